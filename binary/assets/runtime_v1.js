@@ -61,43 +61,46 @@ class V1Element {
     return this.document.innerHTML;
   }
 }
-// class XPathNode {
-//   constructor(content, selector,extension) {
-//     this.content = content;
-//     this.selector = selector;
-//     this.extension=extension;
-//   }
-
-//   async execute(fun) {
-//     return await sendMessage(
-//       "queryXPath",
-//       JSON.stringify([this.content, this.selector, fun])
-//     );
-//   }
+class XPathNode {
+  constructor(content, selector) {
+    this.content = content;
+    this.selector = selector;
+  }
 
-//   get attr() {
-//     return this.execute("attr");
-//   }
+  // Evaluated natively, see xpathQuery in the core
+  execute(fun) {
+    return xpathQuery(this.content, this.selector, fun);
+  }
 
-//   get attrs() {
-//     return this.execute("attrs");
-//   }
+  // first matched attribute, `//a/@href`
+  get attr() {
+    return this.execute("attr");
+  }
 
-//   get text() {
-//     return this.execute("text");
-//   }
+  get attrs() {
+    return this.execute("attrs");
+  }
 
-//   get allHTML() {
-//     return this.execute("allHTML");
-//   }
+  get text() {
+    return this.execute("text");
+  }
 
-//   get outerHTML() {
-//     return this.execute("outerHTML");
-//   }
-// }
+  get texts() {
+    return this.execute("texts");
+  }
 
+  get allHTML() {
+    return this.execute("allHTML");
+  }
 
+  get outerHTML() {
+    return this.execute("outerHTML");
+  }
 
+  get innerHTML() {
+    return this.execute("innerHTML");
+  }
+}
 
 class Extension {
   constructor(webSite) {
@@ -108,6 +111,23 @@ class Extension {
   //name = this.extension.name;
   // 在 load 中注册的 keys
   settingKeys = [];
+  // Key-value storage kept apart from the settings, values are stored as JSON
+  // and options.ttl is in seconds
+  storage = {
+    get: async (key) => {
+      const value = storageGet(key);
+      return value === null ? null : JSON.parse(value);
+    },
+    set: async (key, value, options) => {
+      storageSet(key, JSON.stringify(value), options && options.ttl);
+    },
+    delete: async (key) => {
+      storageDelete(key);
+    },
+    keys: async () => {
+      return storageKeys();
+    },
+  };
 
   async request(url, options) {
     options = options || {};
@@ -140,7 +160,7 @@ class Extension {
   }
 
   querySelector(content, selector) {
-    const {parseHTML} = require("linkedom")
+    const {parseHTML} = require("miru/dom")
     const { document } = parseHTML(content);
     // console.log(document)
     // console.log(selector)
@@ -150,10 +170,10 @@ class Extension {
   }
 
   queryXPath(content, selector) {
-    return new XPathNode(content, selector, this.extension);
+    return new XPathNode(content, selector);
   }
   querySelectorAll(content, selector) {
-    const {parseHTML} = require("linkedom")
+    const {parseHTML} = require("miru/dom")
     const { document } = parseHTML(content);
     const e = document.querySelectorAll(selector).map(function (e) {
       const c = new V1Element(e)
@@ -163,7 +183,7 @@ class Extension {
   }
 
   async getAttributeText(content, selector, attr) {
-    const {parseHTML} = require("linkedom")
+    const {parseHTML} = require("miru/dom")
     const { document } = parseHTML(content);
     const node = document.querySelector(selector);
     return node ? node.getAttribute(attr) : null;
@@ -194,7 +214,7 @@ class Extension {
   }
 
   tags(url) {
-    throw new Error("not implement watch");
+    throw new Error("not implement tags");
   }
 
   checkUpdate(url) {
@@ -212,6 +232,7 @@ class Extension {
   }
 
   async setSetting(key, value) {
+    return setSetting(key, value);
   }
 
   async listCookies(url) {
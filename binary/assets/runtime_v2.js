@@ -1,30 +1,46 @@
-// ################# These lines reserve for go fmt.sprintf ################# //
-const pkg = '%s';
-const name = '%s';
-const website = '%s';
-// ################# These lines reserve for go fmt.sprintf ################# // 
+// ################# extensionMeta is set by the core before this script runs ################# //
+const pkg = extensionMeta.pkg;
+const name = extensionMeta.name;
+const website = extensionMeta.website;
+// ################# extensionMeta is set by the core before this script runs ################# //
 class XPathNode {
   constructor(content, selector) {
     this.content = content;
     this.selector = selector;
   }
-  async excute(fun) {
-    // return await handlePromise("queryXPath$className", JSON.stringify([this.content, this.selector, fun]));
+
+  // Evaluated natively, see xpathQuery in the core
+  execute(fun) {
+    return xpathQuery(this.content, this.selector, fun);
   }
+
+  // first matched attribute, `//a/@href`
   get attr() {
-    return this.excute("attr");
+    return this.execute("attr");
   }
+
   get attrs() {
-    return this.excute("attrs");
+    return this.execute("attrs");
   }
+
   get text() {
-    return this.excute("text");
+    return this.execute("text");
   }
+
+  get texts() {
+    return this.execute("texts");
+  }
+
   get allHTML() {
-    return this.excute("allHTML");
+    return this.execute("allHTML");
   }
+
   get outerHTML() {
-    return this.excute("outerHTML");
+    return this.execute("outerHTML");
+  }
+
+  get innerHTML() {
+    return this.execute("innerHTML");
   }
 }
 
@@ -49,6 +65,9 @@ const Miru = {
       return res;
     }
   },
+  queryXPath: (content, selector) => {
+    return new XPathNode(content, selector);
+  },
   rawRequest: async (url, options) => {
     options = options || {};
     options.headers = options.headers || {};
@@ -61,7 +80,26 @@ const Miru = {
       return message;
     }
   },
-
+  // Key-value storage kept apart from the settings, values are stored as JSON
+  // and options.ttl is in seconds
+  storage: {
+    get: async (key) => {
+      const value = storageGet(key);
+      return value === null ? null : JSON.parse(value);
+    },
+    set: async (key, value, options) => {
+      storageSet(key, JSON.stringify(value), options && options.ttl);
+    },
+    delete: async (key) => {
+      storageDelete(key);
+    },
+    keys: async () => {
+      return storageKeys();
+    },
+  },
+}
+var popular = () => {
+  throw new Error("not implement popular");
 }
 var latest = () => {
   throw new Error("not implement latest");
@@ -81,6 +119,9 @@ var watch = () => {
 var mirror = (url) => {
   return url;
 }
+var tags = () => {
+  throw new Error("not implement tags");
+}
 var checkUpdate = () => {
   throw new Error("not implement checkUpdate");
 }
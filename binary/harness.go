@@ -0,0 +1,82 @@
+package binary
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	extharness "github.com/miru-project/miru-core/pkg/extHarness"
+)
+
+// TestExtension runs the test-extension subcommand. It loads an extension file
+// and runs the cases of its fixture, replaying the recorded requests or
+// recording them from a stand-in server, and returns the exit code
+func TestExtension(args []string) int {
+	flags := flag.NewFlagSet("test-extension", flag.ContinueOnError)
+	fixturePath := flags.String("fixture", "", "Fixture file, defaults to <extension>.fixture.json")
+	standIn := flags.String("record", "", "Record the fixture from the stand-in server at this url")
+	verbose := flags.Bool("v", false, "Print the output of every case")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: test-extension [-fixture file] [-record url] [-v] extension.js")
+		return 2
+	}
+	extFile := flags.Arg(0)
+	if *fixturePath == "" {
+		*fixturePath = strings.TrimSuffix(extFile, ".js") + ".fixture.json"
+	}
+
+	fixture, err := extharness.LoadFixture(*fixturePath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	cleanup, err := extharness.Setup(f)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer cleanup()
+
+	results, err := extharness.Run(context.Background(), extFile, fixture, extharness.Options{StandIn: *standIn})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	code := 0
+	for _, res := range results {
+		name := res.Case.Method + " " + strings.TrimSpace(fmt.Sprint(res.Case.Kw, " ", res.Case.URL))
+		switch {
+		case res.Err != nil:
+			fmt.Printf("FAIL %s: %v\n", name, res.Err)
+			code = 1
+		case len(res.Problems) > 0:
+			fmt.Printf("FAIL %s\n", name)
+			for _, p := range res.Problems {
+				fmt.Printf("    %s\n", p)
+			}
+			code = 1
+		default:
+			fmt.Printf("ok   %s\n", name)
+		}
+		if *verbose && res.Output != nil {
+			out, _ := json.MarshalIndent(res.Output, "    ", "  ")
+			fmt.Printf("    %s\n", out)
+		}
+	}
+
+	if *standIn != "" {
+		if err := fixture.Save(*fixturePath); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Println("Recorded", len(fixture.Exchanges), "exchanges to", *fixturePath)
+	}
+	return code
+}
@@ -3,6 +3,7 @@ package binary
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	fasthttp_router "github.com/fasthttp/router"
 	"github.com/miru-project/miru-core/config"
@@ -59,5 +60,7 @@ func Init() {
 	torrent.Init()
 	download.Init()
 	jsext.InitRuntime(config.Global.ExtensionPath, f)
+	jsext.StartUpdateChecker(time.Duration(config.Global.ExtensionUpdateInterval)*time.Minute, config.Global.ExtensionAutoUpgrade)
+	jsext.StartFavoriteChecker()
 	log.Println("Miru Core initialized successfully!")
 }
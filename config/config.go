@@ -22,6 +22,35 @@ type Config struct {
 	Address        string `json:"address"`
 	Port           string `json:"port"`
 	BTDataDir      string `json:"btDataDir"`
+	// Number of runtimes kept per extension and seconds before an idle one is evicted
+	ExtensionPoolSize    int `json:"extensionPoolSize"`
+	ExtensionIdleTimeout int `json:"extensionIdleTimeout"`
+	// Budgets of a call, going over them aborts it: milliseconds js may run
+	// without yielding, async jobs in flight and heap growth of the whole
+	// process in MB. Extensions going over them repeatedly are disabled
+	ExtensionCPUBudget int `json:"extensionCpuBudget"`
+	ExtensionMaxJobs   int `json:"extensionMaxJobs"`
+	ExtensionHeapLimit int `json:"extensionHeapLimit"`
+	// How results that don't match the models are handled, lenient or strict,
+	// by default and per package
+	ExtensionValidation      string            `json:"extensionValidation"`
+	ExtensionValidationModes map[string]string `json:"extensionValidationModes,omitempty"`
+	// Minutes between checks for extension updates, 0 disables the check.
+	// Outdated extensions are upgraded by the check when auto upgrade is set
+	ExtensionUpdateInterval int  `json:"extensionUpdateInterval"`
+	ExtensionAutoUpgrade    bool `json:"extensionAutoUpgrade"`
+	// What happens to downloaded extensions failing verification, reject or quarantine
+	ExtensionVerifyPolicy string `json:"extensionVerifyPolicy"`
+	// KB each extension may keep with Miru.storage
+	ExtensionStorageQuota int `json:"extensionStorageQuota"`
+	// File holding the key secret extension settings are encrypted with, it is
+	// created on first use and kept out of the database and its backups
+	SecretKeyPath string `json:"secretKeyPath"`
+	// Minutes between checks of favorites for new episodes, 0 disables them
+	// unless a favorite group sets its own interval. Checks run per extension
+	// with at most FavoriteCheckConcurrency details fetched at a time
+	FavoriteCheckInterval    int `json:"favoriteCheckInterval"`
+	FavoriteCheckConcurrency int `json:"favoriteCheckConcurrency"`
 }
 
 var (
@@ -29,6 +58,24 @@ var (
 	Global Config
 )
 
+// Defaults of the settings left unset, also used by the packages reading them
+// when no configuration was loaded
+const (
+	// Runtimes kept per extension and seconds before an idle one is evicted
+	DefaultExtensionPoolSize    = 2
+	DefaultExtensionIdleTimeout = 300
+	// Milliseconds js may run without yielding, async jobs in flight and MB
+	// the heap may grow during a call
+	DefaultExtensionCPUBudget = 10000
+	DefaultExtensionMaxJobs   = 64
+	DefaultExtensionHeapLimit = 256
+	// DefaultExtensionStorageQuota is the KB an extension may keep in its storage
+	DefaultExtensionStorageQuota = 5120
+	// DefaultFavoriteCheckConcurrency is how many favorites of one extension
+	// are checked at a time
+	DefaultFavoriteCheckConcurrency = 2
+)
+
 // Load loads configuration from a file
 func Load(path string) error {
 	data, err := os.ReadFile(path)
@@ -90,6 +137,36 @@ func applyConfigDefaults(cfg *Config) {
 	if cfg.Port == "" {
 		cfg.Port = "3000"
 	}
+	if cfg.ExtensionPoolSize <= 0 {
+		cfg.ExtensionPoolSize = DefaultExtensionPoolSize
+	}
+	if cfg.ExtensionIdleTimeout <= 0 {
+		cfg.ExtensionIdleTimeout = DefaultExtensionIdleTimeout
+	}
+	if cfg.ExtensionCPUBudget <= 0 {
+		cfg.ExtensionCPUBudget = DefaultExtensionCPUBudget
+	}
+	if cfg.ExtensionMaxJobs <= 0 {
+		cfg.ExtensionMaxJobs = DefaultExtensionMaxJobs
+	}
+	if cfg.ExtensionHeapLimit <= 0 {
+		cfg.ExtensionHeapLimit = DefaultExtensionHeapLimit
+	}
+	if cfg.ExtensionValidation == "" {
+		cfg.ExtensionValidation = "lenient"
+	}
+	if cfg.ExtensionVerifyPolicy == "" {
+		cfg.ExtensionVerifyPolicy = "quarantine"
+	}
+	if cfg.ExtensionStorageQuota <= 0 {
+		cfg.ExtensionStorageQuota = DefaultExtensionStorageQuota
+	}
+	if cfg.SecretKeyPath == "" {
+		cfg.SecretKeyPath = "./secret.key"
+	}
+	if cfg.FavoriteCheckConcurrency <= 0 {
+		cfg.FavoriteCheckConcurrency = DefaultFavoriteCheckConcurrency
+	}
 }
 
 // Save saves the current configuration to a file
@@ -114,5 +191,15 @@ func GetDefaultConfig() Config {
 	cfg.ExtensionPath = "./extensions"
 	cfg.Address = "127.0.0.1"
 	cfg.Port = "3000"
+	cfg.ExtensionPoolSize = DefaultExtensionPoolSize
+	cfg.ExtensionIdleTimeout = DefaultExtensionIdleTimeout
+	cfg.ExtensionCPUBudget = DefaultExtensionCPUBudget
+	cfg.ExtensionMaxJobs = DefaultExtensionMaxJobs
+	cfg.ExtensionHeapLimit = DefaultExtensionHeapLimit
+	cfg.ExtensionValidation = "lenient"
+	cfg.ExtensionVerifyPolicy = "quarantine"
+	cfg.ExtensionStorageQuota = DefaultExtensionStorageQuota
+	cfg.SecretKeyPath = "./secret.key"
+	cfg.FavoriteCheckConcurrency = DefaultFavoriteCheckConcurrency
 	return cfg
 }
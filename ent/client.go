@@ -18,8 +18,14 @@ import (
 	"github.com/miru-project/miru-core/ent/appsetting"
 	"github.com/miru-project/miru-core/ent/detail"
 	"github.com/miru-project/miru-core/ent/download"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+	"github.com/miru-project/miru-core/ent/extensiongrant"
 	"github.com/miru-project/miru-core/ent/extensionreposetting"
 	"github.com/miru-project/miru-core/ent/extensionsetting"
+	"github.com/miru-project/miru-core/ent/extensionstate"
+	"github.com/miru-project/miru-core/ent/extensionstorage"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
 	"github.com/miru-project/miru-core/ent/favorite"
 	"github.com/miru-project/miru-core/ent/favoritegroup"
 	"github.com/miru-project/miru-core/ent/history"
@@ -38,10 +44,22 @@ type Client struct {
 	Detail *DetailClient
 	// Download is the client for interacting with the Download builders.
 	Download *DownloadClient
+	// EpisodeUpdate is the client for interacting with the EpisodeUpdate builders.
+	EpisodeUpdate *EpisodeUpdateClient
+	// ExtensionGrant is the client for interacting with the ExtensionGrant builders.
+	ExtensionGrant *ExtensionGrantClient
 	// ExtensionRepoSetting is the client for interacting with the ExtensionRepoSetting builders.
 	ExtensionRepoSetting *ExtensionRepoSettingClient
 	// ExtensionSetting is the client for interacting with the ExtensionSetting builders.
 	ExtensionSetting *ExtensionSettingClient
+	// ExtensionState is the client for interacting with the ExtensionState builders.
+	ExtensionState *ExtensionStateClient
+	// ExtensionStorage is the client for interacting with the ExtensionStorage builders.
+	ExtensionStorage *ExtensionStorageClient
+	// ExtensionVerification is the client for interacting with the ExtensionVerification builders.
+	ExtensionVerification *ExtensionVerificationClient
+	// ExtensionVerificationFailure is the client for interacting with the ExtensionVerificationFailure builders.
+	ExtensionVerificationFailure *ExtensionVerificationFailureClient
 	// Favorite is the client for interacting with the Favorite builders.
 	Favorite *FavoriteClient
 	// FavoriteGroup is the client for interacting with the FavoriteGroup builders.
@@ -66,8 +84,14 @@ func (c *Client) init() {
 	c.AppSetting = NewAppSettingClient(c.config)
 	c.Detail = NewDetailClient(c.config)
 	c.Download = NewDownloadClient(c.config)
+	c.EpisodeUpdate = NewEpisodeUpdateClient(c.config)
+	c.ExtensionGrant = NewExtensionGrantClient(c.config)
 	c.ExtensionRepoSetting = NewExtensionRepoSettingClient(c.config)
 	c.ExtensionSetting = NewExtensionSettingClient(c.config)
+	c.ExtensionState = NewExtensionStateClient(c.config)
+	c.ExtensionStorage = NewExtensionStorageClient(c.config)
+	c.ExtensionVerification = NewExtensionVerificationClient(c.config)
+	c.ExtensionVerificationFailure = NewExtensionVerificationFailureClient(c.config)
 	c.Favorite = NewFavoriteClient(c.config)
 	c.FavoriteGroup = NewFavoriteGroupClient(c.config)
 	c.History = NewHistoryClient(c.config)
@@ -163,18 +187,24 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		AppSetting:           NewAppSettingClient(cfg),
-		Detail:               NewDetailClient(cfg),
-		Download:             NewDownloadClient(cfg),
-		ExtensionRepoSetting: NewExtensionRepoSettingClient(cfg),
-		ExtensionSetting:     NewExtensionSettingClient(cfg),
-		Favorite:             NewFavoriteClient(cfg),
-		FavoriteGroup:        NewFavoriteGroupClient(cfg),
-		History:              NewHistoryClient(cfg),
-		Track:                NewTrackClient(cfg),
-		Tracker:              NewTrackerClient(cfg),
+		ctx:                          ctx,
+		config:                       cfg,
+		AppSetting:                   NewAppSettingClient(cfg),
+		Detail:                       NewDetailClient(cfg),
+		Download:                     NewDownloadClient(cfg),
+		EpisodeUpdate:                NewEpisodeUpdateClient(cfg),
+		ExtensionGrant:               NewExtensionGrantClient(cfg),
+		ExtensionRepoSetting:         NewExtensionRepoSettingClient(cfg),
+		ExtensionSetting:             NewExtensionSettingClient(cfg),
+		ExtensionState:               NewExtensionStateClient(cfg),
+		ExtensionStorage:             NewExtensionStorageClient(cfg),
+		ExtensionVerification:        NewExtensionVerificationClient(cfg),
+		ExtensionVerificationFailure: NewExtensionVerificationFailureClient(cfg),
+		Favorite:                     NewFavoriteClient(cfg),
+		FavoriteGroup:                NewFavoriteGroupClient(cfg),
+		History:                      NewHistoryClient(cfg),
+		Track:                        NewTrackClient(cfg),
+		Tracker:                      NewTrackerClient(cfg),
 	}, nil
 }
 
@@ -192,18 +222,24 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		AppSetting:           NewAppSettingClient(cfg),
-		Detail:               NewDetailClient(cfg),
-		Download:             NewDownloadClient(cfg),
-		ExtensionRepoSetting: NewExtensionRepoSettingClient(cfg),
-		ExtensionSetting:     NewExtensionSettingClient(cfg),
-		Favorite:             NewFavoriteClient(cfg),
-		FavoriteGroup:        NewFavoriteGroupClient(cfg),
-		History:              NewHistoryClient(cfg),
-		Track:                NewTrackClient(cfg),
-		Tracker:              NewTrackerClient(cfg),
+		ctx:                          ctx,
+		config:                       cfg,
+		AppSetting:                   NewAppSettingClient(cfg),
+		Detail:                       NewDetailClient(cfg),
+		Download:                     NewDownloadClient(cfg),
+		EpisodeUpdate:                NewEpisodeUpdateClient(cfg),
+		ExtensionGrant:               NewExtensionGrantClient(cfg),
+		ExtensionRepoSetting:         NewExtensionRepoSettingClient(cfg),
+		ExtensionSetting:             NewExtensionSettingClient(cfg),
+		ExtensionState:               NewExtensionStateClient(cfg),
+		ExtensionStorage:             NewExtensionStorageClient(cfg),
+		ExtensionVerification:        NewExtensionVerificationClient(cfg),
+		ExtensionVerificationFailure: NewExtensionVerificationFailureClient(cfg),
+		Favorite:                     NewFavoriteClient(cfg),
+		FavoriteGroup:                NewFavoriteGroupClient(cfg),
+		History:                      NewHistoryClient(cfg),
+		Track:                        NewTrackClient(cfg),
+		Tracker:                      NewTrackerClient(cfg),
 	}, nil
 }
 
@@ -233,7 +269,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AppSetting, c.Detail, c.Download, c.ExtensionRepoSetting, c.ExtensionSetting,
+		c.AppSetting, c.Detail, c.Download, c.EpisodeUpdate, c.ExtensionGrant,
+		c.ExtensionRepoSetting, c.ExtensionSetting, c.ExtensionState,
+		c.ExtensionStorage, c.ExtensionVerification, c.ExtensionVerificationFailure,
 		c.Favorite, c.FavoriteGroup, c.History, c.Track, c.Tracker,
 	} {
 		n.Use(hooks...)
@@ -244,7 +282,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AppSetting, c.Detail, c.Download, c.ExtensionRepoSetting, c.ExtensionSetting,
+		c.AppSetting, c.Detail, c.Download, c.EpisodeUpdate, c.ExtensionGrant,
+		c.ExtensionRepoSetting, c.ExtensionSetting, c.ExtensionState,
+		c.ExtensionStorage, c.ExtensionVerification, c.ExtensionVerificationFailure,
 		c.Favorite, c.FavoriteGroup, c.History, c.Track, c.Tracker,
 	} {
 		n.Intercept(interceptors...)
@@ -260,10 +300,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Detail.mutate(ctx, m)
 	case *DownloadMutation:
 		return c.Download.mutate(ctx, m)
+	case *EpisodeUpdateMutation:
+		return c.EpisodeUpdate.mutate(ctx, m)
+	case *ExtensionGrantMutation:
+		return c.ExtensionGrant.mutate(ctx, m)
 	case *ExtensionRepoSettingMutation:
 		return c.ExtensionRepoSetting.mutate(ctx, m)
 	case *ExtensionSettingMutation:
 		return c.ExtensionSetting.mutate(ctx, m)
+	case *ExtensionStateMutation:
+		return c.ExtensionState.mutate(ctx, m)
+	case *ExtensionStorageMutation:
+		return c.ExtensionStorage.mutate(ctx, m)
+	case *ExtensionVerificationMutation:
+		return c.ExtensionVerification.mutate(ctx, m)
+	case *ExtensionVerificationFailureMutation:
+		return c.ExtensionVerificationFailure.mutate(ctx, m)
 	case *FavoriteMutation:
 		return c.Favorite.mutate(ctx, m)
 	case *FavoriteGroupMutation:
@@ -694,6 +746,272 @@ func (c *DownloadClient) mutate(ctx context.Context, m *DownloadMutation) (Value
 	}
 }
 
+// EpisodeUpdateClient is a client for the EpisodeUpdate schema.
+type EpisodeUpdateClient struct {
+	config
+}
+
+// NewEpisodeUpdateClient returns a client for the EpisodeUpdate from the given config.
+func NewEpisodeUpdateClient(c config) *EpisodeUpdateClient {
+	return &EpisodeUpdateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `episodeupdate.Hooks(f(g(h())))`.
+func (c *EpisodeUpdateClient) Use(hooks ...Hook) {
+	c.hooks.EpisodeUpdate = append(c.hooks.EpisodeUpdate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `episodeupdate.Intercept(f(g(h())))`.
+func (c *EpisodeUpdateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EpisodeUpdate = append(c.inters.EpisodeUpdate, interceptors...)
+}
+
+// Create returns a builder for creating a EpisodeUpdate entity.
+func (c *EpisodeUpdateClient) Create() *EpisodeUpdateCreate {
+	mutation := newEpisodeUpdateMutation(c.config, OpCreate)
+	return &EpisodeUpdateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EpisodeUpdate entities.
+func (c *EpisodeUpdateClient) CreateBulk(builders ...*EpisodeUpdateCreate) *EpisodeUpdateCreateBulk {
+	return &EpisodeUpdateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EpisodeUpdateClient) MapCreateBulk(slice any, setFunc func(*EpisodeUpdateCreate, int)) *EpisodeUpdateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EpisodeUpdateCreateBulk{err: fmt.Errorf("calling to EpisodeUpdateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EpisodeUpdateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EpisodeUpdateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EpisodeUpdate.
+func (c *EpisodeUpdateClient) Update() *EpisodeUpdateUpdate {
+	mutation := newEpisodeUpdateMutation(c.config, OpUpdate)
+	return &EpisodeUpdateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EpisodeUpdateClient) UpdateOne(_m *EpisodeUpdate) *EpisodeUpdateUpdateOne {
+	mutation := newEpisodeUpdateMutation(c.config, OpUpdateOne, withEpisodeUpdate(_m))
+	return &EpisodeUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EpisodeUpdateClient) UpdateOneID(id int) *EpisodeUpdateUpdateOne {
+	mutation := newEpisodeUpdateMutation(c.config, OpUpdateOne, withEpisodeUpdateID(id))
+	return &EpisodeUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EpisodeUpdate.
+func (c *EpisodeUpdateClient) Delete() *EpisodeUpdateDelete {
+	mutation := newEpisodeUpdateMutation(c.config, OpDelete)
+	return &EpisodeUpdateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EpisodeUpdateClient) DeleteOne(_m *EpisodeUpdate) *EpisodeUpdateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EpisodeUpdateClient) DeleteOneID(id int) *EpisodeUpdateDeleteOne {
+	builder := c.Delete().Where(episodeupdate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EpisodeUpdateDeleteOne{builder}
+}
+
+// Query returns a query builder for EpisodeUpdate.
+func (c *EpisodeUpdateClient) Query() *EpisodeUpdateQuery {
+	return &EpisodeUpdateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEpisodeUpdate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EpisodeUpdate entity by its id.
+func (c *EpisodeUpdateClient) Get(ctx context.Context, id int) (*EpisodeUpdate, error) {
+	return c.Query().Where(episodeupdate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EpisodeUpdateClient) GetX(ctx context.Context, id int) *EpisodeUpdate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EpisodeUpdateClient) Hooks() []Hook {
+	return c.hooks.EpisodeUpdate
+}
+
+// Interceptors returns the client interceptors.
+func (c *EpisodeUpdateClient) Interceptors() []Interceptor {
+	return c.inters.EpisodeUpdate
+}
+
+func (c *EpisodeUpdateClient) mutate(ctx context.Context, m *EpisodeUpdateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EpisodeUpdateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EpisodeUpdateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EpisodeUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EpisodeUpdateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EpisodeUpdate mutation op: %q", m.Op())
+	}
+}
+
+// ExtensionGrantClient is a client for the ExtensionGrant schema.
+type ExtensionGrantClient struct {
+	config
+}
+
+// NewExtensionGrantClient returns a client for the ExtensionGrant from the given config.
+func NewExtensionGrantClient(c config) *ExtensionGrantClient {
+	return &ExtensionGrantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `extensiongrant.Hooks(f(g(h())))`.
+func (c *ExtensionGrantClient) Use(hooks ...Hook) {
+	c.hooks.ExtensionGrant = append(c.hooks.ExtensionGrant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `extensiongrant.Intercept(f(g(h())))`.
+func (c *ExtensionGrantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExtensionGrant = append(c.inters.ExtensionGrant, interceptors...)
+}
+
+// Create returns a builder for creating a ExtensionGrant entity.
+func (c *ExtensionGrantClient) Create() *ExtensionGrantCreate {
+	mutation := newExtensionGrantMutation(c.config, OpCreate)
+	return &ExtensionGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExtensionGrant entities.
+func (c *ExtensionGrantClient) CreateBulk(builders ...*ExtensionGrantCreate) *ExtensionGrantCreateBulk {
+	return &ExtensionGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExtensionGrantClient) MapCreateBulk(slice any, setFunc func(*ExtensionGrantCreate, int)) *ExtensionGrantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExtensionGrantCreateBulk{err: fmt.Errorf("calling to ExtensionGrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExtensionGrantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExtensionGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExtensionGrant.
+func (c *ExtensionGrantClient) Update() *ExtensionGrantUpdate {
+	mutation := newExtensionGrantMutation(c.config, OpUpdate)
+	return &ExtensionGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExtensionGrantClient) UpdateOne(_m *ExtensionGrant) *ExtensionGrantUpdateOne {
+	mutation := newExtensionGrantMutation(c.config, OpUpdateOne, withExtensionGrant(_m))
+	return &ExtensionGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExtensionGrantClient) UpdateOneID(id int) *ExtensionGrantUpdateOne {
+	mutation := newExtensionGrantMutation(c.config, OpUpdateOne, withExtensionGrantID(id))
+	return &ExtensionGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExtensionGrant.
+func (c *ExtensionGrantClient) Delete() *ExtensionGrantDelete {
+	mutation := newExtensionGrantMutation(c.config, OpDelete)
+	return &ExtensionGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExtensionGrantClient) DeleteOne(_m *ExtensionGrant) *ExtensionGrantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExtensionGrantClient) DeleteOneID(id int) *ExtensionGrantDeleteOne {
+	builder := c.Delete().Where(extensiongrant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExtensionGrantDeleteOne{builder}
+}
+
+// Query returns a query builder for ExtensionGrant.
+func (c *ExtensionGrantClient) Query() *ExtensionGrantQuery {
+	return &ExtensionGrantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExtensionGrant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExtensionGrant entity by its id.
+func (c *ExtensionGrantClient) Get(ctx context.Context, id int) (*ExtensionGrant, error) {
+	return c.Query().Where(extensiongrant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExtensionGrantClient) GetX(ctx context.Context, id int) *ExtensionGrant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExtensionGrantClient) Hooks() []Hook {
+	return c.hooks.ExtensionGrant
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExtensionGrantClient) Interceptors() []Interceptor {
+	return c.inters.ExtensionGrant
+}
+
+func (c *ExtensionGrantClient) mutate(ctx context.Context, m *ExtensionGrantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExtensionGrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExtensionGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExtensionGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExtensionGrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExtensionGrant mutation op: %q", m.Op())
+	}
+}
+
 // ExtensionRepoSettingClient is a client for the ExtensionRepoSetting schema.
 type ExtensionRepoSettingClient struct {
 	config
@@ -962,6 +1280,538 @@ func (c *ExtensionSettingClient) mutate(ctx context.Context, m *ExtensionSetting
 	}
 }
 
+// ExtensionStateClient is a client for the ExtensionState schema.
+type ExtensionStateClient struct {
+	config
+}
+
+// NewExtensionStateClient returns a client for the ExtensionState from the given config.
+func NewExtensionStateClient(c config) *ExtensionStateClient {
+	return &ExtensionStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `extensionstate.Hooks(f(g(h())))`.
+func (c *ExtensionStateClient) Use(hooks ...Hook) {
+	c.hooks.ExtensionState = append(c.hooks.ExtensionState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `extensionstate.Intercept(f(g(h())))`.
+func (c *ExtensionStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExtensionState = append(c.inters.ExtensionState, interceptors...)
+}
+
+// Create returns a builder for creating a ExtensionState entity.
+func (c *ExtensionStateClient) Create() *ExtensionStateCreate {
+	mutation := newExtensionStateMutation(c.config, OpCreate)
+	return &ExtensionStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExtensionState entities.
+func (c *ExtensionStateClient) CreateBulk(builders ...*ExtensionStateCreate) *ExtensionStateCreateBulk {
+	return &ExtensionStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExtensionStateClient) MapCreateBulk(slice any, setFunc func(*ExtensionStateCreate, int)) *ExtensionStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExtensionStateCreateBulk{err: fmt.Errorf("calling to ExtensionStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExtensionStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExtensionStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExtensionState.
+func (c *ExtensionStateClient) Update() *ExtensionStateUpdate {
+	mutation := newExtensionStateMutation(c.config, OpUpdate)
+	return &ExtensionStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExtensionStateClient) UpdateOne(_m *ExtensionState) *ExtensionStateUpdateOne {
+	mutation := newExtensionStateMutation(c.config, OpUpdateOne, withExtensionState(_m))
+	return &ExtensionStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExtensionStateClient) UpdateOneID(id int) *ExtensionStateUpdateOne {
+	mutation := newExtensionStateMutation(c.config, OpUpdateOne, withExtensionStateID(id))
+	return &ExtensionStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExtensionState.
+func (c *ExtensionStateClient) Delete() *ExtensionStateDelete {
+	mutation := newExtensionStateMutation(c.config, OpDelete)
+	return &ExtensionStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExtensionStateClient) DeleteOne(_m *ExtensionState) *ExtensionStateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExtensionStateClient) DeleteOneID(id int) *ExtensionStateDeleteOne {
+	builder := c.Delete().Where(extensionstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExtensionStateDeleteOne{builder}
+}
+
+// Query returns a query builder for ExtensionState.
+func (c *ExtensionStateClient) Query() *ExtensionStateQuery {
+	return &ExtensionStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExtensionState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExtensionState entity by its id.
+func (c *ExtensionStateClient) Get(ctx context.Context, id int) (*ExtensionState, error) {
+	return c.Query().Where(extensionstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExtensionStateClient) GetX(ctx context.Context, id int) *ExtensionState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExtensionStateClient) Hooks() []Hook {
+	return c.hooks.ExtensionState
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExtensionStateClient) Interceptors() []Interceptor {
+	return c.inters.ExtensionState
+}
+
+func (c *ExtensionStateClient) mutate(ctx context.Context, m *ExtensionStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExtensionStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExtensionStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExtensionStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExtensionStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExtensionState mutation op: %q", m.Op())
+	}
+}
+
+// ExtensionStorageClient is a client for the ExtensionStorage schema.
+type ExtensionStorageClient struct {
+	config
+}
+
+// NewExtensionStorageClient returns a client for the ExtensionStorage from the given config.
+func NewExtensionStorageClient(c config) *ExtensionStorageClient {
+	return &ExtensionStorageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `extensionstorage.Hooks(f(g(h())))`.
+func (c *ExtensionStorageClient) Use(hooks ...Hook) {
+	c.hooks.ExtensionStorage = append(c.hooks.ExtensionStorage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `extensionstorage.Intercept(f(g(h())))`.
+func (c *ExtensionStorageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExtensionStorage = append(c.inters.ExtensionStorage, interceptors...)
+}
+
+// Create returns a builder for creating a ExtensionStorage entity.
+func (c *ExtensionStorageClient) Create() *ExtensionStorageCreate {
+	mutation := newExtensionStorageMutation(c.config, OpCreate)
+	return &ExtensionStorageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExtensionStorage entities.
+func (c *ExtensionStorageClient) CreateBulk(builders ...*ExtensionStorageCreate) *ExtensionStorageCreateBulk {
+	return &ExtensionStorageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExtensionStorageClient) MapCreateBulk(slice any, setFunc func(*ExtensionStorageCreate, int)) *ExtensionStorageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExtensionStorageCreateBulk{err: fmt.Errorf("calling to ExtensionStorageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExtensionStorageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExtensionStorageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExtensionStorage.
+func (c *ExtensionStorageClient) Update() *ExtensionStorageUpdate {
+	mutation := newExtensionStorageMutation(c.config, OpUpdate)
+	return &ExtensionStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExtensionStorageClient) UpdateOne(_m *ExtensionStorage) *ExtensionStorageUpdateOne {
+	mutation := newExtensionStorageMutation(c.config, OpUpdateOne, withExtensionStorage(_m))
+	return &ExtensionStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExtensionStorageClient) UpdateOneID(id int) *ExtensionStorageUpdateOne {
+	mutation := newExtensionStorageMutation(c.config, OpUpdateOne, withExtensionStorageID(id))
+	return &ExtensionStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExtensionStorage.
+func (c *ExtensionStorageClient) Delete() *ExtensionStorageDelete {
+	mutation := newExtensionStorageMutation(c.config, OpDelete)
+	return &ExtensionStorageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExtensionStorageClient) DeleteOne(_m *ExtensionStorage) *ExtensionStorageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExtensionStorageClient) DeleteOneID(id int) *ExtensionStorageDeleteOne {
+	builder := c.Delete().Where(extensionstorage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExtensionStorageDeleteOne{builder}
+}
+
+// Query returns a query builder for ExtensionStorage.
+func (c *ExtensionStorageClient) Query() *ExtensionStorageQuery {
+	return &ExtensionStorageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExtensionStorage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExtensionStorage entity by its id.
+func (c *ExtensionStorageClient) Get(ctx context.Context, id int) (*ExtensionStorage, error) {
+	return c.Query().Where(extensionstorage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExtensionStorageClient) GetX(ctx context.Context, id int) *ExtensionStorage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExtensionStorageClient) Hooks() []Hook {
+	return c.hooks.ExtensionStorage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExtensionStorageClient) Interceptors() []Interceptor {
+	return c.inters.ExtensionStorage
+}
+
+func (c *ExtensionStorageClient) mutate(ctx context.Context, m *ExtensionStorageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExtensionStorageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExtensionStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExtensionStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExtensionStorageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExtensionStorage mutation op: %q", m.Op())
+	}
+}
+
+// ExtensionVerificationClient is a client for the ExtensionVerification schema.
+type ExtensionVerificationClient struct {
+	config
+}
+
+// NewExtensionVerificationClient returns a client for the ExtensionVerification from the given config.
+func NewExtensionVerificationClient(c config) *ExtensionVerificationClient {
+	return &ExtensionVerificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `extensionverification.Hooks(f(g(h())))`.
+func (c *ExtensionVerificationClient) Use(hooks ...Hook) {
+	c.hooks.ExtensionVerification = append(c.hooks.ExtensionVerification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `extensionverification.Intercept(f(g(h())))`.
+func (c *ExtensionVerificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExtensionVerification = append(c.inters.ExtensionVerification, interceptors...)
+}
+
+// Create returns a builder for creating a ExtensionVerification entity.
+func (c *ExtensionVerificationClient) Create() *ExtensionVerificationCreate {
+	mutation := newExtensionVerificationMutation(c.config, OpCreate)
+	return &ExtensionVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExtensionVerification entities.
+func (c *ExtensionVerificationClient) CreateBulk(builders ...*ExtensionVerificationCreate) *ExtensionVerificationCreateBulk {
+	return &ExtensionVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExtensionVerificationClient) MapCreateBulk(slice any, setFunc func(*ExtensionVerificationCreate, int)) *ExtensionVerificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExtensionVerificationCreateBulk{err: fmt.Errorf("calling to ExtensionVerificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExtensionVerificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExtensionVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExtensionVerification.
+func (c *ExtensionVerificationClient) Update() *ExtensionVerificationUpdate {
+	mutation := newExtensionVerificationMutation(c.config, OpUpdate)
+	return &ExtensionVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExtensionVerificationClient) UpdateOne(_m *ExtensionVerification) *ExtensionVerificationUpdateOne {
+	mutation := newExtensionVerificationMutation(c.config, OpUpdateOne, withExtensionVerification(_m))
+	return &ExtensionVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExtensionVerificationClient) UpdateOneID(id int) *ExtensionVerificationUpdateOne {
+	mutation := newExtensionVerificationMutation(c.config, OpUpdateOne, withExtensionVerificationID(id))
+	return &ExtensionVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExtensionVerification.
+func (c *ExtensionVerificationClient) Delete() *ExtensionVerificationDelete {
+	mutation := newExtensionVerificationMutation(c.config, OpDelete)
+	return &ExtensionVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExtensionVerificationClient) DeleteOne(_m *ExtensionVerification) *ExtensionVerificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExtensionVerificationClient) DeleteOneID(id int) *ExtensionVerificationDeleteOne {
+	builder := c.Delete().Where(extensionverification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExtensionVerificationDeleteOne{builder}
+}
+
+// Query returns a query builder for ExtensionVerification.
+func (c *ExtensionVerificationClient) Query() *ExtensionVerificationQuery {
+	return &ExtensionVerificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExtensionVerification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExtensionVerification entity by its id.
+func (c *ExtensionVerificationClient) Get(ctx context.Context, id int) (*ExtensionVerification, error) {
+	return c.Query().Where(extensionverification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExtensionVerificationClient) GetX(ctx context.Context, id int) *ExtensionVerification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExtensionVerificationClient) Hooks() []Hook {
+	return c.hooks.ExtensionVerification
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExtensionVerificationClient) Interceptors() []Interceptor {
+	return c.inters.ExtensionVerification
+}
+
+func (c *ExtensionVerificationClient) mutate(ctx context.Context, m *ExtensionVerificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExtensionVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExtensionVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExtensionVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExtensionVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExtensionVerification mutation op: %q", m.Op())
+	}
+}
+
+// ExtensionVerificationFailureClient is a client for the ExtensionVerificationFailure schema.
+type ExtensionVerificationFailureClient struct {
+	config
+}
+
+// NewExtensionVerificationFailureClient returns a client for the ExtensionVerificationFailure from the given config.
+func NewExtensionVerificationFailureClient(c config) *ExtensionVerificationFailureClient {
+	return &ExtensionVerificationFailureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `extensionverificationfailure.Hooks(f(g(h())))`.
+func (c *ExtensionVerificationFailureClient) Use(hooks ...Hook) {
+	c.hooks.ExtensionVerificationFailure = append(c.hooks.ExtensionVerificationFailure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `extensionverificationfailure.Intercept(f(g(h())))`.
+func (c *ExtensionVerificationFailureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExtensionVerificationFailure = append(c.inters.ExtensionVerificationFailure, interceptors...)
+}
+
+// Create returns a builder for creating a ExtensionVerificationFailure entity.
+func (c *ExtensionVerificationFailureClient) Create() *ExtensionVerificationFailureCreate {
+	mutation := newExtensionVerificationFailureMutation(c.config, OpCreate)
+	return &ExtensionVerificationFailureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExtensionVerificationFailure entities.
+func (c *ExtensionVerificationFailureClient) CreateBulk(builders ...*ExtensionVerificationFailureCreate) *ExtensionVerificationFailureCreateBulk {
+	return &ExtensionVerificationFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExtensionVerificationFailureClient) MapCreateBulk(slice any, setFunc func(*ExtensionVerificationFailureCreate, int)) *ExtensionVerificationFailureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExtensionVerificationFailureCreateBulk{err: fmt.Errorf("calling to ExtensionVerificationFailureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExtensionVerificationFailureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExtensionVerificationFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExtensionVerificationFailure.
+func (c *ExtensionVerificationFailureClient) Update() *ExtensionVerificationFailureUpdate {
+	mutation := newExtensionVerificationFailureMutation(c.config, OpUpdate)
+	return &ExtensionVerificationFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExtensionVerificationFailureClient) UpdateOne(_m *ExtensionVerificationFailure) *ExtensionVerificationFailureUpdateOne {
+	mutation := newExtensionVerificationFailureMutation(c.config, OpUpdateOne, withExtensionVerificationFailure(_m))
+	return &ExtensionVerificationFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExtensionVerificationFailureClient) UpdateOneID(id int) *ExtensionVerificationFailureUpdateOne {
+	mutation := newExtensionVerificationFailureMutation(c.config, OpUpdateOne, withExtensionVerificationFailureID(id))
+	return &ExtensionVerificationFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExtensionVerificationFailure.
+func (c *ExtensionVerificationFailureClient) Delete() *ExtensionVerificationFailureDelete {
+	mutation := newExtensionVerificationFailureMutation(c.config, OpDelete)
+	return &ExtensionVerificationFailureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExtensionVerificationFailureClient) DeleteOne(_m *ExtensionVerificationFailure) *ExtensionVerificationFailureDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExtensionVerificationFailureClient) DeleteOneID(id int) *ExtensionVerificationFailureDeleteOne {
+	builder := c.Delete().Where(extensionverificationfailure.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExtensionVerificationFailureDeleteOne{builder}
+}
+
+// Query returns a query builder for ExtensionVerificationFailure.
+func (c *ExtensionVerificationFailureClient) Query() *ExtensionVerificationFailureQuery {
+	return &ExtensionVerificationFailureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExtensionVerificationFailure},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExtensionVerificationFailure entity by its id.
+func (c *ExtensionVerificationFailureClient) Get(ctx context.Context, id int) (*ExtensionVerificationFailure, error) {
+	return c.Query().Where(extensionverificationfailure.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExtensionVerificationFailureClient) GetX(ctx context.Context, id int) *ExtensionVerificationFailure {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExtensionVerificationFailureClient) Hooks() []Hook {
+	return c.hooks.ExtensionVerificationFailure
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExtensionVerificationFailureClient) Interceptors() []Interceptor {
+	return c.inters.ExtensionVerificationFailure
+}
+
+func (c *ExtensionVerificationFailureClient) mutate(ctx context.Context, m *ExtensionVerificationFailureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExtensionVerificationFailureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExtensionVerificationFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExtensionVerificationFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExtensionVerificationFailureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExtensionVerificationFailure mutation op: %q", m.Op())
+	}
+}
+
 // FavoriteClient is a client for the Favorite schema.
 type FavoriteClient struct {
 	config
@@ -1678,11 +2528,15 @@ func (c *TrackerClient) mutate(ctx context.Context, m *TrackerMutation) (Value,
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AppSetting, Detail, Download, ExtensionRepoSetting, ExtensionSetting, Favorite,
-		FavoriteGroup, History, Track, Tracker []ent.Hook
+		AppSetting, Detail, Download, EpisodeUpdate, ExtensionGrant,
+		ExtensionRepoSetting, ExtensionSetting, ExtensionState, ExtensionStorage,
+		ExtensionVerification, ExtensionVerificationFailure, Favorite, FavoriteGroup,
+		History, Track, Tracker []ent.Hook
 	}
 	inters struct {
-		AppSetting, Detail, Download, ExtensionRepoSetting, ExtensionSetting, Favorite,
-		FavoriteGroup, History, Track, Tracker []ent.Interceptor
+		AppSetting, Detail, Download, EpisodeUpdate, ExtensionGrant,
+		ExtensionRepoSetting, ExtensionSetting, ExtensionState, ExtensionStorage,
+		ExtensionVerification, ExtensionVerificationFailure, Favorite, FavoriteGroup,
+		History, Track, Tracker []ent.Interceptor
 	}
 )
@@ -15,8 +15,14 @@ import (
 	"github.com/miru-project/miru-core/ent/appsetting"
 	"github.com/miru-project/miru-core/ent/detail"
 	"github.com/miru-project/miru-core/ent/download"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+	"github.com/miru-project/miru-core/ent/extensiongrant"
 	"github.com/miru-project/miru-core/ent/extensionreposetting"
 	"github.com/miru-project/miru-core/ent/extensionsetting"
+	"github.com/miru-project/miru-core/ent/extensionstate"
+	"github.com/miru-project/miru-core/ent/extensionstorage"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
 	"github.com/miru-project/miru-core/ent/favorite"
 	"github.com/miru-project/miru-core/ent/favoritegroup"
 	"github.com/miru-project/miru-core/ent/history"
@@ -82,16 +88,22 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			appsetting.Table:           appsetting.ValidColumn,
-			detail.Table:               detail.ValidColumn,
-			download.Table:             download.ValidColumn,
-			extensionreposetting.Table: extensionreposetting.ValidColumn,
-			extensionsetting.Table:     extensionsetting.ValidColumn,
-			favorite.Table:             favorite.ValidColumn,
-			favoritegroup.Table:        favoritegroup.ValidColumn,
-			history.Table:              history.ValidColumn,
-			track.Table:                track.ValidColumn,
-			tracker.Table:              tracker.ValidColumn,
+			appsetting.Table:                   appsetting.ValidColumn,
+			detail.Table:                       detail.ValidColumn,
+			download.Table:                     download.ValidColumn,
+			episodeupdate.Table:                episodeupdate.ValidColumn,
+			extensiongrant.Table:               extensiongrant.ValidColumn,
+			extensionreposetting.Table:         extensionreposetting.ValidColumn,
+			extensionsetting.Table:             extensionsetting.ValidColumn,
+			extensionstate.Table:               extensionstate.ValidColumn,
+			extensionstorage.Table:             extensionstorage.ValidColumn,
+			extensionverification.Table:        extensionverification.ValidColumn,
+			extensionverificationfailure.Table: extensionverificationfailure.ValidColumn,
+			favorite.Table:                     favorite.ValidColumn,
+			favoritegroup.Table:                favoritegroup.ValidColumn,
+			history.Table:                      history.ValidColumn,
+			track.Table:                        track.ValidColumn,
+			tracker.Table:                      tracker.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
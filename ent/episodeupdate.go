@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+)
+
+// EpisodeUpdate is the model entity for the EpisodeUpdate schema.
+type EpisodeUpdate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The package identifier
+	Package string `json:"package,omitempty"`
+	// The Detail URL of the favorite
+	DetailURL string `json:"detail_url,omitempty"`
+	// Title of the favorite
+	Title string `json:"title,omitempty"`
+	// Title of the episode group the episode is in
+	GroupTitle string `json:"group_title,omitempty"`
+	// Name of the episode
+	Name string `json:"name,omitempty"`
+	// Watch URL of the episode
+	URL string `json:"url,omitempty"`
+	// Date when the episode was found
+	Date         time.Time `json:"date,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EpisodeUpdate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case episodeupdate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case episodeupdate.FieldPackage, episodeupdate.FieldDetailURL, episodeupdate.FieldTitle, episodeupdate.FieldGroupTitle, episodeupdate.FieldName, episodeupdate.FieldURL:
+			values[i] = new(sql.NullString)
+		case episodeupdate.FieldDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EpisodeUpdate fields.
+func (_m *EpisodeUpdate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case episodeupdate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case episodeupdate.FieldPackage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package", values[i])
+			} else if value.Valid {
+				_m.Package = value.String
+			}
+		case episodeupdate.FieldDetailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detail_url", values[i])
+			} else if value.Valid {
+				_m.DetailURL = value.String
+			}
+		case episodeupdate.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case episodeupdate.FieldGroupTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_title", values[i])
+			} else if value.Valid {
+				_m.GroupTitle = value.String
+			}
+		case episodeupdate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case episodeupdate.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case episodeupdate.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EpisodeUpdate.
+// This includes values selected through modifiers, order, etc.
+func (_m *EpisodeUpdate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EpisodeUpdate.
+// Note that you need to call EpisodeUpdate.Unwrap() before calling this method if this EpisodeUpdate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EpisodeUpdate) Update() *EpisodeUpdateUpdateOne {
+	return NewEpisodeUpdateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EpisodeUpdate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EpisodeUpdate) Unwrap() *EpisodeUpdate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EpisodeUpdate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EpisodeUpdate) String() string {
+	var builder strings.Builder
+	builder.WriteString("EpisodeUpdate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("package=")
+	builder.WriteString(_m.Package)
+	builder.WriteString(", ")
+	builder.WriteString("detail_url=")
+	builder.WriteString(_m.DetailURL)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("group_title=")
+	builder.WriteString(_m.GroupTitle)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EpisodeUpdates is a parsable slice of EpisodeUpdate.
+type EpisodeUpdates []*EpisodeUpdate
@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package episodeupdate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the episodeupdate type in the database.
+	Label = "episode_update"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackage holds the string denoting the package field in the database.
+	FieldPackage = "package"
+	// FieldDetailURL holds the string denoting the detail_url field in the database.
+	FieldDetailURL = "detail_url"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldGroupTitle holds the string denoting the group_title field in the database.
+	FieldGroupTitle = "group_title"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// Table holds the table name of the episodeupdate in the database.
+	Table = "episode_updates"
+)
+
+// Columns holds all SQL columns for episodeupdate fields.
+var Columns = []string{
+	FieldID,
+	FieldPackage,
+	FieldDetailURL,
+	FieldTitle,
+	FieldGroupTitle,
+	FieldName,
+	FieldURL,
+	FieldDate,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	PackageValidator func(string) error
+	// DetailURLValidator is a validator for the "detail_url" field. It is called by the builders before save.
+	DetailURLValidator func(string) error
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// DefaultDate holds the default value on creation for the "date" field.
+	DefaultDate func() time.Time
+)
+
+// OrderOption defines the ordering options for the EpisodeUpdate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPackage orders the results by the package field.
+func ByPackage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackage, opts...).ToFunc()
+}
+
+// ByDetailURL orders the results by the detail_url field.
+func ByDetailURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetailURL, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByGroupTitle orders the results by the group_title field.
+func ByGroupTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupTitle, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package episodeupdate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLTE(FieldID, id))
+}
+
+// Package applies equality check predicate on the "package" field. It's identical to PackageEQ.
+func Package(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldPackage, v))
+}
+
+// DetailURL applies equality check predicate on the "detail_url" field. It's identical to DetailURLEQ.
+func DetailURL(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldDetailURL, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldTitle, v))
+}
+
+// GroupTitle applies equality check predicate on the "group_title" field. It's identical to GroupTitleEQ.
+func GroupTitle(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldGroupTitle, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldName, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldURL, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldDate, v))
+}
+
+// PackageEQ applies the EQ predicate on the "package" field.
+func PackageEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldPackage, v))
+}
+
+// PackageNEQ applies the NEQ predicate on the "package" field.
+func PackageNEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNEQ(FieldPackage, v))
+}
+
+// PackageIn applies the In predicate on the "package" field.
+func PackageIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldIn(FieldPackage, vs...))
+}
+
+// PackageNotIn applies the NotIn predicate on the "package" field.
+func PackageNotIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNotIn(FieldPackage, vs...))
+}
+
+// PackageGT applies the GT predicate on the "package" field.
+func PackageGT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGT(FieldPackage, v))
+}
+
+// PackageGTE applies the GTE predicate on the "package" field.
+func PackageGTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGTE(FieldPackage, v))
+}
+
+// PackageLT applies the LT predicate on the "package" field.
+func PackageLT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLT(FieldPackage, v))
+}
+
+// PackageLTE applies the LTE predicate on the "package" field.
+func PackageLTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLTE(FieldPackage, v))
+}
+
+// PackageContains applies the Contains predicate on the "package" field.
+func PackageContains(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContains(FieldPackage, v))
+}
+
+// PackageHasPrefix applies the HasPrefix predicate on the "package" field.
+func PackageHasPrefix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasPrefix(FieldPackage, v))
+}
+
+// PackageHasSuffix applies the HasSuffix predicate on the "package" field.
+func PackageHasSuffix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasSuffix(FieldPackage, v))
+}
+
+// PackageEqualFold applies the EqualFold predicate on the "package" field.
+func PackageEqualFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEqualFold(FieldPackage, v))
+}
+
+// PackageContainsFold applies the ContainsFold predicate on the "package" field.
+func PackageContainsFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContainsFold(FieldPackage, v))
+}
+
+// DetailURLEQ applies the EQ predicate on the "detail_url" field.
+func DetailURLEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldDetailURL, v))
+}
+
+// DetailURLNEQ applies the NEQ predicate on the "detail_url" field.
+func DetailURLNEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNEQ(FieldDetailURL, v))
+}
+
+// DetailURLIn applies the In predicate on the "detail_url" field.
+func DetailURLIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldIn(FieldDetailURL, vs...))
+}
+
+// DetailURLNotIn applies the NotIn predicate on the "detail_url" field.
+func DetailURLNotIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNotIn(FieldDetailURL, vs...))
+}
+
+// DetailURLGT applies the GT predicate on the "detail_url" field.
+func DetailURLGT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGT(FieldDetailURL, v))
+}
+
+// DetailURLGTE applies the GTE predicate on the "detail_url" field.
+func DetailURLGTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGTE(FieldDetailURL, v))
+}
+
+// DetailURLLT applies the LT predicate on the "detail_url" field.
+func DetailURLLT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLT(FieldDetailURL, v))
+}
+
+// DetailURLLTE applies the LTE predicate on the "detail_url" field.
+func DetailURLLTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLTE(FieldDetailURL, v))
+}
+
+// DetailURLContains applies the Contains predicate on the "detail_url" field.
+func DetailURLContains(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContains(FieldDetailURL, v))
+}
+
+// DetailURLHasPrefix applies the HasPrefix predicate on the "detail_url" field.
+func DetailURLHasPrefix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasPrefix(FieldDetailURL, v))
+}
+
+// DetailURLHasSuffix applies the HasSuffix predicate on the "detail_url" field.
+func DetailURLHasSuffix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasSuffix(FieldDetailURL, v))
+}
+
+// DetailURLEqualFold applies the EqualFold predicate on the "detail_url" field.
+func DetailURLEqualFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEqualFold(FieldDetailURL, v))
+}
+
+// DetailURLContainsFold applies the ContainsFold predicate on the "detail_url" field.
+func DetailURLContainsFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContainsFold(FieldDetailURL, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// GroupTitleEQ applies the EQ predicate on the "group_title" field.
+func GroupTitleEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldGroupTitle, v))
+}
+
+// GroupTitleNEQ applies the NEQ predicate on the "group_title" field.
+func GroupTitleNEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNEQ(FieldGroupTitle, v))
+}
+
+// GroupTitleIn applies the In predicate on the "group_title" field.
+func GroupTitleIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldIn(FieldGroupTitle, vs...))
+}
+
+// GroupTitleNotIn applies the NotIn predicate on the "group_title" field.
+func GroupTitleNotIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNotIn(FieldGroupTitle, vs...))
+}
+
+// GroupTitleGT applies the GT predicate on the "group_title" field.
+func GroupTitleGT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGT(FieldGroupTitle, v))
+}
+
+// GroupTitleGTE applies the GTE predicate on the "group_title" field.
+func GroupTitleGTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGTE(FieldGroupTitle, v))
+}
+
+// GroupTitleLT applies the LT predicate on the "group_title" field.
+func GroupTitleLT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLT(FieldGroupTitle, v))
+}
+
+// GroupTitleLTE applies the LTE predicate on the "group_title" field.
+func GroupTitleLTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLTE(FieldGroupTitle, v))
+}
+
+// GroupTitleContains applies the Contains predicate on the "group_title" field.
+func GroupTitleContains(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContains(FieldGroupTitle, v))
+}
+
+// GroupTitleHasPrefix applies the HasPrefix predicate on the "group_title" field.
+func GroupTitleHasPrefix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasPrefix(FieldGroupTitle, v))
+}
+
+// GroupTitleHasSuffix applies the HasSuffix predicate on the "group_title" field.
+func GroupTitleHasSuffix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasSuffix(FieldGroupTitle, v))
+}
+
+// GroupTitleEqualFold applies the EqualFold predicate on the "group_title" field.
+func GroupTitleEqualFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEqualFold(FieldGroupTitle, v))
+}
+
+// GroupTitleContainsFold applies the ContainsFold predicate on the "group_title" field.
+func GroupTitleContainsFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContainsFold(FieldGroupTitle, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContainsFold(FieldName, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldContainsFold(FieldURL, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.FieldLTE(FieldDate, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EpisodeUpdate) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EpisodeUpdate) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EpisodeUpdate) predicate.EpisodeUpdate {
+	return predicate.EpisodeUpdate(sql.NotPredicates(p))
+}
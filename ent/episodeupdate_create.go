@@ -0,0 +1,806 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+)
+
+// EpisodeUpdateCreate is the builder for creating a EpisodeUpdate entity.
+type EpisodeUpdateCreate struct {
+	config
+	mutation *EpisodeUpdateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackage sets the "package" field.
+func (_c *EpisodeUpdateCreate) SetPackage(v string) *EpisodeUpdateCreate {
+	_c.mutation.SetPackage(v)
+	return _c
+}
+
+// SetDetailURL sets the "detail_url" field.
+func (_c *EpisodeUpdateCreate) SetDetailURL(v string) *EpisodeUpdateCreate {
+	_c.mutation.SetDetailURL(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *EpisodeUpdateCreate) SetTitle(v string) *EpisodeUpdateCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetGroupTitle sets the "group_title" field.
+func (_c *EpisodeUpdateCreate) SetGroupTitle(v string) *EpisodeUpdateCreate {
+	_c.mutation.SetGroupTitle(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *EpisodeUpdateCreate) SetName(v string) *EpisodeUpdateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *EpisodeUpdateCreate) SetURL(v string) *EpisodeUpdateCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *EpisodeUpdateCreate) SetDate(v time.Time) *EpisodeUpdateCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_c *EpisodeUpdateCreate) SetNillableDate(v *time.Time) *EpisodeUpdateCreate {
+	if v != nil {
+		_c.SetDate(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EpisodeUpdateMutation object of the builder.
+func (_c *EpisodeUpdateCreate) Mutation() *EpisodeUpdateMutation {
+	return _c.mutation
+}
+
+// Save creates the EpisodeUpdate in the database.
+func (_c *EpisodeUpdateCreate) Save(ctx context.Context) (*EpisodeUpdate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EpisodeUpdateCreate) SaveX(ctx context.Context) *EpisodeUpdate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EpisodeUpdateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EpisodeUpdateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EpisodeUpdateCreate) defaults() {
+	if _, ok := _c.mutation.Date(); !ok {
+		v := episodeupdate.DefaultDate()
+		_c.mutation.SetDate(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EpisodeUpdateCreate) check() error {
+	if _, ok := _c.mutation.Package(); !ok {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required field "EpisodeUpdate.package"`)}
+	}
+	if v, ok := _c.mutation.Package(); ok {
+		if err := episodeupdate.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.package": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DetailURL(); !ok {
+		return &ValidationError{Name: "detail_url", err: errors.New(`ent: missing required field "EpisodeUpdate.detail_url"`)}
+	}
+	if v, ok := _c.mutation.DetailURL(); ok {
+		if err := episodeupdate.DetailURLValidator(v); err != nil {
+			return &ValidationError{Name: "detail_url", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.detail_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "EpisodeUpdate.title"`)}
+	}
+	if _, ok := _c.mutation.GroupTitle(); !ok {
+		return &ValidationError{Name: "group_title", err: errors.New(`ent: missing required field "EpisodeUpdate.group_title"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EpisodeUpdate.name"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "EpisodeUpdate.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := episodeupdate.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "EpisodeUpdate.date"`)}
+	}
+	return nil
+}
+
+func (_c *EpisodeUpdateCreate) sqlSave(ctx context.Context) (*EpisodeUpdate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EpisodeUpdateCreate) createSpec() (*EpisodeUpdate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EpisodeUpdate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(episodeupdate.Table, sqlgraph.NewFieldSpec(episodeupdate.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Package(); ok {
+		_spec.SetField(episodeupdate.FieldPackage, field.TypeString, value)
+		_node.Package = value
+	}
+	if value, ok := _c.mutation.DetailURL(); ok {
+		_spec.SetField(episodeupdate.FieldDetailURL, field.TypeString, value)
+		_node.DetailURL = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(episodeupdate.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.GroupTitle(); ok {
+		_spec.SetField(episodeupdate.FieldGroupTitle, field.TypeString, value)
+		_node.GroupTitle = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(episodeupdate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(episodeupdate.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(episodeupdate.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EpisodeUpdate.Create().
+//		SetPackage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EpisodeUpdateUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EpisodeUpdateCreate) OnConflict(opts ...sql.ConflictOption) *EpisodeUpdateUpsertOne {
+	_c.conflict = opts
+	return &EpisodeUpdateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EpisodeUpdate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EpisodeUpdateCreate) OnConflictColumns(columns ...string) *EpisodeUpdateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EpisodeUpdateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EpisodeUpdateUpsertOne is the builder for "upsert"-ing
+	//  one EpisodeUpdate node.
+	EpisodeUpdateUpsertOne struct {
+		create *EpisodeUpdateCreate
+	}
+
+	// EpisodeUpdateUpsert is the "OnConflict" setter.
+	EpisodeUpdateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackage sets the "package" field.
+func (u *EpisodeUpdateUpsert) SetPackage(v string) *EpisodeUpdateUpsert {
+	u.Set(episodeupdate.FieldPackage, v)
+	return u
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsert) UpdatePackage() *EpisodeUpdateUpsert {
+	u.SetExcluded(episodeupdate.FieldPackage)
+	return u
+}
+
+// SetDetailURL sets the "detail_url" field.
+func (u *EpisodeUpdateUpsert) SetDetailURL(v string) *EpisodeUpdateUpsert {
+	u.Set(episodeupdate.FieldDetailURL, v)
+	return u
+}
+
+// UpdateDetailURL sets the "detail_url" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsert) UpdateDetailURL() *EpisodeUpdateUpsert {
+	u.SetExcluded(episodeupdate.FieldDetailURL)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *EpisodeUpdateUpsert) SetTitle(v string) *EpisodeUpdateUpsert {
+	u.Set(episodeupdate.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsert) UpdateTitle() *EpisodeUpdateUpsert {
+	u.SetExcluded(episodeupdate.FieldTitle)
+	return u
+}
+
+// SetGroupTitle sets the "group_title" field.
+func (u *EpisodeUpdateUpsert) SetGroupTitle(v string) *EpisodeUpdateUpsert {
+	u.Set(episodeupdate.FieldGroupTitle, v)
+	return u
+}
+
+// UpdateGroupTitle sets the "group_title" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsert) UpdateGroupTitle() *EpisodeUpdateUpsert {
+	u.SetExcluded(episodeupdate.FieldGroupTitle)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EpisodeUpdateUpsert) SetName(v string) *EpisodeUpdateUpsert {
+	u.Set(episodeupdate.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsert) UpdateName() *EpisodeUpdateUpsert {
+	u.SetExcluded(episodeupdate.FieldName)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *EpisodeUpdateUpsert) SetURL(v string) *EpisodeUpdateUpsert {
+	u.Set(episodeupdate.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsert) UpdateURL() *EpisodeUpdateUpsert {
+	u.SetExcluded(episodeupdate.FieldURL)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *EpisodeUpdateUpsert) SetDate(v time.Time) *EpisodeUpdateUpsert {
+	u.Set(episodeupdate.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsert) UpdateDate() *EpisodeUpdateUpsert {
+	u.SetExcluded(episodeupdate.FieldDate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EpisodeUpdate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EpisodeUpdateUpsertOne) UpdateNewValues() *EpisodeUpdateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EpisodeUpdate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EpisodeUpdateUpsertOne) Ignore() *EpisodeUpdateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EpisodeUpdateUpsertOne) DoNothing() *EpisodeUpdateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EpisodeUpdateCreate.OnConflict
+// documentation for more info.
+func (u *EpisodeUpdateUpsertOne) Update(set func(*EpisodeUpdateUpsert)) *EpisodeUpdateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EpisodeUpdateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *EpisodeUpdateUpsertOne) SetPackage(v string) *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertOne) UpdatePackage() *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetDetailURL sets the "detail_url" field.
+func (u *EpisodeUpdateUpsertOne) SetDetailURL(v string) *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetDetailURL(v)
+	})
+}
+
+// UpdateDetailURL sets the "detail_url" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertOne) UpdateDetailURL() *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateDetailURL()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *EpisodeUpdateUpsertOne) SetTitle(v string) *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertOne) UpdateTitle() *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetGroupTitle sets the "group_title" field.
+func (u *EpisodeUpdateUpsertOne) SetGroupTitle(v string) *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetGroupTitle(v)
+	})
+}
+
+// UpdateGroupTitle sets the "group_title" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertOne) UpdateGroupTitle() *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateGroupTitle()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EpisodeUpdateUpsertOne) SetName(v string) *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertOne) UpdateName() *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *EpisodeUpdateUpsertOne) SetURL(v string) *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertOne) UpdateURL() *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *EpisodeUpdateUpsertOne) SetDate(v time.Time) *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertOne) UpdateDate() *EpisodeUpdateUpsertOne {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// Exec executes the query.
+func (u *EpisodeUpdateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EpisodeUpdateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EpisodeUpdateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EpisodeUpdateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EpisodeUpdateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EpisodeUpdateCreateBulk is the builder for creating many EpisodeUpdate entities in bulk.
+type EpisodeUpdateCreateBulk struct {
+	config
+	err      error
+	builders []*EpisodeUpdateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EpisodeUpdate entities in the database.
+func (_c *EpisodeUpdateCreateBulk) Save(ctx context.Context) ([]*EpisodeUpdate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EpisodeUpdate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EpisodeUpdateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EpisodeUpdateCreateBulk) SaveX(ctx context.Context) []*EpisodeUpdate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EpisodeUpdateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EpisodeUpdateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EpisodeUpdate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EpisodeUpdateUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EpisodeUpdateCreateBulk) OnConflict(opts ...sql.ConflictOption) *EpisodeUpdateUpsertBulk {
+	_c.conflict = opts
+	return &EpisodeUpdateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EpisodeUpdate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EpisodeUpdateCreateBulk) OnConflictColumns(columns ...string) *EpisodeUpdateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EpisodeUpdateUpsertBulk{
+		create: _c,
+	}
+}
+
+// EpisodeUpdateUpsertBulk is the builder for "upsert"-ing
+// a bulk of EpisodeUpdate nodes.
+type EpisodeUpdateUpsertBulk struct {
+	create *EpisodeUpdateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EpisodeUpdate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EpisodeUpdateUpsertBulk) UpdateNewValues() *EpisodeUpdateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EpisodeUpdate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EpisodeUpdateUpsertBulk) Ignore() *EpisodeUpdateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EpisodeUpdateUpsertBulk) DoNothing() *EpisodeUpdateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EpisodeUpdateCreateBulk.OnConflict
+// documentation for more info.
+func (u *EpisodeUpdateUpsertBulk) Update(set func(*EpisodeUpdateUpsert)) *EpisodeUpdateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EpisodeUpdateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *EpisodeUpdateUpsertBulk) SetPackage(v string) *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertBulk) UpdatePackage() *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetDetailURL sets the "detail_url" field.
+func (u *EpisodeUpdateUpsertBulk) SetDetailURL(v string) *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetDetailURL(v)
+	})
+}
+
+// UpdateDetailURL sets the "detail_url" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertBulk) UpdateDetailURL() *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateDetailURL()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *EpisodeUpdateUpsertBulk) SetTitle(v string) *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertBulk) UpdateTitle() *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetGroupTitle sets the "group_title" field.
+func (u *EpisodeUpdateUpsertBulk) SetGroupTitle(v string) *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetGroupTitle(v)
+	})
+}
+
+// UpdateGroupTitle sets the "group_title" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertBulk) UpdateGroupTitle() *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateGroupTitle()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EpisodeUpdateUpsertBulk) SetName(v string) *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertBulk) UpdateName() *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *EpisodeUpdateUpsertBulk) SetURL(v string) *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertBulk) UpdateURL() *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *EpisodeUpdateUpsertBulk) SetDate(v time.Time) *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *EpisodeUpdateUpsertBulk) UpdateDate() *EpisodeUpdateUpsertBulk {
+	return u.Update(func(s *EpisodeUpdateUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// Exec executes the query.
+func (u *EpisodeUpdateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EpisodeUpdateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EpisodeUpdateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EpisodeUpdateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
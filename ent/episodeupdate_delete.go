@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// EpisodeUpdateDelete is the builder for deleting a EpisodeUpdate entity.
+type EpisodeUpdateDelete struct {
+	config
+	hooks    []Hook
+	mutation *EpisodeUpdateMutation
+}
+
+// Where appends a list predicates to the EpisodeUpdateDelete builder.
+func (_d *EpisodeUpdateDelete) Where(ps ...predicate.EpisodeUpdate) *EpisodeUpdateDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EpisodeUpdateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EpisodeUpdateDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EpisodeUpdateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(episodeupdate.Table, sqlgraph.NewFieldSpec(episodeupdate.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EpisodeUpdateDeleteOne is the builder for deleting a single EpisodeUpdate entity.
+type EpisodeUpdateDeleteOne struct {
+	_d *EpisodeUpdateDelete
+}
+
+// Where appends a list predicates to the EpisodeUpdateDelete builder.
+func (_d *EpisodeUpdateDeleteOne) Where(ps ...predicate.EpisodeUpdate) *EpisodeUpdateDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EpisodeUpdateDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{episodeupdate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EpisodeUpdateDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// EpisodeUpdateUpdate is the builder for updating EpisodeUpdate entities.
+type EpisodeUpdateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EpisodeUpdateMutation
+}
+
+// Where appends a list predicates to the EpisodeUpdateUpdate builder.
+func (_u *EpisodeUpdateUpdate) Where(ps ...predicate.EpisodeUpdate) *EpisodeUpdateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPackage sets the "package" field.
+func (_u *EpisodeUpdateUpdate) SetPackage(v string) *EpisodeUpdateUpdate {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdate) SetNillablePackage(v *string) *EpisodeUpdateUpdate {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetDetailURL sets the "detail_url" field.
+func (_u *EpisodeUpdateUpdate) SetDetailURL(v string) *EpisodeUpdateUpdate {
+	_u.mutation.SetDetailURL(v)
+	return _u
+}
+
+// SetNillableDetailURL sets the "detail_url" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdate) SetNillableDetailURL(v *string) *EpisodeUpdateUpdate {
+	if v != nil {
+		_u.SetDetailURL(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *EpisodeUpdateUpdate) SetTitle(v string) *EpisodeUpdateUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdate) SetNillableTitle(v *string) *EpisodeUpdateUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetGroupTitle sets the "group_title" field.
+func (_u *EpisodeUpdateUpdate) SetGroupTitle(v string) *EpisodeUpdateUpdate {
+	_u.mutation.SetGroupTitle(v)
+	return _u
+}
+
+// SetNillableGroupTitle sets the "group_title" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdate) SetNillableGroupTitle(v *string) *EpisodeUpdateUpdate {
+	if v != nil {
+		_u.SetGroupTitle(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EpisodeUpdateUpdate) SetName(v string) *EpisodeUpdateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdate) SetNillableName(v *string) *EpisodeUpdateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *EpisodeUpdateUpdate) SetURL(v string) *EpisodeUpdateUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdate) SetNillableURL(v *string) *EpisodeUpdateUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *EpisodeUpdateUpdate) SetDate(v time.Time) *EpisodeUpdateUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdate) SetNillableDate(v *time.Time) *EpisodeUpdateUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EpisodeUpdateMutation object of the builder.
+func (_u *EpisodeUpdateUpdate) Mutation() *EpisodeUpdateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EpisodeUpdateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EpisodeUpdateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EpisodeUpdateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EpisodeUpdateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EpisodeUpdateUpdate) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := episodeupdate.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DetailURL(); ok {
+		if err := episodeupdate.DetailURLValidator(v); err != nil {
+			return &ValidationError{Name: "detail_url", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.detail_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := episodeupdate.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EpisodeUpdateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(episodeupdate.Table, episodeupdate.Columns, sqlgraph.NewFieldSpec(episodeupdate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(episodeupdate.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DetailURL(); ok {
+		_spec.SetField(episodeupdate.FieldDetailURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(episodeupdate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupTitle(); ok {
+		_spec.SetField(episodeupdate.FieldGroupTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(episodeupdate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(episodeupdate.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(episodeupdate.FieldDate, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{episodeupdate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EpisodeUpdateUpdateOne is the builder for updating a single EpisodeUpdate entity.
+type EpisodeUpdateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EpisodeUpdateMutation
+}
+
+// SetPackage sets the "package" field.
+func (_u *EpisodeUpdateUpdateOne) SetPackage(v string) *EpisodeUpdateUpdateOne {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdateOne) SetNillablePackage(v *string) *EpisodeUpdateUpdateOne {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetDetailURL sets the "detail_url" field.
+func (_u *EpisodeUpdateUpdateOne) SetDetailURL(v string) *EpisodeUpdateUpdateOne {
+	_u.mutation.SetDetailURL(v)
+	return _u
+}
+
+// SetNillableDetailURL sets the "detail_url" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdateOne) SetNillableDetailURL(v *string) *EpisodeUpdateUpdateOne {
+	if v != nil {
+		_u.SetDetailURL(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *EpisodeUpdateUpdateOne) SetTitle(v string) *EpisodeUpdateUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdateOne) SetNillableTitle(v *string) *EpisodeUpdateUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetGroupTitle sets the "group_title" field.
+func (_u *EpisodeUpdateUpdateOne) SetGroupTitle(v string) *EpisodeUpdateUpdateOne {
+	_u.mutation.SetGroupTitle(v)
+	return _u
+}
+
+// SetNillableGroupTitle sets the "group_title" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdateOne) SetNillableGroupTitle(v *string) *EpisodeUpdateUpdateOne {
+	if v != nil {
+		_u.SetGroupTitle(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EpisodeUpdateUpdateOne) SetName(v string) *EpisodeUpdateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdateOne) SetNillableName(v *string) *EpisodeUpdateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *EpisodeUpdateUpdateOne) SetURL(v string) *EpisodeUpdateUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdateOne) SetNillableURL(v *string) *EpisodeUpdateUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *EpisodeUpdateUpdateOne) SetDate(v time.Time) *EpisodeUpdateUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *EpisodeUpdateUpdateOne) SetNillableDate(v *time.Time) *EpisodeUpdateUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EpisodeUpdateMutation object of the builder.
+func (_u *EpisodeUpdateUpdateOne) Mutation() *EpisodeUpdateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EpisodeUpdateUpdate builder.
+func (_u *EpisodeUpdateUpdateOne) Where(ps ...predicate.EpisodeUpdate) *EpisodeUpdateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EpisodeUpdateUpdateOne) Select(field string, fields ...string) *EpisodeUpdateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EpisodeUpdate entity.
+func (_u *EpisodeUpdateUpdateOne) Save(ctx context.Context) (*EpisodeUpdate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EpisodeUpdateUpdateOne) SaveX(ctx context.Context) *EpisodeUpdate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EpisodeUpdateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EpisodeUpdateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EpisodeUpdateUpdateOne) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := episodeupdate.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DetailURL(); ok {
+		if err := episodeupdate.DetailURLValidator(v); err != nil {
+			return &ValidationError{Name: "detail_url", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.detail_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := episodeupdate.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "EpisodeUpdate.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EpisodeUpdateUpdateOne) sqlSave(ctx context.Context) (_node *EpisodeUpdate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(episodeupdate.Table, episodeupdate.Columns, sqlgraph.NewFieldSpec(episodeupdate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EpisodeUpdate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, episodeupdate.FieldID)
+		for _, f := range fields {
+			if !episodeupdate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != episodeupdate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(episodeupdate.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DetailURL(); ok {
+		_spec.SetField(episodeupdate.FieldDetailURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(episodeupdate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupTitle(); ok {
+		_spec.SetField(episodeupdate.FieldGroupTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(episodeupdate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(episodeupdate.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(episodeupdate.FieldDate, field.TypeTime, value)
+	}
+	_node = &EpisodeUpdate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{episodeupdate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/extensiongrant"
+)
+
+// ExtensionGrant is the model entity for the ExtensionGrant schema.
+type ExtensionGrant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Package name of the extension
+	Package string `json:"package,omitempty"`
+	// Granted host, *.example.com matches subdomains
+	Domain       string `json:"domain,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExtensionGrant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case extensiongrant.FieldID:
+			values[i] = new(sql.NullInt64)
+		case extensiongrant.FieldPackage, extensiongrant.FieldDomain:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExtensionGrant fields.
+func (_m *ExtensionGrant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case extensiongrant.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case extensiongrant.FieldPackage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package", values[i])
+			} else if value.Valid {
+				_m.Package = value.String
+			}
+		case extensiongrant.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				_m.Domain = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExtensionGrant.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExtensionGrant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExtensionGrant.
+// Note that you need to call ExtensionGrant.Unwrap() before calling this method if this ExtensionGrant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExtensionGrant) Update() *ExtensionGrantUpdateOne {
+	return NewExtensionGrantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExtensionGrant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExtensionGrant) Unwrap() *ExtensionGrant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExtensionGrant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExtensionGrant) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExtensionGrant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("package=")
+	builder.WriteString(_m.Package)
+	builder.WriteString(", ")
+	builder.WriteString("domain=")
+	builder.WriteString(_m.Domain)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExtensionGrants is a parsable slice of ExtensionGrant.
+type ExtensionGrants []*ExtensionGrant
@@ -0,0 +1,62 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extensiongrant
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the extensiongrant type in the database.
+	Label = "extension_grant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackage holds the string denoting the package field in the database.
+	FieldPackage = "package"
+	// FieldDomain holds the string denoting the domain field in the database.
+	FieldDomain = "domain"
+	// Table holds the table name of the extensiongrant in the database.
+	Table = "extension_grants"
+)
+
+// Columns holds all SQL columns for extensiongrant fields.
+var Columns = []string{
+	FieldID,
+	FieldPackage,
+	FieldDomain,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	PackageValidator func(string) error
+	// DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	DomainValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ExtensionGrant queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPackage orders the results by the package field.
+func ByPackage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackage, opts...).ToFunc()
+}
+
+// ByDomain orders the results by the domain field.
+func ByDomain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomain, opts...).ToFunc()
+}
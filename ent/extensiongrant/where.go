@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extensiongrant
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldLTE(FieldID, id))
+}
+
+// Package applies equality check predicate on the "package" field. It's identical to PackageEQ.
+func Package(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldEQ(FieldPackage, v))
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldEQ(FieldDomain, v))
+}
+
+// PackageEQ applies the EQ predicate on the "package" field.
+func PackageEQ(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldEQ(FieldPackage, v))
+}
+
+// PackageNEQ applies the NEQ predicate on the "package" field.
+func PackageNEQ(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldNEQ(FieldPackage, v))
+}
+
+// PackageIn applies the In predicate on the "package" field.
+func PackageIn(vs ...string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldIn(FieldPackage, vs...))
+}
+
+// PackageNotIn applies the NotIn predicate on the "package" field.
+func PackageNotIn(vs ...string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldNotIn(FieldPackage, vs...))
+}
+
+// PackageGT applies the GT predicate on the "package" field.
+func PackageGT(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldGT(FieldPackage, v))
+}
+
+// PackageGTE applies the GTE predicate on the "package" field.
+func PackageGTE(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldGTE(FieldPackage, v))
+}
+
+// PackageLT applies the LT predicate on the "package" field.
+func PackageLT(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldLT(FieldPackage, v))
+}
+
+// PackageLTE applies the LTE predicate on the "package" field.
+func PackageLTE(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldLTE(FieldPackage, v))
+}
+
+// PackageContains applies the Contains predicate on the "package" field.
+func PackageContains(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldContains(FieldPackage, v))
+}
+
+// PackageHasPrefix applies the HasPrefix predicate on the "package" field.
+func PackageHasPrefix(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldHasPrefix(FieldPackage, v))
+}
+
+// PackageHasSuffix applies the HasSuffix predicate on the "package" field.
+func PackageHasSuffix(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldHasSuffix(FieldPackage, v))
+}
+
+// PackageEqualFold applies the EqualFold predicate on the "package" field.
+func PackageEqualFold(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldEqualFold(FieldPackage, v))
+}
+
+// PackageContainsFold applies the ContainsFold predicate on the "package" field.
+func PackageContainsFold(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldContainsFold(FieldPackage, v))
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldEQ(FieldDomain, v))
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldNEQ(FieldDomain, v))
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldIn(FieldDomain, vs...))
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldNotIn(FieldDomain, vs...))
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldGT(FieldDomain, v))
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldGTE(FieldDomain, v))
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldLT(FieldDomain, v))
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldLTE(FieldDomain, v))
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldContains(FieldDomain, v))
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldHasPrefix(FieldDomain, v))
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldHasSuffix(FieldDomain, v))
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldEqualFold(FieldDomain, v))
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.FieldContainsFold(FieldDomain, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExtensionGrant) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExtensionGrant) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExtensionGrant) predicate.ExtensionGrant {
+	return predicate.ExtensionGrant(sql.NotPredicates(p))
+}
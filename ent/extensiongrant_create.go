@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensiongrant"
+)
+
+// ExtensionGrantCreate is the builder for creating a ExtensionGrant entity.
+type ExtensionGrantCreate struct {
+	config
+	mutation *ExtensionGrantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackage sets the "package" field.
+func (_c *ExtensionGrantCreate) SetPackage(v string) *ExtensionGrantCreate {
+	_c.mutation.SetPackage(v)
+	return _c
+}
+
+// SetDomain sets the "domain" field.
+func (_c *ExtensionGrantCreate) SetDomain(v string) *ExtensionGrantCreate {
+	_c.mutation.SetDomain(v)
+	return _c
+}
+
+// Mutation returns the ExtensionGrantMutation object of the builder.
+func (_c *ExtensionGrantCreate) Mutation() *ExtensionGrantMutation {
+	return _c.mutation
+}
+
+// Save creates the ExtensionGrant in the database.
+func (_c *ExtensionGrantCreate) Save(ctx context.Context) (*ExtensionGrant, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExtensionGrantCreate) SaveX(ctx context.Context) *ExtensionGrant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionGrantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionGrantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExtensionGrantCreate) check() error {
+	if _, ok := _c.mutation.Package(); !ok {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required field "ExtensionGrant.package"`)}
+	}
+	if v, ok := _c.mutation.Package(); ok {
+		if err := extensiongrant.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionGrant.package": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Domain(); !ok {
+		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required field "ExtensionGrant.domain"`)}
+	}
+	if v, ok := _c.mutation.Domain(); ok {
+		if err := extensiongrant.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "ExtensionGrant.domain": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ExtensionGrantCreate) sqlSave(ctx context.Context) (*ExtensionGrant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExtensionGrantCreate) createSpec() (*ExtensionGrant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExtensionGrant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(extensiongrant.Table, sqlgraph.NewFieldSpec(extensiongrant.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Package(); ok {
+		_spec.SetField(extensiongrant.FieldPackage, field.TypeString, value)
+		_node.Package = value
+	}
+	if value, ok := _c.mutation.Domain(); ok {
+		_spec.SetField(extensiongrant.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionGrant.Create().
+//		SetPackage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionGrantUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionGrantCreate) OnConflict(opts ...sql.ConflictOption) *ExtensionGrantUpsertOne {
+	_c.conflict = opts
+	return &ExtensionGrantUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionGrant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionGrantCreate) OnConflictColumns(columns ...string) *ExtensionGrantUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionGrantUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExtensionGrantUpsertOne is the builder for "upsert"-ing
+	//  one ExtensionGrant node.
+	ExtensionGrantUpsertOne struct {
+		create *ExtensionGrantCreate
+	}
+
+	// ExtensionGrantUpsert is the "OnConflict" setter.
+	ExtensionGrantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackage sets the "package" field.
+func (u *ExtensionGrantUpsert) SetPackage(v string) *ExtensionGrantUpsert {
+	u.Set(extensiongrant.FieldPackage, v)
+	return u
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionGrantUpsert) UpdatePackage() *ExtensionGrantUpsert {
+	u.SetExcluded(extensiongrant.FieldPackage)
+	return u
+}
+
+// SetDomain sets the "domain" field.
+func (u *ExtensionGrantUpsert) SetDomain(v string) *ExtensionGrantUpsert {
+	u.Set(extensiongrant.FieldDomain, v)
+	return u
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ExtensionGrantUpsert) UpdateDomain() *ExtensionGrantUpsert {
+	u.SetExcluded(extensiongrant.FieldDomain)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionGrant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionGrantUpsertOne) UpdateNewValues() *ExtensionGrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionGrant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExtensionGrantUpsertOne) Ignore() *ExtensionGrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionGrantUpsertOne) DoNothing() *ExtensionGrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionGrantCreate.OnConflict
+// documentation for more info.
+func (u *ExtensionGrantUpsertOne) Update(set func(*ExtensionGrantUpsert)) *ExtensionGrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionGrantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionGrantUpsertOne) SetPackage(v string) *ExtensionGrantUpsertOne {
+	return u.Update(func(s *ExtensionGrantUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionGrantUpsertOne) UpdatePackage() *ExtensionGrantUpsertOne {
+	return u.Update(func(s *ExtensionGrantUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *ExtensionGrantUpsertOne) SetDomain(v string) *ExtensionGrantUpsertOne {
+	return u.Update(func(s *ExtensionGrantUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ExtensionGrantUpsertOne) UpdateDomain() *ExtensionGrantUpsertOne {
+	return u.Update(func(s *ExtensionGrantUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionGrantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionGrantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionGrantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExtensionGrantUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExtensionGrantUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExtensionGrantCreateBulk is the builder for creating many ExtensionGrant entities in bulk.
+type ExtensionGrantCreateBulk struct {
+	config
+	err      error
+	builders []*ExtensionGrantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExtensionGrant entities in the database.
+func (_c *ExtensionGrantCreateBulk) Save(ctx context.Context) ([]*ExtensionGrant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExtensionGrant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExtensionGrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExtensionGrantCreateBulk) SaveX(ctx context.Context) []*ExtensionGrant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionGrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionGrantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionGrant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionGrantUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionGrantCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExtensionGrantUpsertBulk {
+	_c.conflict = opts
+	return &ExtensionGrantUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionGrant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionGrantCreateBulk) OnConflictColumns(columns ...string) *ExtensionGrantUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionGrantUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExtensionGrantUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExtensionGrant nodes.
+type ExtensionGrantUpsertBulk struct {
+	create *ExtensionGrantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExtensionGrant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionGrantUpsertBulk) UpdateNewValues() *ExtensionGrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionGrant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExtensionGrantUpsertBulk) Ignore() *ExtensionGrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionGrantUpsertBulk) DoNothing() *ExtensionGrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionGrantCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExtensionGrantUpsertBulk) Update(set func(*ExtensionGrantUpsert)) *ExtensionGrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionGrantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionGrantUpsertBulk) SetPackage(v string) *ExtensionGrantUpsertBulk {
+	return u.Update(func(s *ExtensionGrantUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionGrantUpsertBulk) UpdatePackage() *ExtensionGrantUpsertBulk {
+	return u.Update(func(s *ExtensionGrantUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *ExtensionGrantUpsertBulk) SetDomain(v string) *ExtensionGrantUpsertBulk {
+	return u.Update(func(s *ExtensionGrantUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ExtensionGrantUpsertBulk) UpdateDomain() *ExtensionGrantUpsertBulk {
+	return u.Update(func(s *ExtensionGrantUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionGrantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExtensionGrantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionGrantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionGrantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
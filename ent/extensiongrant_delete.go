@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensiongrant"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionGrantDelete is the builder for deleting a ExtensionGrant entity.
+type ExtensionGrantDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionGrantMutation
+}
+
+// Where appends a list predicates to the ExtensionGrantDelete builder.
+func (_d *ExtensionGrantDelete) Where(ps ...predicate.ExtensionGrant) *ExtensionGrantDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ExtensionGrantDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExtensionGrantDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ExtensionGrantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(extensiongrant.Table, sqlgraph.NewFieldSpec(extensiongrant.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ExtensionGrantDeleteOne is the builder for deleting a single ExtensionGrant entity.
+type ExtensionGrantDeleteOne struct {
+	_d *ExtensionGrantDelete
+}
+
+// Where appends a list predicates to the ExtensionGrantDelete builder.
+func (_d *ExtensionGrantDeleteOne) Where(ps ...predicate.ExtensionGrant) *ExtensionGrantDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ExtensionGrantDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{extensiongrant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExtensionGrantDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensiongrant"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionGrantUpdate is the builder for updating ExtensionGrant entities.
+type ExtensionGrantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionGrantMutation
+}
+
+// Where appends a list predicates to the ExtensionGrantUpdate builder.
+func (_u *ExtensionGrantUpdate) Where(ps ...predicate.ExtensionGrant) *ExtensionGrantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionGrantUpdate) SetPackage(v string) *ExtensionGrantUpdate {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionGrantUpdate) SetNillablePackage(v *string) *ExtensionGrantUpdate {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *ExtensionGrantUpdate) SetDomain(v string) *ExtensionGrantUpdate {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *ExtensionGrantUpdate) SetNillableDomain(v *string) *ExtensionGrantUpdate {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ExtensionGrantMutation object of the builder.
+func (_u *ExtensionGrantUpdate) Mutation() *ExtensionGrantMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExtensionGrantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionGrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExtensionGrantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionGrantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionGrantUpdate) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensiongrant.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionGrant.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Domain(); ok {
+		if err := extensiongrant.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "ExtensionGrant.domain": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionGrantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensiongrant.Table, extensiongrant.Columns, sqlgraph.NewFieldSpec(extensiongrant.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensiongrant.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(extensiongrant.FieldDomain, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensiongrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExtensionGrantUpdateOne is the builder for updating a single ExtensionGrant entity.
+type ExtensionGrantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExtensionGrantMutation
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionGrantUpdateOne) SetPackage(v string) *ExtensionGrantUpdateOne {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionGrantUpdateOne) SetNillablePackage(v *string) *ExtensionGrantUpdateOne {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *ExtensionGrantUpdateOne) SetDomain(v string) *ExtensionGrantUpdateOne {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *ExtensionGrantUpdateOne) SetNillableDomain(v *string) *ExtensionGrantUpdateOne {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ExtensionGrantMutation object of the builder.
+func (_u *ExtensionGrantUpdateOne) Mutation() *ExtensionGrantMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExtensionGrantUpdate builder.
+func (_u *ExtensionGrantUpdateOne) Where(ps ...predicate.ExtensionGrant) *ExtensionGrantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExtensionGrantUpdateOne) Select(field string, fields ...string) *ExtensionGrantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExtensionGrant entity.
+func (_u *ExtensionGrantUpdateOne) Save(ctx context.Context) (*ExtensionGrant, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionGrantUpdateOne) SaveX(ctx context.Context) *ExtensionGrant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExtensionGrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionGrantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionGrantUpdateOne) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensiongrant.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionGrant.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Domain(); ok {
+		if err := extensiongrant.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "ExtensionGrant.domain": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionGrantUpdateOne) sqlSave(ctx context.Context) (_node *ExtensionGrant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensiongrant.Table, extensiongrant.Columns, sqlgraph.NewFieldSpec(extensiongrant.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExtensionGrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, extensiongrant.FieldID)
+		for _, f := range fields {
+			if !extensiongrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != extensiongrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensiongrant.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(extensiongrant.FieldDomain, field.TypeString, value)
+	}
+	_node = &ExtensionGrant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensiongrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
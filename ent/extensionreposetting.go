@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -19,7 +20,9 @@ type ExtensionRepoSetting struct {
 	// The URL of the extension repository
 	Link string `json:"link,omitempty"`
 	// The name of the extension repository
-	Name         string `json:"name,omitempty"`
+	Name string `json:"name,omitempty"`
+	// Base64 ed25519 public keys trusted to sign the extensions of the repository
+	TrustedKeys  []string `json:"trusted_keys,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -28,6 +31,8 @@ func (*ExtensionRepoSetting) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case extensionreposetting.FieldTrustedKeys:
+			values[i] = new([]byte)
 		case extensionreposetting.FieldID:
 			values[i] = new(sql.NullInt64)
 		case extensionreposetting.FieldLink, extensionreposetting.FieldName:
@@ -65,6 +70,14 @@ func (_m *ExtensionRepoSetting) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case extensionreposetting.FieldTrustedKeys:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field trusted_keys", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TrustedKeys); err != nil {
+					return fmt.Errorf("unmarshal field trusted_keys: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -106,6 +119,9 @@ func (_m *ExtensionRepoSetting) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("trusted_keys=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrustedKeys))
 	builder.WriteByte(')')
 	return builder.String()
 }
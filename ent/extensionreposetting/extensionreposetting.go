@@ -16,6 +16,8 @@ const (
 	FieldLink = "link"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldTrustedKeys holds the string denoting the trusted_keys field in the database.
+	FieldTrustedKeys = "trusted_keys"
 	// Table holds the table name of the extensionreposetting in the database.
 	Table = "extension_repo_settings"
 )
@@ -25,6 +27,7 @@ var Columns = []string{
 	FieldID,
 	FieldLink,
 	FieldName,
+	FieldTrustedKeys,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -192,6 +192,16 @@ func NameContainsFold(v string) predicate.ExtensionRepoSetting {
 	return predicate.ExtensionRepoSetting(sql.FieldContainsFold(FieldName, v))
 }
 
+// TrustedKeysIsNil applies the IsNil predicate on the "trusted_keys" field.
+func TrustedKeysIsNil() predicate.ExtensionRepoSetting {
+	return predicate.ExtensionRepoSetting(sql.FieldIsNull(FieldTrustedKeys))
+}
+
+// TrustedKeysNotNil applies the NotNil predicate on the "trusted_keys" field.
+func TrustedKeysNotNil() predicate.ExtensionRepoSetting {
+	return predicate.ExtensionRepoSetting(sql.FieldNotNull(FieldTrustedKeys))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ExtensionRepoSetting) predicate.ExtensionRepoSetting {
 	return predicate.ExtensionRepoSetting(sql.AndPredicates(predicates...))
@@ -33,6 +33,12 @@ func (_c *ExtensionRepoSettingCreate) SetName(v string) *ExtensionRepoSettingCre
 	return _c
 }
 
+// SetTrustedKeys sets the "trusted_keys" field.
+func (_c *ExtensionRepoSettingCreate) SetTrustedKeys(v []string) *ExtensionRepoSettingCreate {
+	_c.mutation.SetTrustedKeys(v)
+	return _c
+}
+
 // Mutation returns the ExtensionRepoSettingMutation object of the builder.
 func (_c *ExtensionRepoSettingCreate) Mutation() *ExtensionRepoSettingMutation {
 	return _c.mutation
@@ -118,6 +124,10 @@ func (_c *ExtensionRepoSettingCreate) createSpec() (*ExtensionRepoSetting, *sqlg
 		_spec.SetField(extensionreposetting.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.TrustedKeys(); ok {
+		_spec.SetField(extensionreposetting.FieldTrustedKeys, field.TypeJSON, value)
+		_node.TrustedKeys = value
+	}
 	return _node, _spec
 }
 
@@ -194,6 +204,24 @@ func (u *ExtensionRepoSettingUpsert) UpdateName() *ExtensionRepoSettingUpsert {
 	return u
 }
 
+// SetTrustedKeys sets the "trusted_keys" field.
+func (u *ExtensionRepoSettingUpsert) SetTrustedKeys(v []string) *ExtensionRepoSettingUpsert {
+	u.Set(extensionreposetting.FieldTrustedKeys, v)
+	return u
+}
+
+// UpdateTrustedKeys sets the "trusted_keys" field to the value that was provided on create.
+func (u *ExtensionRepoSettingUpsert) UpdateTrustedKeys() *ExtensionRepoSettingUpsert {
+	u.SetExcluded(extensionreposetting.FieldTrustedKeys)
+	return u
+}
+
+// ClearTrustedKeys clears the value of the "trusted_keys" field.
+func (u *ExtensionRepoSettingUpsert) ClearTrustedKeys() *ExtensionRepoSettingUpsert {
+	u.SetNull(extensionreposetting.FieldTrustedKeys)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -262,6 +290,27 @@ func (u *ExtensionRepoSettingUpsertOne) UpdateName() *ExtensionRepoSettingUpsert
 	})
 }
 
+// SetTrustedKeys sets the "trusted_keys" field.
+func (u *ExtensionRepoSettingUpsertOne) SetTrustedKeys(v []string) *ExtensionRepoSettingUpsertOne {
+	return u.Update(func(s *ExtensionRepoSettingUpsert) {
+		s.SetTrustedKeys(v)
+	})
+}
+
+// UpdateTrustedKeys sets the "trusted_keys" field to the value that was provided on create.
+func (u *ExtensionRepoSettingUpsertOne) UpdateTrustedKeys() *ExtensionRepoSettingUpsertOne {
+	return u.Update(func(s *ExtensionRepoSettingUpsert) {
+		s.UpdateTrustedKeys()
+	})
+}
+
+// ClearTrustedKeys clears the value of the "trusted_keys" field.
+func (u *ExtensionRepoSettingUpsertOne) ClearTrustedKeys() *ExtensionRepoSettingUpsertOne {
+	return u.Update(func(s *ExtensionRepoSettingUpsert) {
+		s.ClearTrustedKeys()
+	})
+}
+
 // Exec executes the query.
 func (u *ExtensionRepoSettingUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -493,6 +542,27 @@ func (u *ExtensionRepoSettingUpsertBulk) UpdateName() *ExtensionRepoSettingUpser
 	})
 }
 
+// SetTrustedKeys sets the "trusted_keys" field.
+func (u *ExtensionRepoSettingUpsertBulk) SetTrustedKeys(v []string) *ExtensionRepoSettingUpsertBulk {
+	return u.Update(func(s *ExtensionRepoSettingUpsert) {
+		s.SetTrustedKeys(v)
+	})
+}
+
+// UpdateTrustedKeys sets the "trusted_keys" field to the value that was provided on create.
+func (u *ExtensionRepoSettingUpsertBulk) UpdateTrustedKeys() *ExtensionRepoSettingUpsertBulk {
+	return u.Update(func(s *ExtensionRepoSettingUpsert) {
+		s.UpdateTrustedKeys()
+	})
+}
+
+// ClearTrustedKeys clears the value of the "trusted_keys" field.
+func (u *ExtensionRepoSettingUpsertBulk) ClearTrustedKeys() *ExtensionRepoSettingUpsertBulk {
+	return u.Update(func(s *ExtensionRepoSettingUpsert) {
+		s.ClearTrustedKeys()
+	})
+}
+
 // Exec executes the query.
 func (u *ExtensionRepoSettingUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/miru-project/miru-core/ent/extensionreposetting"
 	"github.com/miru-project/miru-core/ent/predicate"
@@ -55,6 +56,24 @@ func (_u *ExtensionRepoSettingUpdate) SetNillableName(v *string) *ExtensionRepoS
 	return _u
 }
 
+// SetTrustedKeys sets the "trusted_keys" field.
+func (_u *ExtensionRepoSettingUpdate) SetTrustedKeys(v []string) *ExtensionRepoSettingUpdate {
+	_u.mutation.SetTrustedKeys(v)
+	return _u
+}
+
+// AppendTrustedKeys appends value to the "trusted_keys" field.
+func (_u *ExtensionRepoSettingUpdate) AppendTrustedKeys(v []string) *ExtensionRepoSettingUpdate {
+	_u.mutation.AppendTrustedKeys(v)
+	return _u
+}
+
+// ClearTrustedKeys clears the value of the "trusted_keys" field.
+func (_u *ExtensionRepoSettingUpdate) ClearTrustedKeys() *ExtensionRepoSettingUpdate {
+	_u.mutation.ClearTrustedKeys()
+	return _u
+}
+
 // Mutation returns the ExtensionRepoSettingMutation object of the builder.
 func (_u *ExtensionRepoSettingUpdate) Mutation() *ExtensionRepoSettingMutation {
 	return _u.mutation
@@ -120,6 +139,17 @@ func (_u *ExtensionRepoSettingUpdate) sqlSave(ctx context.Context) (_node int, e
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(extensionreposetting.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrustedKeys(); ok {
+		_spec.SetField(extensionreposetting.FieldTrustedKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTrustedKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, extensionreposetting.FieldTrustedKeys, value)
+		})
+	}
+	if _u.mutation.TrustedKeysCleared() {
+		_spec.ClearField(extensionreposetting.FieldTrustedKeys, field.TypeJSON)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{extensionreposetting.Label}
@@ -168,6 +198,24 @@ func (_u *ExtensionRepoSettingUpdateOne) SetNillableName(v *string) *ExtensionRe
 	return _u
 }
 
+// SetTrustedKeys sets the "trusted_keys" field.
+func (_u *ExtensionRepoSettingUpdateOne) SetTrustedKeys(v []string) *ExtensionRepoSettingUpdateOne {
+	_u.mutation.SetTrustedKeys(v)
+	return _u
+}
+
+// AppendTrustedKeys appends value to the "trusted_keys" field.
+func (_u *ExtensionRepoSettingUpdateOne) AppendTrustedKeys(v []string) *ExtensionRepoSettingUpdateOne {
+	_u.mutation.AppendTrustedKeys(v)
+	return _u
+}
+
+// ClearTrustedKeys clears the value of the "trusted_keys" field.
+func (_u *ExtensionRepoSettingUpdateOne) ClearTrustedKeys() *ExtensionRepoSettingUpdateOne {
+	_u.mutation.ClearTrustedKeys()
+	return _u
+}
+
 // Mutation returns the ExtensionRepoSettingMutation object of the builder.
 func (_u *ExtensionRepoSettingUpdateOne) Mutation() *ExtensionRepoSettingMutation {
 	return _u.mutation
@@ -263,6 +311,17 @@ func (_u *ExtensionRepoSettingUpdateOne) sqlSave(ctx context.Context) (_node *Ex
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(extensionreposetting.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrustedKeys(); ok {
+		_spec.SetField(extensionreposetting.FieldTrustedKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTrustedKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, extensionreposetting.FieldTrustedKeys, value)
+		})
+	}
+	if _u.mutation.TrustedKeysCleared() {
+		_spec.ClearField(extensionreposetting.FieldTrustedKeys, field.TypeJSON)
+	}
 	_node = &ExtensionRepoSetting{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -26,7 +26,7 @@ type ExtensionSetting struct {
 	Value *string `json:"value,omitempty"`
 	// Default value
 	DefaultValue *string `json:"default_value,omitempty"`
-	// Type stored as string: input, radio, toggle
+	// Type stored as string: input, radio, toggle, number, multi_select, secret
 	DbType extensionsetting.DbType `json:"db_type,omitempty"`
 	// Description
 	Description *string `json:"description,omitempty"`
@@ -72,8 +72,6 @@ var (
 	KeyValidator func(string) error
 	// DefaultDefaultValue holds the default value on creation for the "default_value" field.
 	DefaultDefaultValue string
-	// DefaultValueValidator is a validator for the "default_value" field. It is called by the builders before save.
-	DefaultValueValidator func(string) error
 )
 
 // DbType defines the type for the "db_type" enum field.
@@ -84,9 +82,12 @@ const DefaultDbType = DbTypeInput
 
 // DbType values.
 const (
-	DbTypeInput  DbType = "input"
-	DbTypeRadio  DbType = "radio"
-	DbTypeToggle DbType = "toggle"
+	DbTypeInput       DbType = "input"
+	DbTypeRadio       DbType = "radio"
+	DbTypeToggle      DbType = "toggle"
+	DbTypeNumber      DbType = "number"
+	DbTypeMultiSelect DbType = "multi_select"
+	DbTypeSecret      DbType = "secret"
 )
 
 func (dt DbType) String() string {
@@ -96,7 +97,7 @@ func (dt DbType) String() string {
 // DbTypeValidator is a validator for the "db_type" field enum values. It is called by the builders before save.
 func DbTypeValidator(dt DbType) error {
 	switch dt {
-	case DbTypeInput, DbTypeRadio, DbTypeToggle:
+	case DbTypeInput, DbTypeRadio, DbTypeToggle, DbTypeNumber, DbTypeMultiSelect, DbTypeSecret:
 		return nil
 	default:
 		return fmt.Errorf("extensionsetting: invalid enum value for db_type field: %q", dt)
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/extensionstate"
+)
+
+// ExtensionState is the model entity for the ExtensionState schema.
+type ExtensionState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Package name of the extension
+	Package string `json:"package,omitempty"`
+	// Disabled extensions are listed but never run
+	Enabled bool `json:"enabled,omitempty"`
+	// Version the extension is held at, upgrades skip pinned extensions
+	PinnedVersion string `json:"pinned_version,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExtensionState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case extensionstate.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case extensionstate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case extensionstate.FieldPackage, extensionstate.FieldPinnedVersion:
+			values[i] = new(sql.NullString)
+		case extensionstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExtensionState fields.
+func (_m *ExtensionState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case extensionstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case extensionstate.FieldPackage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package", values[i])
+			} else if value.Valid {
+				_m.Package = value.String
+			}
+		case extensionstate.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case extensionstate.FieldPinnedVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_version", values[i])
+			} else if value.Valid {
+				_m.PinnedVersion = value.String
+			}
+		case extensionstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExtensionState.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExtensionState) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExtensionState.
+// Note that you need to call ExtensionState.Unwrap() before calling this method if this ExtensionState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExtensionState) Update() *ExtensionStateUpdateOne {
+	return NewExtensionStateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExtensionState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExtensionState) Unwrap() *ExtensionState {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExtensionState is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExtensionState) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExtensionState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("package=")
+	builder.WriteString(_m.Package)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("pinned_version=")
+	builder.WriteString(_m.PinnedVersion)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExtensionStates is a parsable slice of ExtensionState.
+type ExtensionStates []*ExtensionState
@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extensionstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the extensionstate type in the database.
+	Label = "extension_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackage holds the string denoting the package field in the database.
+	FieldPackage = "package"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldPinnedVersion holds the string denoting the pinned_version field in the database.
+	FieldPinnedVersion = "pinned_version"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the extensionstate in the database.
+	Table = "extension_states"
+)
+
+// Columns holds all SQL columns for extensionstate fields.
+var Columns = []string{
+	FieldID,
+	FieldPackage,
+	FieldEnabled,
+	FieldPinnedVersion,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	PackageValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ExtensionState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPackage orders the results by the package field.
+func ByPackage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackage, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByPinnedVersion orders the results by the pinned_version field.
+func ByPinnedVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinnedVersion, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extensionstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldLTE(FieldID, id))
+}
+
+// Package applies equality check predicate on the "package" field. It's identical to PackageEQ.
+func Package(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldPackage, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldEnabled, v))
+}
+
+// PinnedVersion applies equality check predicate on the "pinned_version" field. It's identical to PinnedVersionEQ.
+func PinnedVersion(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldPinnedVersion, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PackageEQ applies the EQ predicate on the "package" field.
+func PackageEQ(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldPackage, v))
+}
+
+// PackageNEQ applies the NEQ predicate on the "package" field.
+func PackageNEQ(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNEQ(FieldPackage, v))
+}
+
+// PackageIn applies the In predicate on the "package" field.
+func PackageIn(vs ...string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldIn(FieldPackage, vs...))
+}
+
+// PackageNotIn applies the NotIn predicate on the "package" field.
+func PackageNotIn(vs ...string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNotIn(FieldPackage, vs...))
+}
+
+// PackageGT applies the GT predicate on the "package" field.
+func PackageGT(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldGT(FieldPackage, v))
+}
+
+// PackageGTE applies the GTE predicate on the "package" field.
+func PackageGTE(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldGTE(FieldPackage, v))
+}
+
+// PackageLT applies the LT predicate on the "package" field.
+func PackageLT(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldLT(FieldPackage, v))
+}
+
+// PackageLTE applies the LTE predicate on the "package" field.
+func PackageLTE(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldLTE(FieldPackage, v))
+}
+
+// PackageContains applies the Contains predicate on the "package" field.
+func PackageContains(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldContains(FieldPackage, v))
+}
+
+// PackageHasPrefix applies the HasPrefix predicate on the "package" field.
+func PackageHasPrefix(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldHasPrefix(FieldPackage, v))
+}
+
+// PackageHasSuffix applies the HasSuffix predicate on the "package" field.
+func PackageHasSuffix(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldHasSuffix(FieldPackage, v))
+}
+
+// PackageEqualFold applies the EqualFold predicate on the "package" field.
+func PackageEqualFold(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEqualFold(FieldPackage, v))
+}
+
+// PackageContainsFold applies the ContainsFold predicate on the "package" field.
+func PackageContainsFold(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldContainsFold(FieldPackage, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PinnedVersionEQ applies the EQ predicate on the "pinned_version" field.
+func PinnedVersionEQ(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldPinnedVersion, v))
+}
+
+// PinnedVersionNEQ applies the NEQ predicate on the "pinned_version" field.
+func PinnedVersionNEQ(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNEQ(FieldPinnedVersion, v))
+}
+
+// PinnedVersionIn applies the In predicate on the "pinned_version" field.
+func PinnedVersionIn(vs ...string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldIn(FieldPinnedVersion, vs...))
+}
+
+// PinnedVersionNotIn applies the NotIn predicate on the "pinned_version" field.
+func PinnedVersionNotIn(vs ...string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNotIn(FieldPinnedVersion, vs...))
+}
+
+// PinnedVersionGT applies the GT predicate on the "pinned_version" field.
+func PinnedVersionGT(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldGT(FieldPinnedVersion, v))
+}
+
+// PinnedVersionGTE applies the GTE predicate on the "pinned_version" field.
+func PinnedVersionGTE(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldGTE(FieldPinnedVersion, v))
+}
+
+// PinnedVersionLT applies the LT predicate on the "pinned_version" field.
+func PinnedVersionLT(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldLT(FieldPinnedVersion, v))
+}
+
+// PinnedVersionLTE applies the LTE predicate on the "pinned_version" field.
+func PinnedVersionLTE(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldLTE(FieldPinnedVersion, v))
+}
+
+// PinnedVersionContains applies the Contains predicate on the "pinned_version" field.
+func PinnedVersionContains(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldContains(FieldPinnedVersion, v))
+}
+
+// PinnedVersionHasPrefix applies the HasPrefix predicate on the "pinned_version" field.
+func PinnedVersionHasPrefix(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldHasPrefix(FieldPinnedVersion, v))
+}
+
+// PinnedVersionHasSuffix applies the HasSuffix predicate on the "pinned_version" field.
+func PinnedVersionHasSuffix(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldHasSuffix(FieldPinnedVersion, v))
+}
+
+// PinnedVersionIsNil applies the IsNil predicate on the "pinned_version" field.
+func PinnedVersionIsNil() predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldIsNull(FieldPinnedVersion))
+}
+
+// PinnedVersionNotNil applies the NotNil predicate on the "pinned_version" field.
+func PinnedVersionNotNil() predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNotNull(FieldPinnedVersion))
+}
+
+// PinnedVersionEqualFold applies the EqualFold predicate on the "pinned_version" field.
+func PinnedVersionEqualFold(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEqualFold(FieldPinnedVersion, v))
+}
+
+// PinnedVersionContainsFold applies the ContainsFold predicate on the "pinned_version" field.
+func PinnedVersionContainsFold(v string) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldContainsFold(FieldPinnedVersion, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExtensionState) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExtensionState) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExtensionState) predicate.ExtensionState {
+	return predicate.ExtensionState(sql.NotPredicates(p))
+}
@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionstate"
+)
+
+// ExtensionStateCreate is the builder for creating a ExtensionState entity.
+type ExtensionStateCreate struct {
+	config
+	mutation *ExtensionStateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackage sets the "package" field.
+func (_c *ExtensionStateCreate) SetPackage(v string) *ExtensionStateCreate {
+	_c.mutation.SetPackage(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ExtensionStateCreate) SetEnabled(v bool) *ExtensionStateCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ExtensionStateCreate) SetNillableEnabled(v *bool) *ExtensionStateCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetPinnedVersion sets the "pinned_version" field.
+func (_c *ExtensionStateCreate) SetPinnedVersion(v string) *ExtensionStateCreate {
+	_c.mutation.SetPinnedVersion(v)
+	return _c
+}
+
+// SetNillablePinnedVersion sets the "pinned_version" field if the given value is not nil.
+func (_c *ExtensionStateCreate) SetNillablePinnedVersion(v *string) *ExtensionStateCreate {
+	if v != nil {
+		_c.SetPinnedVersion(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ExtensionStateCreate) SetUpdatedAt(v time.Time) *ExtensionStateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ExtensionStateCreate) SetNillableUpdatedAt(v *time.Time) *ExtensionStateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ExtensionStateMutation object of the builder.
+func (_c *ExtensionStateCreate) Mutation() *ExtensionStateMutation {
+	return _c.mutation
+}
+
+// Save creates the ExtensionState in the database.
+func (_c *ExtensionStateCreate) Save(ctx context.Context) (*ExtensionState, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExtensionStateCreate) SaveX(ctx context.Context) *ExtensionState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionStateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionStateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExtensionStateCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := extensionstate.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := extensionstate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExtensionStateCreate) check() error {
+	if _, ok := _c.mutation.Package(); !ok {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required field "ExtensionState.package"`)}
+	}
+	if v, ok := _c.mutation.Package(); ok {
+		if err := extensionstate.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionState.package": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ExtensionState.enabled"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExtensionState.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ExtensionStateCreate) sqlSave(ctx context.Context) (*ExtensionState, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExtensionStateCreate) createSpec() (*ExtensionState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExtensionState{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(extensionstate.Table, sqlgraph.NewFieldSpec(extensionstate.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Package(); ok {
+		_spec.SetField(extensionstate.FieldPackage, field.TypeString, value)
+		_node.Package = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(extensionstate.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.PinnedVersion(); ok {
+		_spec.SetField(extensionstate.FieldPinnedVersion, field.TypeString, value)
+		_node.PinnedVersion = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(extensionstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionState.Create().
+//		SetPackage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionStateUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionStateCreate) OnConflict(opts ...sql.ConflictOption) *ExtensionStateUpsertOne {
+	_c.conflict = opts
+	return &ExtensionStateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionStateCreate) OnConflictColumns(columns ...string) *ExtensionStateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionStateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExtensionStateUpsertOne is the builder for "upsert"-ing
+	//  one ExtensionState node.
+	ExtensionStateUpsertOne struct {
+		create *ExtensionStateCreate
+	}
+
+	// ExtensionStateUpsert is the "OnConflict" setter.
+	ExtensionStateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackage sets the "package" field.
+func (u *ExtensionStateUpsert) SetPackage(v string) *ExtensionStateUpsert {
+	u.Set(extensionstate.FieldPackage, v)
+	return u
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionStateUpsert) UpdatePackage() *ExtensionStateUpsert {
+	u.SetExcluded(extensionstate.FieldPackage)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ExtensionStateUpsert) SetEnabled(v bool) *ExtensionStateUpsert {
+	u.Set(extensionstate.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ExtensionStateUpsert) UpdateEnabled() *ExtensionStateUpsert {
+	u.SetExcluded(extensionstate.FieldEnabled)
+	return u
+}
+
+// SetPinnedVersion sets the "pinned_version" field.
+func (u *ExtensionStateUpsert) SetPinnedVersion(v string) *ExtensionStateUpsert {
+	u.Set(extensionstate.FieldPinnedVersion, v)
+	return u
+}
+
+// UpdatePinnedVersion sets the "pinned_version" field to the value that was provided on create.
+func (u *ExtensionStateUpsert) UpdatePinnedVersion() *ExtensionStateUpsert {
+	u.SetExcluded(extensionstate.FieldPinnedVersion)
+	return u
+}
+
+// ClearPinnedVersion clears the value of the "pinned_version" field.
+func (u *ExtensionStateUpsert) ClearPinnedVersion() *ExtensionStateUpsert {
+	u.SetNull(extensionstate.FieldPinnedVersion)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExtensionStateUpsert) SetUpdatedAt(v time.Time) *ExtensionStateUpsert {
+	u.Set(extensionstate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExtensionStateUpsert) UpdateUpdatedAt() *ExtensionStateUpsert {
+	u.SetExcluded(extensionstate.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionStateUpsertOne) UpdateNewValues() *ExtensionStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionState.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExtensionStateUpsertOne) Ignore() *ExtensionStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionStateUpsertOne) DoNothing() *ExtensionStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionStateCreate.OnConflict
+// documentation for more info.
+func (u *ExtensionStateUpsertOne) Update(set func(*ExtensionStateUpsert)) *ExtensionStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionStateUpsertOne) SetPackage(v string) *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionStateUpsertOne) UpdatePackage() *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ExtensionStateUpsertOne) SetEnabled(v bool) *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ExtensionStateUpsertOne) UpdateEnabled() *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPinnedVersion sets the "pinned_version" field.
+func (u *ExtensionStateUpsertOne) SetPinnedVersion(v string) *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.SetPinnedVersion(v)
+	})
+}
+
+// UpdatePinnedVersion sets the "pinned_version" field to the value that was provided on create.
+func (u *ExtensionStateUpsertOne) UpdatePinnedVersion() *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.UpdatePinnedVersion()
+	})
+}
+
+// ClearPinnedVersion clears the value of the "pinned_version" field.
+func (u *ExtensionStateUpsertOne) ClearPinnedVersion() *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.ClearPinnedVersion()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExtensionStateUpsertOne) SetUpdatedAt(v time.Time) *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExtensionStateUpsertOne) UpdateUpdatedAt() *ExtensionStateUpsertOne {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionStateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionStateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionStateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExtensionStateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExtensionStateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExtensionStateCreateBulk is the builder for creating many ExtensionState entities in bulk.
+type ExtensionStateCreateBulk struct {
+	config
+	err      error
+	builders []*ExtensionStateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExtensionState entities in the database.
+func (_c *ExtensionStateCreateBulk) Save(ctx context.Context) ([]*ExtensionState, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExtensionState, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExtensionStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExtensionStateCreateBulk) SaveX(ctx context.Context) []*ExtensionState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionStateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionState.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionStateUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionStateCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExtensionStateUpsertBulk {
+	_c.conflict = opts
+	return &ExtensionStateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionStateCreateBulk) OnConflictColumns(columns ...string) *ExtensionStateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionStateUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExtensionStateUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExtensionState nodes.
+type ExtensionStateUpsertBulk struct {
+	create *ExtensionStateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExtensionState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionStateUpsertBulk) UpdateNewValues() *ExtensionStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionState.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExtensionStateUpsertBulk) Ignore() *ExtensionStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionStateUpsertBulk) DoNothing() *ExtensionStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionStateCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExtensionStateUpsertBulk) Update(set func(*ExtensionStateUpsert)) *ExtensionStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionStateUpsertBulk) SetPackage(v string) *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionStateUpsertBulk) UpdatePackage() *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ExtensionStateUpsertBulk) SetEnabled(v bool) *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ExtensionStateUpsertBulk) UpdateEnabled() *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPinnedVersion sets the "pinned_version" field.
+func (u *ExtensionStateUpsertBulk) SetPinnedVersion(v string) *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.SetPinnedVersion(v)
+	})
+}
+
+// UpdatePinnedVersion sets the "pinned_version" field to the value that was provided on create.
+func (u *ExtensionStateUpsertBulk) UpdatePinnedVersion() *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.UpdatePinnedVersion()
+	})
+}
+
+// ClearPinnedVersion clears the value of the "pinned_version" field.
+func (u *ExtensionStateUpsertBulk) ClearPinnedVersion() *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.ClearPinnedVersion()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExtensionStateUpsertBulk) SetUpdatedAt(v time.Time) *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExtensionStateUpsertBulk) UpdateUpdatedAt() *ExtensionStateUpsertBulk {
+	return u.Update(func(s *ExtensionStateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionStateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExtensionStateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionStateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionStateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionstate"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionStateUpdate is the builder for updating ExtensionState entities.
+type ExtensionStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionStateMutation
+}
+
+// Where appends a list predicates to the ExtensionStateUpdate builder.
+func (_u *ExtensionStateUpdate) Where(ps ...predicate.ExtensionState) *ExtensionStateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionStateUpdate) SetPackage(v string) *ExtensionStateUpdate {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionStateUpdate) SetNillablePackage(v *string) *ExtensionStateUpdate {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ExtensionStateUpdate) SetEnabled(v bool) *ExtensionStateUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ExtensionStateUpdate) SetNillableEnabled(v *bool) *ExtensionStateUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPinnedVersion sets the "pinned_version" field.
+func (_u *ExtensionStateUpdate) SetPinnedVersion(v string) *ExtensionStateUpdate {
+	_u.mutation.SetPinnedVersion(v)
+	return _u
+}
+
+// SetNillablePinnedVersion sets the "pinned_version" field if the given value is not nil.
+func (_u *ExtensionStateUpdate) SetNillablePinnedVersion(v *string) *ExtensionStateUpdate {
+	if v != nil {
+		_u.SetPinnedVersion(*v)
+	}
+	return _u
+}
+
+// ClearPinnedVersion clears the value of the "pinned_version" field.
+func (_u *ExtensionStateUpdate) ClearPinnedVersion() *ExtensionStateUpdate {
+	_u.mutation.ClearPinnedVersion()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExtensionStateUpdate) SetUpdatedAt(v time.Time) *ExtensionStateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtensionStateMutation object of the builder.
+func (_u *ExtensionStateUpdate) Mutation() *ExtensionStateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExtensionStateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExtensionStateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionStateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtensionStateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := extensionstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionStateUpdate) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensionstate.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionState.package": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionStateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensionstate.Table, extensionstate.Columns, sqlgraph.NewFieldSpec(extensionstate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensionstate.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(extensionstate.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PinnedVersion(); ok {
+		_spec.SetField(extensionstate.FieldPinnedVersion, field.TypeString, value)
+	}
+	if _u.mutation.PinnedVersionCleared() {
+		_spec.ClearField(extensionstate.FieldPinnedVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(extensionstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensionstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExtensionStateUpdateOne is the builder for updating a single ExtensionState entity.
+type ExtensionStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExtensionStateMutation
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionStateUpdateOne) SetPackage(v string) *ExtensionStateUpdateOne {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionStateUpdateOne) SetNillablePackage(v *string) *ExtensionStateUpdateOne {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ExtensionStateUpdateOne) SetEnabled(v bool) *ExtensionStateUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ExtensionStateUpdateOne) SetNillableEnabled(v *bool) *ExtensionStateUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPinnedVersion sets the "pinned_version" field.
+func (_u *ExtensionStateUpdateOne) SetPinnedVersion(v string) *ExtensionStateUpdateOne {
+	_u.mutation.SetPinnedVersion(v)
+	return _u
+}
+
+// SetNillablePinnedVersion sets the "pinned_version" field if the given value is not nil.
+func (_u *ExtensionStateUpdateOne) SetNillablePinnedVersion(v *string) *ExtensionStateUpdateOne {
+	if v != nil {
+		_u.SetPinnedVersion(*v)
+	}
+	return _u
+}
+
+// ClearPinnedVersion clears the value of the "pinned_version" field.
+func (_u *ExtensionStateUpdateOne) ClearPinnedVersion() *ExtensionStateUpdateOne {
+	_u.mutation.ClearPinnedVersion()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExtensionStateUpdateOne) SetUpdatedAt(v time.Time) *ExtensionStateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtensionStateMutation object of the builder.
+func (_u *ExtensionStateUpdateOne) Mutation() *ExtensionStateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExtensionStateUpdate builder.
+func (_u *ExtensionStateUpdateOne) Where(ps ...predicate.ExtensionState) *ExtensionStateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExtensionStateUpdateOne) Select(field string, fields ...string) *ExtensionStateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExtensionState entity.
+func (_u *ExtensionStateUpdateOne) Save(ctx context.Context) (*ExtensionState, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionStateUpdateOne) SaveX(ctx context.Context) *ExtensionState {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExtensionStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionStateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtensionStateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := extensionstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionStateUpdateOne) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensionstate.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionState.package": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionStateUpdateOne) sqlSave(ctx context.Context) (_node *ExtensionState, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensionstate.Table, extensionstate.Columns, sqlgraph.NewFieldSpec(extensionstate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExtensionState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, extensionstate.FieldID)
+		for _, f := range fields {
+			if !extensionstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != extensionstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensionstate.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(extensionstate.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PinnedVersion(); ok {
+		_spec.SetField(extensionstate.FieldPinnedVersion, field.TypeString, value)
+	}
+	if _u.mutation.PinnedVersionCleared() {
+		_spec.ClearField(extensionstate.FieldPinnedVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(extensionstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ExtensionState{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensionstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
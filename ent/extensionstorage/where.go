@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extensionstorage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLTE(FieldID, id))
+}
+
+// Package applies equality check predicate on the "package" field. It's identical to PackageEQ.
+func Package(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldPackage, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldKey, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldValue, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldSize, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PackageEQ applies the EQ predicate on the "package" field.
+func PackageEQ(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldPackage, v))
+}
+
+// PackageNEQ applies the NEQ predicate on the "package" field.
+func PackageNEQ(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNEQ(FieldPackage, v))
+}
+
+// PackageIn applies the In predicate on the "package" field.
+func PackageIn(vs ...string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldIn(FieldPackage, vs...))
+}
+
+// PackageNotIn applies the NotIn predicate on the "package" field.
+func PackageNotIn(vs ...string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNotIn(FieldPackage, vs...))
+}
+
+// PackageGT applies the GT predicate on the "package" field.
+func PackageGT(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGT(FieldPackage, v))
+}
+
+// PackageGTE applies the GTE predicate on the "package" field.
+func PackageGTE(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGTE(FieldPackage, v))
+}
+
+// PackageLT applies the LT predicate on the "package" field.
+func PackageLT(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLT(FieldPackage, v))
+}
+
+// PackageLTE applies the LTE predicate on the "package" field.
+func PackageLTE(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLTE(FieldPackage, v))
+}
+
+// PackageContains applies the Contains predicate on the "package" field.
+func PackageContains(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldContains(FieldPackage, v))
+}
+
+// PackageHasPrefix applies the HasPrefix predicate on the "package" field.
+func PackageHasPrefix(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldHasPrefix(FieldPackage, v))
+}
+
+// PackageHasSuffix applies the HasSuffix predicate on the "package" field.
+func PackageHasSuffix(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldHasSuffix(FieldPackage, v))
+}
+
+// PackageEqualFold applies the EqualFold predicate on the "package" field.
+func PackageEqualFold(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEqualFold(FieldPackage, v))
+}
+
+// PackageContainsFold applies the ContainsFold predicate on the "package" field.
+func PackageContainsFold(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldContainsFold(FieldPackage, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldContainsFold(FieldKey, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldContainsFold(FieldValue, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLTE(FieldSize, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExtensionStorage) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExtensionStorage) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExtensionStorage) predicate.ExtensionStorage {
+	return predicate.ExtensionStorage(sql.NotPredicates(p))
+}
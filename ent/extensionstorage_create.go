@@ -0,0 +1,798 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionstorage"
+)
+
+// ExtensionStorageCreate is the builder for creating a ExtensionStorage entity.
+type ExtensionStorageCreate struct {
+	config
+	mutation *ExtensionStorageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackage sets the "package" field.
+func (_c *ExtensionStorageCreate) SetPackage(v string) *ExtensionStorageCreate {
+	_c.mutation.SetPackage(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *ExtensionStorageCreate) SetKey(v string) *ExtensionStorageCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *ExtensionStorageCreate) SetValue(v string) *ExtensionStorageCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *ExtensionStorageCreate) SetSize(v int) *ExtensionStorageCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ExtensionStorageCreate) SetExpiresAt(v time.Time) *ExtensionStorageCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ExtensionStorageCreate) SetNillableExpiresAt(v *time.Time) *ExtensionStorageCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ExtensionStorageCreate) SetUpdatedAt(v time.Time) *ExtensionStorageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ExtensionStorageCreate) SetNillableUpdatedAt(v *time.Time) *ExtensionStorageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ExtensionStorageMutation object of the builder.
+func (_c *ExtensionStorageCreate) Mutation() *ExtensionStorageMutation {
+	return _c.mutation
+}
+
+// Save creates the ExtensionStorage in the database.
+func (_c *ExtensionStorageCreate) Save(ctx context.Context) (*ExtensionStorage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExtensionStorageCreate) SaveX(ctx context.Context) *ExtensionStorage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionStorageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionStorageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExtensionStorageCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := extensionstorage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExtensionStorageCreate) check() error {
+	if _, ok := _c.mutation.Package(); !ok {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required field "ExtensionStorage.package"`)}
+	}
+	if v, ok := _c.mutation.Package(); ok {
+		if err := extensionstorage.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.package": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ExtensionStorage.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := extensionstorage.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "ExtensionStorage.value"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "ExtensionStorage.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := extensionstorage.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExtensionStorage.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ExtensionStorageCreate) sqlSave(ctx context.Context) (*ExtensionStorage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExtensionStorageCreate) createSpec() (*ExtensionStorage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExtensionStorage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(extensionstorage.Table, sqlgraph.NewFieldSpec(extensionstorage.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Package(); ok {
+		_spec.SetField(extensionstorage.FieldPackage, field.TypeString, value)
+		_node.Package = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(extensionstorage.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(extensionstorage.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(extensionstorage.FieldSize, field.TypeInt, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(extensionstorage.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(extensionstorage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionStorage.Create().
+//		SetPackage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionStorageUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionStorageCreate) OnConflict(opts ...sql.ConflictOption) *ExtensionStorageUpsertOne {
+	_c.conflict = opts
+	return &ExtensionStorageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionStorage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionStorageCreate) OnConflictColumns(columns ...string) *ExtensionStorageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionStorageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExtensionStorageUpsertOne is the builder for "upsert"-ing
+	//  one ExtensionStorage node.
+	ExtensionStorageUpsertOne struct {
+		create *ExtensionStorageCreate
+	}
+
+	// ExtensionStorageUpsert is the "OnConflict" setter.
+	ExtensionStorageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackage sets the "package" field.
+func (u *ExtensionStorageUpsert) SetPackage(v string) *ExtensionStorageUpsert {
+	u.Set(extensionstorage.FieldPackage, v)
+	return u
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionStorageUpsert) UpdatePackage() *ExtensionStorageUpsert {
+	u.SetExcluded(extensionstorage.FieldPackage)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *ExtensionStorageUpsert) SetKey(v string) *ExtensionStorageUpsert {
+	u.Set(extensionstorage.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ExtensionStorageUpsert) UpdateKey() *ExtensionStorageUpsert {
+	u.SetExcluded(extensionstorage.FieldKey)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *ExtensionStorageUpsert) SetValue(v string) *ExtensionStorageUpsert {
+	u.Set(extensionstorage.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *ExtensionStorageUpsert) UpdateValue() *ExtensionStorageUpsert {
+	u.SetExcluded(extensionstorage.FieldValue)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *ExtensionStorageUpsert) SetSize(v int) *ExtensionStorageUpsert {
+	u.Set(extensionstorage.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *ExtensionStorageUpsert) UpdateSize() *ExtensionStorageUpsert {
+	u.SetExcluded(extensionstorage.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *ExtensionStorageUpsert) AddSize(v int) *ExtensionStorageUpsert {
+	u.Add(extensionstorage.FieldSize, v)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ExtensionStorageUpsert) SetExpiresAt(v time.Time) *ExtensionStorageUpsert {
+	u.Set(extensionstorage.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ExtensionStorageUpsert) UpdateExpiresAt() *ExtensionStorageUpsert {
+	u.SetExcluded(extensionstorage.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ExtensionStorageUpsert) ClearExpiresAt() *ExtensionStorageUpsert {
+	u.SetNull(extensionstorage.FieldExpiresAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExtensionStorageUpsert) SetUpdatedAt(v time.Time) *ExtensionStorageUpsert {
+	u.Set(extensionstorage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExtensionStorageUpsert) UpdateUpdatedAt() *ExtensionStorageUpsert {
+	u.SetExcluded(extensionstorage.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionStorage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionStorageUpsertOne) UpdateNewValues() *ExtensionStorageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionStorage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExtensionStorageUpsertOne) Ignore() *ExtensionStorageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionStorageUpsertOne) DoNothing() *ExtensionStorageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionStorageCreate.OnConflict
+// documentation for more info.
+func (u *ExtensionStorageUpsertOne) Update(set func(*ExtensionStorageUpsert)) *ExtensionStorageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionStorageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionStorageUpsertOne) SetPackage(v string) *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertOne) UpdatePackage() *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *ExtensionStorageUpsertOne) SetKey(v string) *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertOne) UpdateKey() *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *ExtensionStorageUpsertOne) SetValue(v string) *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertOne) UpdateValue() *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *ExtensionStorageUpsertOne) SetSize(v int) *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *ExtensionStorageUpsertOne) AddSize(v int) *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertOne) UpdateSize() *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ExtensionStorageUpsertOne) SetExpiresAt(v time.Time) *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertOne) UpdateExpiresAt() *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ExtensionStorageUpsertOne) ClearExpiresAt() *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExtensionStorageUpsertOne) SetUpdatedAt(v time.Time) *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertOne) UpdateUpdatedAt() *ExtensionStorageUpsertOne {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionStorageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionStorageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionStorageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExtensionStorageUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExtensionStorageUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExtensionStorageCreateBulk is the builder for creating many ExtensionStorage entities in bulk.
+type ExtensionStorageCreateBulk struct {
+	config
+	err      error
+	builders []*ExtensionStorageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExtensionStorage entities in the database.
+func (_c *ExtensionStorageCreateBulk) Save(ctx context.Context) ([]*ExtensionStorage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExtensionStorage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExtensionStorageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExtensionStorageCreateBulk) SaveX(ctx context.Context) []*ExtensionStorage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionStorageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionStorageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionStorage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionStorageUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionStorageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExtensionStorageUpsertBulk {
+	_c.conflict = opts
+	return &ExtensionStorageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionStorage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionStorageCreateBulk) OnConflictColumns(columns ...string) *ExtensionStorageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionStorageUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExtensionStorageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExtensionStorage nodes.
+type ExtensionStorageUpsertBulk struct {
+	create *ExtensionStorageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExtensionStorage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionStorageUpsertBulk) UpdateNewValues() *ExtensionStorageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionStorage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExtensionStorageUpsertBulk) Ignore() *ExtensionStorageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionStorageUpsertBulk) DoNothing() *ExtensionStorageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionStorageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExtensionStorageUpsertBulk) Update(set func(*ExtensionStorageUpsert)) *ExtensionStorageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionStorageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionStorageUpsertBulk) SetPackage(v string) *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertBulk) UpdatePackage() *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *ExtensionStorageUpsertBulk) SetKey(v string) *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertBulk) UpdateKey() *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *ExtensionStorageUpsertBulk) SetValue(v string) *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertBulk) UpdateValue() *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *ExtensionStorageUpsertBulk) SetSize(v int) *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *ExtensionStorageUpsertBulk) AddSize(v int) *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertBulk) UpdateSize() *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ExtensionStorageUpsertBulk) SetExpiresAt(v time.Time) *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertBulk) UpdateExpiresAt() *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ExtensionStorageUpsertBulk) ClearExpiresAt() *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExtensionStorageUpsertBulk) SetUpdatedAt(v time.Time) *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExtensionStorageUpsertBulk) UpdateUpdatedAt() *ExtensionStorageUpsertBulk {
+	return u.Update(func(s *ExtensionStorageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionStorageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExtensionStorageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionStorageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionStorageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
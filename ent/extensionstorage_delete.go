@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionstorage"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionStorageDelete is the builder for deleting a ExtensionStorage entity.
+type ExtensionStorageDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionStorageMutation
+}
+
+// Where appends a list predicates to the ExtensionStorageDelete builder.
+func (_d *ExtensionStorageDelete) Where(ps ...predicate.ExtensionStorage) *ExtensionStorageDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ExtensionStorageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExtensionStorageDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ExtensionStorageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(extensionstorage.Table, sqlgraph.NewFieldSpec(extensionstorage.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ExtensionStorageDeleteOne is the builder for deleting a single ExtensionStorage entity.
+type ExtensionStorageDeleteOne struct {
+	_d *ExtensionStorageDelete
+}
+
+// Where appends a list predicates to the ExtensionStorageDelete builder.
+func (_d *ExtensionStorageDeleteOne) Where(ps ...predicate.ExtensionStorage) *ExtensionStorageDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ExtensionStorageDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{extensionstorage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExtensionStorageDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
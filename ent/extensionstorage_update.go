@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionstorage"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionStorageUpdate is the builder for updating ExtensionStorage entities.
+type ExtensionStorageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionStorageMutation
+}
+
+// Where appends a list predicates to the ExtensionStorageUpdate builder.
+func (_u *ExtensionStorageUpdate) Where(ps ...predicate.ExtensionStorage) *ExtensionStorageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionStorageUpdate) SetPackage(v string) *ExtensionStorageUpdate {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionStorageUpdate) SetNillablePackage(v *string) *ExtensionStorageUpdate {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *ExtensionStorageUpdate) SetKey(v string) *ExtensionStorageUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ExtensionStorageUpdate) SetNillableKey(v *string) *ExtensionStorageUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *ExtensionStorageUpdate) SetValue(v string) *ExtensionStorageUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *ExtensionStorageUpdate) SetNillableValue(v *string) *ExtensionStorageUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *ExtensionStorageUpdate) SetSize(v int) *ExtensionStorageUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *ExtensionStorageUpdate) SetNillableSize(v *int) *ExtensionStorageUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *ExtensionStorageUpdate) AddSize(v int) *ExtensionStorageUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ExtensionStorageUpdate) SetExpiresAt(v time.Time) *ExtensionStorageUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ExtensionStorageUpdate) SetNillableExpiresAt(v *time.Time) *ExtensionStorageUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ExtensionStorageUpdate) ClearExpiresAt() *ExtensionStorageUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExtensionStorageUpdate) SetUpdatedAt(v time.Time) *ExtensionStorageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtensionStorageMutation object of the builder.
+func (_u *ExtensionStorageUpdate) Mutation() *ExtensionStorageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExtensionStorageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionStorageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExtensionStorageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionStorageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtensionStorageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := extensionstorage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionStorageUpdate) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensionstorage.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := extensionstorage.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := extensionstorage.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionStorageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensionstorage.Table, extensionstorage.Columns, sqlgraph.NewFieldSpec(extensionstorage.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensionstorage.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(extensionstorage.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(extensionstorage.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(extensionstorage.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(extensionstorage.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(extensionstorage.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(extensionstorage.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(extensionstorage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensionstorage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExtensionStorageUpdateOne is the builder for updating a single ExtensionStorage entity.
+type ExtensionStorageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExtensionStorageMutation
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionStorageUpdateOne) SetPackage(v string) *ExtensionStorageUpdateOne {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionStorageUpdateOne) SetNillablePackage(v *string) *ExtensionStorageUpdateOne {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *ExtensionStorageUpdateOne) SetKey(v string) *ExtensionStorageUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ExtensionStorageUpdateOne) SetNillableKey(v *string) *ExtensionStorageUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *ExtensionStorageUpdateOne) SetValue(v string) *ExtensionStorageUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *ExtensionStorageUpdateOne) SetNillableValue(v *string) *ExtensionStorageUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *ExtensionStorageUpdateOne) SetSize(v int) *ExtensionStorageUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *ExtensionStorageUpdateOne) SetNillableSize(v *int) *ExtensionStorageUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *ExtensionStorageUpdateOne) AddSize(v int) *ExtensionStorageUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ExtensionStorageUpdateOne) SetExpiresAt(v time.Time) *ExtensionStorageUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ExtensionStorageUpdateOne) SetNillableExpiresAt(v *time.Time) *ExtensionStorageUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ExtensionStorageUpdateOne) ClearExpiresAt() *ExtensionStorageUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExtensionStorageUpdateOne) SetUpdatedAt(v time.Time) *ExtensionStorageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtensionStorageMutation object of the builder.
+func (_u *ExtensionStorageUpdateOne) Mutation() *ExtensionStorageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExtensionStorageUpdate builder.
+func (_u *ExtensionStorageUpdateOne) Where(ps ...predicate.ExtensionStorage) *ExtensionStorageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExtensionStorageUpdateOne) Select(field string, fields ...string) *ExtensionStorageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExtensionStorage entity.
+func (_u *ExtensionStorageUpdateOne) Save(ctx context.Context) (*ExtensionStorage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionStorageUpdateOne) SaveX(ctx context.Context) *ExtensionStorage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExtensionStorageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionStorageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtensionStorageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := extensionstorage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionStorageUpdateOne) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensionstorage.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := extensionstorage.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := extensionstorage.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ExtensionStorage.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionStorageUpdateOne) sqlSave(ctx context.Context) (_node *ExtensionStorage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensionstorage.Table, extensionstorage.Columns, sqlgraph.NewFieldSpec(extensionstorage.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExtensionStorage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, extensionstorage.FieldID)
+		for _, f := range fields {
+			if !extensionstorage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != extensionstorage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensionstorage.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(extensionstorage.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(extensionstorage.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(extensionstorage.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(extensionstorage.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(extensionstorage.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(extensionstorage.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(extensionstorage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ExtensionStorage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensionstorage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+)
+
+// ExtensionVerification is the model entity for the ExtensionVerification schema.
+type ExtensionVerification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Package name of the extension
+	Package string `json:"package,omitempty"`
+	// URL of the repository the script was downloaded from
+	Repo string `json:"repo,omitempty"`
+	// signed and hashed scripts matched the index, failures are recorded as ExtensionVerificationFailure
+	Status extensionverification.Status `json:"status,omitempty"`
+	// sha256 of the verified script in hex
+	Hash string `json:"hash,omitempty"`
+	// Trusted key the script was signed with
+	Key string `json:"key,omitempty"`
+	// Why the script failed verification
+	Reason string `json:"reason,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt   time.Time `json:"verified_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExtensionVerification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case extensionverification.FieldID:
+			values[i] = new(sql.NullInt64)
+		case extensionverification.FieldPackage, extensionverification.FieldRepo, extensionverification.FieldStatus, extensionverification.FieldHash, extensionverification.FieldKey, extensionverification.FieldReason:
+			values[i] = new(sql.NullString)
+		case extensionverification.FieldVerifiedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExtensionVerification fields.
+func (_m *ExtensionVerification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case extensionverification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case extensionverification.FieldPackage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package", values[i])
+			} else if value.Valid {
+				_m.Package = value.String
+			}
+		case extensionverification.FieldRepo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repo", values[i])
+			} else if value.Valid {
+				_m.Repo = value.String
+			}
+		case extensionverification.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = extensionverification.Status(value.String)
+			}
+		case extensionverification.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case extensionverification.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case extensionverification.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case extensionverification.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				_m.VerifiedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExtensionVerification.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExtensionVerification) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExtensionVerification.
+// Note that you need to call ExtensionVerification.Unwrap() before calling this method if this ExtensionVerification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExtensionVerification) Update() *ExtensionVerificationUpdateOne {
+	return NewExtensionVerificationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExtensionVerification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExtensionVerification) Unwrap() *ExtensionVerification {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExtensionVerification is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExtensionVerification) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExtensionVerification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("package=")
+	builder.WriteString(_m.Package)
+	builder.WriteString(", ")
+	builder.WriteString("repo=")
+	builder.WriteString(_m.Repo)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("verified_at=")
+	builder.WriteString(_m.VerifiedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExtensionVerifications is a parsable slice of ExtensionVerification.
+type ExtensionVerifications []*ExtensionVerification
@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extensionverification
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the extensionverification type in the database.
+	Label = "extension_verification"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackage holds the string denoting the package field in the database.
+	FieldPackage = "package"
+	// FieldRepo holds the string denoting the repo field in the database.
+	FieldRepo = "repo"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
+	// Table holds the table name of the extensionverification in the database.
+	Table = "extension_verifications"
+)
+
+// Columns holds all SQL columns for extensionverification fields.
+var Columns = []string{
+	FieldID,
+	FieldPackage,
+	FieldRepo,
+	FieldStatus,
+	FieldHash,
+	FieldKey,
+	FieldReason,
+	FieldVerifiedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	PackageValidator func(string) error
+	// DefaultVerifiedAt holds the default value on creation for the "verified_at" field.
+	DefaultVerifiedAt func() time.Time
+	// UpdateDefaultVerifiedAt holds the default value on update for the "verified_at" field.
+	UpdateDefaultVerifiedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusSigned     Status = "signed"
+	StatusHashed     Status = "hashed"
+	StatusUnverified Status = "unverified"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusSigned, StatusHashed, StatusUnverified:
+		return nil
+	default:
+		return fmt.Errorf("extensionverification: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ExtensionVerification queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPackage orders the results by the package field.
+func ByPackage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackage, opts...).ToFunc()
+}
+
+// ByRepo orders the results by the repo field.
+func ByRepo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepo, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
@@ -0,0 +1,851 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+)
+
+// ExtensionVerificationCreate is the builder for creating a ExtensionVerification entity.
+type ExtensionVerificationCreate struct {
+	config
+	mutation *ExtensionVerificationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackage sets the "package" field.
+func (_c *ExtensionVerificationCreate) SetPackage(v string) *ExtensionVerificationCreate {
+	_c.mutation.SetPackage(v)
+	return _c
+}
+
+// SetRepo sets the "repo" field.
+func (_c *ExtensionVerificationCreate) SetRepo(v string) *ExtensionVerificationCreate {
+	_c.mutation.SetRepo(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ExtensionVerificationCreate) SetStatus(v extensionverification.Status) *ExtensionVerificationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *ExtensionVerificationCreate) SetHash(v string) *ExtensionVerificationCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *ExtensionVerificationCreate) SetKey(v string) *ExtensionVerificationCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_c *ExtensionVerificationCreate) SetNillableKey(v *string) *ExtensionVerificationCreate {
+	if v != nil {
+		_c.SetKey(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ExtensionVerificationCreate) SetReason(v string) *ExtensionVerificationCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ExtensionVerificationCreate) SetNillableReason(v *string) *ExtensionVerificationCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_c *ExtensionVerificationCreate) SetVerifiedAt(v time.Time) *ExtensionVerificationCreate {
+	_c.mutation.SetVerifiedAt(v)
+	return _c
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_c *ExtensionVerificationCreate) SetNillableVerifiedAt(v *time.Time) *ExtensionVerificationCreate {
+	if v != nil {
+		_c.SetVerifiedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ExtensionVerificationMutation object of the builder.
+func (_c *ExtensionVerificationCreate) Mutation() *ExtensionVerificationMutation {
+	return _c.mutation
+}
+
+// Save creates the ExtensionVerification in the database.
+func (_c *ExtensionVerificationCreate) Save(ctx context.Context) (*ExtensionVerification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExtensionVerificationCreate) SaveX(ctx context.Context) *ExtensionVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionVerificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionVerificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExtensionVerificationCreate) defaults() {
+	if _, ok := _c.mutation.VerifiedAt(); !ok {
+		v := extensionverification.DefaultVerifiedAt()
+		_c.mutation.SetVerifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExtensionVerificationCreate) check() error {
+	if _, ok := _c.mutation.Package(); !ok {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required field "ExtensionVerification.package"`)}
+	}
+	if v, ok := _c.mutation.Package(); ok {
+		if err := extensionverification.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerification.package": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Repo(); !ok {
+		return &ValidationError{Name: "repo", err: errors.New(`ent: missing required field "ExtensionVerification.repo"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExtensionVerification.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := extensionverification.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerification.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "ExtensionVerification.hash"`)}
+	}
+	if _, ok := _c.mutation.VerifiedAt(); !ok {
+		return &ValidationError{Name: "verified_at", err: errors.New(`ent: missing required field "ExtensionVerification.verified_at"`)}
+	}
+	return nil
+}
+
+func (_c *ExtensionVerificationCreate) sqlSave(ctx context.Context) (*ExtensionVerification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExtensionVerificationCreate) createSpec() (*ExtensionVerification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExtensionVerification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(extensionverification.Table, sqlgraph.NewFieldSpec(extensionverification.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Package(); ok {
+		_spec.SetField(extensionverification.FieldPackage, field.TypeString, value)
+		_node.Package = value
+	}
+	if value, ok := _c.mutation.Repo(); ok {
+		_spec.SetField(extensionverification.FieldRepo, field.TypeString, value)
+		_node.Repo = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(extensionverification.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(extensionverification.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(extensionverification.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(extensionverification.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.VerifiedAt(); ok {
+		_spec.SetField(extensionverification.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionVerification.Create().
+//		SetPackage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionVerificationUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionVerificationCreate) OnConflict(opts ...sql.ConflictOption) *ExtensionVerificationUpsertOne {
+	_c.conflict = opts
+	return &ExtensionVerificationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionVerification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionVerificationCreate) OnConflictColumns(columns ...string) *ExtensionVerificationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionVerificationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExtensionVerificationUpsertOne is the builder for "upsert"-ing
+	//  one ExtensionVerification node.
+	ExtensionVerificationUpsertOne struct {
+		create *ExtensionVerificationCreate
+	}
+
+	// ExtensionVerificationUpsert is the "OnConflict" setter.
+	ExtensionVerificationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackage sets the "package" field.
+func (u *ExtensionVerificationUpsert) SetPackage(v string) *ExtensionVerificationUpsert {
+	u.Set(extensionverification.FieldPackage, v)
+	return u
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsert) UpdatePackage() *ExtensionVerificationUpsert {
+	u.SetExcluded(extensionverification.FieldPackage)
+	return u
+}
+
+// SetRepo sets the "repo" field.
+func (u *ExtensionVerificationUpsert) SetRepo(v string) *ExtensionVerificationUpsert {
+	u.Set(extensionverification.FieldRepo, v)
+	return u
+}
+
+// UpdateRepo sets the "repo" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsert) UpdateRepo() *ExtensionVerificationUpsert {
+	u.SetExcluded(extensionverification.FieldRepo)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtensionVerificationUpsert) SetStatus(v extensionverification.Status) *ExtensionVerificationUpsert {
+	u.Set(extensionverification.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsert) UpdateStatus() *ExtensionVerificationUpsert {
+	u.SetExcluded(extensionverification.FieldStatus)
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *ExtensionVerificationUpsert) SetHash(v string) *ExtensionVerificationUpsert {
+	u.Set(extensionverification.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsert) UpdateHash() *ExtensionVerificationUpsert {
+	u.SetExcluded(extensionverification.FieldHash)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *ExtensionVerificationUpsert) SetKey(v string) *ExtensionVerificationUpsert {
+	u.Set(extensionverification.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsert) UpdateKey() *ExtensionVerificationUpsert {
+	u.SetExcluded(extensionverification.FieldKey)
+	return u
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *ExtensionVerificationUpsert) ClearKey() *ExtensionVerificationUpsert {
+	u.SetNull(extensionverification.FieldKey)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ExtensionVerificationUpsert) SetReason(v string) *ExtensionVerificationUpsert {
+	u.Set(extensionverification.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsert) UpdateReason() *ExtensionVerificationUpsert {
+	u.SetExcluded(extensionverification.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ExtensionVerificationUpsert) ClearReason() *ExtensionVerificationUpsert {
+	u.SetNull(extensionverification.FieldReason)
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *ExtensionVerificationUpsert) SetVerifiedAt(v time.Time) *ExtensionVerificationUpsert {
+	u.Set(extensionverification.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsert) UpdateVerifiedAt() *ExtensionVerificationUpsert {
+	u.SetExcluded(extensionverification.FieldVerifiedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionVerification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionVerificationUpsertOne) UpdateNewValues() *ExtensionVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionVerification.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExtensionVerificationUpsertOne) Ignore() *ExtensionVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionVerificationUpsertOne) DoNothing() *ExtensionVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionVerificationCreate.OnConflict
+// documentation for more info.
+func (u *ExtensionVerificationUpsertOne) Update(set func(*ExtensionVerificationUpsert)) *ExtensionVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionVerificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionVerificationUpsertOne) SetPackage(v string) *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertOne) UpdatePackage() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetRepo sets the "repo" field.
+func (u *ExtensionVerificationUpsertOne) SetRepo(v string) *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetRepo(v)
+	})
+}
+
+// UpdateRepo sets the "repo" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertOne) UpdateRepo() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateRepo()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtensionVerificationUpsertOne) SetStatus(v extensionverification.Status) *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertOne) UpdateStatus() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *ExtensionVerificationUpsertOne) SetHash(v string) *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertOne) UpdateHash() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *ExtensionVerificationUpsertOne) SetKey(v string) *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertOne) UpdateKey() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *ExtensionVerificationUpsertOne) ClearKey() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.ClearKey()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ExtensionVerificationUpsertOne) SetReason(v string) *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertOne) UpdateReason() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ExtensionVerificationUpsertOne) ClearReason() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *ExtensionVerificationUpsertOne) SetVerifiedAt(v time.Time) *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertOne) UpdateVerifiedAt() *ExtensionVerificationUpsertOne {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionVerificationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionVerificationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionVerificationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExtensionVerificationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExtensionVerificationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExtensionVerificationCreateBulk is the builder for creating many ExtensionVerification entities in bulk.
+type ExtensionVerificationCreateBulk struct {
+	config
+	err      error
+	builders []*ExtensionVerificationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExtensionVerification entities in the database.
+func (_c *ExtensionVerificationCreateBulk) Save(ctx context.Context) ([]*ExtensionVerification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExtensionVerification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExtensionVerificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExtensionVerificationCreateBulk) SaveX(ctx context.Context) []*ExtensionVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionVerificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionVerificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionVerification.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionVerificationUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionVerificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExtensionVerificationUpsertBulk {
+	_c.conflict = opts
+	return &ExtensionVerificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionVerification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionVerificationCreateBulk) OnConflictColumns(columns ...string) *ExtensionVerificationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionVerificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExtensionVerificationUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExtensionVerification nodes.
+type ExtensionVerificationUpsertBulk struct {
+	create *ExtensionVerificationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExtensionVerification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionVerificationUpsertBulk) UpdateNewValues() *ExtensionVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionVerification.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExtensionVerificationUpsertBulk) Ignore() *ExtensionVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionVerificationUpsertBulk) DoNothing() *ExtensionVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionVerificationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExtensionVerificationUpsertBulk) Update(set func(*ExtensionVerificationUpsert)) *ExtensionVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionVerificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionVerificationUpsertBulk) SetPackage(v string) *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertBulk) UpdatePackage() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetRepo sets the "repo" field.
+func (u *ExtensionVerificationUpsertBulk) SetRepo(v string) *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetRepo(v)
+	})
+}
+
+// UpdateRepo sets the "repo" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertBulk) UpdateRepo() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateRepo()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtensionVerificationUpsertBulk) SetStatus(v extensionverification.Status) *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertBulk) UpdateStatus() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *ExtensionVerificationUpsertBulk) SetHash(v string) *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertBulk) UpdateHash() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *ExtensionVerificationUpsertBulk) SetKey(v string) *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertBulk) UpdateKey() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *ExtensionVerificationUpsertBulk) ClearKey() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.ClearKey()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ExtensionVerificationUpsertBulk) SetReason(v string) *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertBulk) UpdateReason() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ExtensionVerificationUpsertBulk) ClearReason() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *ExtensionVerificationUpsertBulk) SetVerifiedAt(v time.Time) *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *ExtensionVerificationUpsertBulk) UpdateVerifiedAt() *ExtensionVerificationUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionVerificationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExtensionVerificationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionVerificationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionVerificationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
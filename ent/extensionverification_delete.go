@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionVerificationDelete is the builder for deleting a ExtensionVerification entity.
+type ExtensionVerificationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionVerificationMutation
+}
+
+// Where appends a list predicates to the ExtensionVerificationDelete builder.
+func (_d *ExtensionVerificationDelete) Where(ps ...predicate.ExtensionVerification) *ExtensionVerificationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ExtensionVerificationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExtensionVerificationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ExtensionVerificationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(extensionverification.Table, sqlgraph.NewFieldSpec(extensionverification.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ExtensionVerificationDeleteOne is the builder for deleting a single ExtensionVerification entity.
+type ExtensionVerificationDeleteOne struct {
+	_d *ExtensionVerificationDelete
+}
+
+// Where appends a list predicates to the ExtensionVerificationDelete builder.
+func (_d *ExtensionVerificationDeleteOne) Where(ps ...predicate.ExtensionVerification) *ExtensionVerificationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ExtensionVerificationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{extensionverification.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExtensionVerificationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionVerificationUpdate is the builder for updating ExtensionVerification entities.
+type ExtensionVerificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionVerificationMutation
+}
+
+// Where appends a list predicates to the ExtensionVerificationUpdate builder.
+func (_u *ExtensionVerificationUpdate) Where(ps ...predicate.ExtensionVerification) *ExtensionVerificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionVerificationUpdate) SetPackage(v string) *ExtensionVerificationUpdate {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdate) SetNillablePackage(v *string) *ExtensionVerificationUpdate {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetRepo sets the "repo" field.
+func (_u *ExtensionVerificationUpdate) SetRepo(v string) *ExtensionVerificationUpdate {
+	_u.mutation.SetRepo(v)
+	return _u
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdate) SetNillableRepo(v *string) *ExtensionVerificationUpdate {
+	if v != nil {
+		_u.SetRepo(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExtensionVerificationUpdate) SetStatus(v extensionverification.Status) *ExtensionVerificationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdate) SetNillableStatus(v *extensionverification.Status) *ExtensionVerificationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ExtensionVerificationUpdate) SetHash(v string) *ExtensionVerificationUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdate) SetNillableHash(v *string) *ExtensionVerificationUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *ExtensionVerificationUpdate) SetKey(v string) *ExtensionVerificationUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdate) SetNillableKey(v *string) *ExtensionVerificationUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *ExtensionVerificationUpdate) ClearKey() *ExtensionVerificationUpdate {
+	_u.mutation.ClearKey()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ExtensionVerificationUpdate) SetReason(v string) *ExtensionVerificationUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdate) SetNillableReason(v *string) *ExtensionVerificationUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ExtensionVerificationUpdate) ClearReason() *ExtensionVerificationUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *ExtensionVerificationUpdate) SetVerifiedAt(v time.Time) *ExtensionVerificationUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtensionVerificationMutation object of the builder.
+func (_u *ExtensionVerificationUpdate) Mutation() *ExtensionVerificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExtensionVerificationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExtensionVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionVerificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtensionVerificationUpdate) defaults() {
+	if _, ok := _u.mutation.VerifiedAt(); !ok {
+		v := extensionverification.UpdateDefaultVerifiedAt()
+		_u.mutation.SetVerifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionVerificationUpdate) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensionverification.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerification.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := extensionverification.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerification.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionVerificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensionverification.Table, extensionverification.Columns, sqlgraph.NewFieldSpec(extensionverification.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensionverification.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repo(); ok {
+		_spec.SetField(extensionverification.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(extensionverification.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(extensionverification.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(extensionverification.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(extensionverification.FieldKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(extensionverification.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(extensionverification.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(extensionverification.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensionverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExtensionVerificationUpdateOne is the builder for updating a single ExtensionVerification entity.
+type ExtensionVerificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExtensionVerificationMutation
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionVerificationUpdateOne) SetPackage(v string) *ExtensionVerificationUpdateOne {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdateOne) SetNillablePackage(v *string) *ExtensionVerificationUpdateOne {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetRepo sets the "repo" field.
+func (_u *ExtensionVerificationUpdateOne) SetRepo(v string) *ExtensionVerificationUpdateOne {
+	_u.mutation.SetRepo(v)
+	return _u
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdateOne) SetNillableRepo(v *string) *ExtensionVerificationUpdateOne {
+	if v != nil {
+		_u.SetRepo(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExtensionVerificationUpdateOne) SetStatus(v extensionverification.Status) *ExtensionVerificationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdateOne) SetNillableStatus(v *extensionverification.Status) *ExtensionVerificationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ExtensionVerificationUpdateOne) SetHash(v string) *ExtensionVerificationUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdateOne) SetNillableHash(v *string) *ExtensionVerificationUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *ExtensionVerificationUpdateOne) SetKey(v string) *ExtensionVerificationUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdateOne) SetNillableKey(v *string) *ExtensionVerificationUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// ClearKey clears the value of the "key" field.
+func (_u *ExtensionVerificationUpdateOne) ClearKey() *ExtensionVerificationUpdateOne {
+	_u.mutation.ClearKey()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ExtensionVerificationUpdateOne) SetReason(v string) *ExtensionVerificationUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ExtensionVerificationUpdateOne) SetNillableReason(v *string) *ExtensionVerificationUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ExtensionVerificationUpdateOne) ClearReason() *ExtensionVerificationUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *ExtensionVerificationUpdateOne) SetVerifiedAt(v time.Time) *ExtensionVerificationUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtensionVerificationMutation object of the builder.
+func (_u *ExtensionVerificationUpdateOne) Mutation() *ExtensionVerificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExtensionVerificationUpdate builder.
+func (_u *ExtensionVerificationUpdateOne) Where(ps ...predicate.ExtensionVerification) *ExtensionVerificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExtensionVerificationUpdateOne) Select(field string, fields ...string) *ExtensionVerificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExtensionVerification entity.
+func (_u *ExtensionVerificationUpdateOne) Save(ctx context.Context) (*ExtensionVerification, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionVerificationUpdateOne) SaveX(ctx context.Context) *ExtensionVerification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExtensionVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtensionVerificationUpdateOne) defaults() {
+	if _, ok := _u.mutation.VerifiedAt(); !ok {
+		v := extensionverification.UpdateDefaultVerifiedAt()
+		_u.mutation.SetVerifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionVerificationUpdateOne) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensionverification.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerification.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := extensionverification.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerification.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionVerificationUpdateOne) sqlSave(ctx context.Context) (_node *ExtensionVerification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensionverification.Table, extensionverification.Columns, sqlgraph.NewFieldSpec(extensionverification.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExtensionVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, extensionverification.FieldID)
+		for _, f := range fields {
+			if !extensionverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != extensionverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensionverification.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repo(); ok {
+		_spec.SetField(extensionverification.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(extensionverification.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(extensionverification.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(extensionverification.FieldKey, field.TypeString, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(extensionverification.FieldKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(extensionverification.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(extensionverification.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(extensionverification.FieldVerifiedAt, field.TypeTime, value)
+	}
+	_node = &ExtensionVerification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensionverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
+)
+
+// ExtensionVerificationFailure is the model entity for the ExtensionVerificationFailure schema.
+type ExtensionVerificationFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Package name of the extension
+	Package string `json:"package,omitempty"`
+	// URL of the repository the script was downloaded from
+	Repo string `json:"repo,omitempty"`
+	// Whether the script was dropped or kept in the quarantine directory
+	Status extensionverificationfailure.Status `json:"status,omitempty"`
+	// sha256 of the failed script in hex
+	Hash string `json:"hash,omitempty"`
+	// Why the script failed verification
+	Reason string `json:"reason,omitempty"`
+	// FailedAt holds the value of the "failed_at" field.
+	FailedAt     time.Time `json:"failed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExtensionVerificationFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case extensionverificationfailure.FieldID:
+			values[i] = new(sql.NullInt64)
+		case extensionverificationfailure.FieldPackage, extensionverificationfailure.FieldRepo, extensionverificationfailure.FieldStatus, extensionverificationfailure.FieldHash, extensionverificationfailure.FieldReason:
+			values[i] = new(sql.NullString)
+		case extensionverificationfailure.FieldFailedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExtensionVerificationFailure fields.
+func (_m *ExtensionVerificationFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case extensionverificationfailure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case extensionverificationfailure.FieldPackage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package", values[i])
+			} else if value.Valid {
+				_m.Package = value.String
+			}
+		case extensionverificationfailure.FieldRepo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repo", values[i])
+			} else if value.Valid {
+				_m.Repo = value.String
+			}
+		case extensionverificationfailure.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = extensionverificationfailure.Status(value.String)
+			}
+		case extensionverificationfailure.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case extensionverificationfailure.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case extensionverificationfailure.FieldFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_at", values[i])
+			} else if value.Valid {
+				_m.FailedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExtensionVerificationFailure.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExtensionVerificationFailure) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExtensionVerificationFailure.
+// Note that you need to call ExtensionVerificationFailure.Unwrap() before calling this method if this ExtensionVerificationFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExtensionVerificationFailure) Update() *ExtensionVerificationFailureUpdateOne {
+	return NewExtensionVerificationFailureClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExtensionVerificationFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExtensionVerificationFailure) Unwrap() *ExtensionVerificationFailure {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExtensionVerificationFailure is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExtensionVerificationFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExtensionVerificationFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("package=")
+	builder.WriteString(_m.Package)
+	builder.WriteString(", ")
+	builder.WriteString("repo=")
+	builder.WriteString(_m.Repo)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("failed_at=")
+	builder.WriteString(_m.FailedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExtensionVerificationFailures is a parsable slice of ExtensionVerificationFailure.
+type ExtensionVerificationFailures []*ExtensionVerificationFailure
@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extensionverificationfailure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLTE(FieldID, id))
+}
+
+// Package applies equality check predicate on the "package" field. It's identical to PackageEQ.
+func Package(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldPackage, v))
+}
+
+// Repo applies equality check predicate on the "repo" field. It's identical to RepoEQ.
+func Repo(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldRepo, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldHash, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldReason, v))
+}
+
+// FailedAt applies equality check predicate on the "failed_at" field. It's identical to FailedAtEQ.
+func FailedAt(v time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// PackageEQ applies the EQ predicate on the "package" field.
+func PackageEQ(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldPackage, v))
+}
+
+// PackageNEQ applies the NEQ predicate on the "package" field.
+func PackageNEQ(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNEQ(FieldPackage, v))
+}
+
+// PackageIn applies the In predicate on the "package" field.
+func PackageIn(vs ...string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldIn(FieldPackage, vs...))
+}
+
+// PackageNotIn applies the NotIn predicate on the "package" field.
+func PackageNotIn(vs ...string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNotIn(FieldPackage, vs...))
+}
+
+// PackageGT applies the GT predicate on the "package" field.
+func PackageGT(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGT(FieldPackage, v))
+}
+
+// PackageGTE applies the GTE predicate on the "package" field.
+func PackageGTE(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGTE(FieldPackage, v))
+}
+
+// PackageLT applies the LT predicate on the "package" field.
+func PackageLT(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLT(FieldPackage, v))
+}
+
+// PackageLTE applies the LTE predicate on the "package" field.
+func PackageLTE(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLTE(FieldPackage, v))
+}
+
+// PackageContains applies the Contains predicate on the "package" field.
+func PackageContains(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldContains(FieldPackage, v))
+}
+
+// PackageHasPrefix applies the HasPrefix predicate on the "package" field.
+func PackageHasPrefix(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldHasPrefix(FieldPackage, v))
+}
+
+// PackageHasSuffix applies the HasSuffix predicate on the "package" field.
+func PackageHasSuffix(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldHasSuffix(FieldPackage, v))
+}
+
+// PackageEqualFold applies the EqualFold predicate on the "package" field.
+func PackageEqualFold(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEqualFold(FieldPackage, v))
+}
+
+// PackageContainsFold applies the ContainsFold predicate on the "package" field.
+func PackageContainsFold(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldContainsFold(FieldPackage, v))
+}
+
+// RepoEQ applies the EQ predicate on the "repo" field.
+func RepoEQ(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldRepo, v))
+}
+
+// RepoNEQ applies the NEQ predicate on the "repo" field.
+func RepoNEQ(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNEQ(FieldRepo, v))
+}
+
+// RepoIn applies the In predicate on the "repo" field.
+func RepoIn(vs ...string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldIn(FieldRepo, vs...))
+}
+
+// RepoNotIn applies the NotIn predicate on the "repo" field.
+func RepoNotIn(vs ...string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNotIn(FieldRepo, vs...))
+}
+
+// RepoGT applies the GT predicate on the "repo" field.
+func RepoGT(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGT(FieldRepo, v))
+}
+
+// RepoGTE applies the GTE predicate on the "repo" field.
+func RepoGTE(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGTE(FieldRepo, v))
+}
+
+// RepoLT applies the LT predicate on the "repo" field.
+func RepoLT(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLT(FieldRepo, v))
+}
+
+// RepoLTE applies the LTE predicate on the "repo" field.
+func RepoLTE(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLTE(FieldRepo, v))
+}
+
+// RepoContains applies the Contains predicate on the "repo" field.
+func RepoContains(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldContains(FieldRepo, v))
+}
+
+// RepoHasPrefix applies the HasPrefix predicate on the "repo" field.
+func RepoHasPrefix(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldHasPrefix(FieldRepo, v))
+}
+
+// RepoHasSuffix applies the HasSuffix predicate on the "repo" field.
+func RepoHasSuffix(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldHasSuffix(FieldRepo, v))
+}
+
+// RepoEqualFold applies the EqualFold predicate on the "repo" field.
+func RepoEqualFold(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEqualFold(FieldRepo, v))
+}
+
+// RepoContainsFold applies the ContainsFold predicate on the "repo" field.
+func RepoContainsFold(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldContainsFold(FieldRepo, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldContainsFold(FieldHash, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldContainsFold(FieldReason, v))
+}
+
+// FailedAtEQ applies the EQ predicate on the "failed_at" field.
+func FailedAtEQ(v time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// FailedAtNEQ applies the NEQ predicate on the "failed_at" field.
+func FailedAtNEQ(v time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNEQ(FieldFailedAt, v))
+}
+
+// FailedAtIn applies the In predicate on the "failed_at" field.
+func FailedAtIn(vs ...time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldIn(FieldFailedAt, vs...))
+}
+
+// FailedAtNotIn applies the NotIn predicate on the "failed_at" field.
+func FailedAtNotIn(vs ...time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldNotIn(FieldFailedAt, vs...))
+}
+
+// FailedAtGT applies the GT predicate on the "failed_at" field.
+func FailedAtGT(v time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGT(FieldFailedAt, v))
+}
+
+// FailedAtGTE applies the GTE predicate on the "failed_at" field.
+func FailedAtGTE(v time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldGTE(FieldFailedAt, v))
+}
+
+// FailedAtLT applies the LT predicate on the "failed_at" field.
+func FailedAtLT(v time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLT(FieldFailedAt, v))
+}
+
+// FailedAtLTE applies the LTE predicate on the "failed_at" field.
+func FailedAtLTE(v time.Time) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.FieldLTE(FieldFailedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExtensionVerificationFailure) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExtensionVerificationFailure) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExtensionVerificationFailure) predicate.ExtensionVerificationFailure {
+	return predicate.ExtensionVerificationFailure(sql.NotPredicates(p))
+}
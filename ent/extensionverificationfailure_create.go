@@ -0,0 +1,748 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
+)
+
+// ExtensionVerificationFailureCreate is the builder for creating a ExtensionVerificationFailure entity.
+type ExtensionVerificationFailureCreate struct {
+	config
+	mutation *ExtensionVerificationFailureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackage sets the "package" field.
+func (_c *ExtensionVerificationFailureCreate) SetPackage(v string) *ExtensionVerificationFailureCreate {
+	_c.mutation.SetPackage(v)
+	return _c
+}
+
+// SetRepo sets the "repo" field.
+func (_c *ExtensionVerificationFailureCreate) SetRepo(v string) *ExtensionVerificationFailureCreate {
+	_c.mutation.SetRepo(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ExtensionVerificationFailureCreate) SetStatus(v extensionverificationfailure.Status) *ExtensionVerificationFailureCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *ExtensionVerificationFailureCreate) SetHash(v string) *ExtensionVerificationFailureCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ExtensionVerificationFailureCreate) SetReason(v string) *ExtensionVerificationFailureCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_c *ExtensionVerificationFailureCreate) SetFailedAt(v time.Time) *ExtensionVerificationFailureCreate {
+	_c.mutation.SetFailedAt(v)
+	return _c
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_c *ExtensionVerificationFailureCreate) SetNillableFailedAt(v *time.Time) *ExtensionVerificationFailureCreate {
+	if v != nil {
+		_c.SetFailedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ExtensionVerificationFailureMutation object of the builder.
+func (_c *ExtensionVerificationFailureCreate) Mutation() *ExtensionVerificationFailureMutation {
+	return _c.mutation
+}
+
+// Save creates the ExtensionVerificationFailure in the database.
+func (_c *ExtensionVerificationFailureCreate) Save(ctx context.Context) (*ExtensionVerificationFailure, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExtensionVerificationFailureCreate) SaveX(ctx context.Context) *ExtensionVerificationFailure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionVerificationFailureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionVerificationFailureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExtensionVerificationFailureCreate) defaults() {
+	if _, ok := _c.mutation.FailedAt(); !ok {
+		v := extensionverificationfailure.DefaultFailedAt()
+		_c.mutation.SetFailedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExtensionVerificationFailureCreate) check() error {
+	if _, ok := _c.mutation.Package(); !ok {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required field "ExtensionVerificationFailure.package"`)}
+	}
+	if v, ok := _c.mutation.Package(); ok {
+		if err := extensionverificationfailure.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerificationFailure.package": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Repo(); !ok {
+		return &ValidationError{Name: "repo", err: errors.New(`ent: missing required field "ExtensionVerificationFailure.repo"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExtensionVerificationFailure.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := extensionverificationfailure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerificationFailure.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "ExtensionVerificationFailure.hash"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ExtensionVerificationFailure.reason"`)}
+	}
+	if _, ok := _c.mutation.FailedAt(); !ok {
+		return &ValidationError{Name: "failed_at", err: errors.New(`ent: missing required field "ExtensionVerificationFailure.failed_at"`)}
+	}
+	return nil
+}
+
+func (_c *ExtensionVerificationFailureCreate) sqlSave(ctx context.Context) (*ExtensionVerificationFailure, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExtensionVerificationFailureCreate) createSpec() (*ExtensionVerificationFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExtensionVerificationFailure{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(extensionverificationfailure.Table, sqlgraph.NewFieldSpec(extensionverificationfailure.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Package(); ok {
+		_spec.SetField(extensionverificationfailure.FieldPackage, field.TypeString, value)
+		_node.Package = value
+	}
+	if value, ok := _c.mutation.Repo(); ok {
+		_spec.SetField(extensionverificationfailure.FieldRepo, field.TypeString, value)
+		_node.Repo = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(extensionverificationfailure.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(extensionverificationfailure.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(extensionverificationfailure.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.FailedAt(); ok {
+		_spec.SetField(extensionverificationfailure.FieldFailedAt, field.TypeTime, value)
+		_node.FailedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionVerificationFailure.Create().
+//		SetPackage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionVerificationFailureUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionVerificationFailureCreate) OnConflict(opts ...sql.ConflictOption) *ExtensionVerificationFailureUpsertOne {
+	_c.conflict = opts
+	return &ExtensionVerificationFailureUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionVerificationFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionVerificationFailureCreate) OnConflictColumns(columns ...string) *ExtensionVerificationFailureUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionVerificationFailureUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExtensionVerificationFailureUpsertOne is the builder for "upsert"-ing
+	//  one ExtensionVerificationFailure node.
+	ExtensionVerificationFailureUpsertOne struct {
+		create *ExtensionVerificationFailureCreate
+	}
+
+	// ExtensionVerificationFailureUpsert is the "OnConflict" setter.
+	ExtensionVerificationFailureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackage sets the "package" field.
+func (u *ExtensionVerificationFailureUpsert) SetPackage(v string) *ExtensionVerificationFailureUpsert {
+	u.Set(extensionverificationfailure.FieldPackage, v)
+	return u
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsert) UpdatePackage() *ExtensionVerificationFailureUpsert {
+	u.SetExcluded(extensionverificationfailure.FieldPackage)
+	return u
+}
+
+// SetRepo sets the "repo" field.
+func (u *ExtensionVerificationFailureUpsert) SetRepo(v string) *ExtensionVerificationFailureUpsert {
+	u.Set(extensionverificationfailure.FieldRepo, v)
+	return u
+}
+
+// UpdateRepo sets the "repo" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsert) UpdateRepo() *ExtensionVerificationFailureUpsert {
+	u.SetExcluded(extensionverificationfailure.FieldRepo)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtensionVerificationFailureUpsert) SetStatus(v extensionverificationfailure.Status) *ExtensionVerificationFailureUpsert {
+	u.Set(extensionverificationfailure.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsert) UpdateStatus() *ExtensionVerificationFailureUpsert {
+	u.SetExcluded(extensionverificationfailure.FieldStatus)
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *ExtensionVerificationFailureUpsert) SetHash(v string) *ExtensionVerificationFailureUpsert {
+	u.Set(extensionverificationfailure.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsert) UpdateHash() *ExtensionVerificationFailureUpsert {
+	u.SetExcluded(extensionverificationfailure.FieldHash)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ExtensionVerificationFailureUpsert) SetReason(v string) *ExtensionVerificationFailureUpsert {
+	u.Set(extensionverificationfailure.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsert) UpdateReason() *ExtensionVerificationFailureUpsert {
+	u.SetExcluded(extensionverificationfailure.FieldReason)
+	return u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *ExtensionVerificationFailureUpsert) SetFailedAt(v time.Time) *ExtensionVerificationFailureUpsert {
+	u.Set(extensionverificationfailure.FieldFailedAt, v)
+	return u
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsert) UpdateFailedAt() *ExtensionVerificationFailureUpsert {
+	u.SetExcluded(extensionverificationfailure.FieldFailedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionVerificationFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionVerificationFailureUpsertOne) UpdateNewValues() *ExtensionVerificationFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionVerificationFailure.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExtensionVerificationFailureUpsertOne) Ignore() *ExtensionVerificationFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionVerificationFailureUpsertOne) DoNothing() *ExtensionVerificationFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionVerificationFailureCreate.OnConflict
+// documentation for more info.
+func (u *ExtensionVerificationFailureUpsertOne) Update(set func(*ExtensionVerificationFailureUpsert)) *ExtensionVerificationFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionVerificationFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionVerificationFailureUpsertOne) SetPackage(v string) *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertOne) UpdatePackage() *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetRepo sets the "repo" field.
+func (u *ExtensionVerificationFailureUpsertOne) SetRepo(v string) *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetRepo(v)
+	})
+}
+
+// UpdateRepo sets the "repo" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertOne) UpdateRepo() *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateRepo()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtensionVerificationFailureUpsertOne) SetStatus(v extensionverificationfailure.Status) *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertOne) UpdateStatus() *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *ExtensionVerificationFailureUpsertOne) SetHash(v string) *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertOne) UpdateHash() *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ExtensionVerificationFailureUpsertOne) SetReason(v string) *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertOne) UpdateReason() *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *ExtensionVerificationFailureUpsertOne) SetFailedAt(v time.Time) *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetFailedAt(v)
+	})
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertOne) UpdateFailedAt() *ExtensionVerificationFailureUpsertOne {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionVerificationFailureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionVerificationFailureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionVerificationFailureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExtensionVerificationFailureUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExtensionVerificationFailureUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExtensionVerificationFailureCreateBulk is the builder for creating many ExtensionVerificationFailure entities in bulk.
+type ExtensionVerificationFailureCreateBulk struct {
+	config
+	err      error
+	builders []*ExtensionVerificationFailureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExtensionVerificationFailure entities in the database.
+func (_c *ExtensionVerificationFailureCreateBulk) Save(ctx context.Context) ([]*ExtensionVerificationFailure, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExtensionVerificationFailure, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExtensionVerificationFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExtensionVerificationFailureCreateBulk) SaveX(ctx context.Context) []*ExtensionVerificationFailure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtensionVerificationFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtensionVerificationFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtensionVerificationFailure.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtensionVerificationFailureUpsert) {
+//			SetPackage(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtensionVerificationFailureCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExtensionVerificationFailureUpsertBulk {
+	_c.conflict = opts
+	return &ExtensionVerificationFailureUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtensionVerificationFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtensionVerificationFailureCreateBulk) OnConflictColumns(columns ...string) *ExtensionVerificationFailureUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtensionVerificationFailureUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExtensionVerificationFailureUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExtensionVerificationFailure nodes.
+type ExtensionVerificationFailureUpsertBulk struct {
+	create *ExtensionVerificationFailureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExtensionVerificationFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExtensionVerificationFailureUpsertBulk) UpdateNewValues() *ExtensionVerificationFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtensionVerificationFailure.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExtensionVerificationFailureUpsertBulk) Ignore() *ExtensionVerificationFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtensionVerificationFailureUpsertBulk) DoNothing() *ExtensionVerificationFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtensionVerificationFailureCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExtensionVerificationFailureUpsertBulk) Update(set func(*ExtensionVerificationFailureUpsert)) *ExtensionVerificationFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtensionVerificationFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackage sets the "package" field.
+func (u *ExtensionVerificationFailureUpsertBulk) SetPackage(v string) *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetPackage(v)
+	})
+}
+
+// UpdatePackage sets the "package" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertBulk) UpdatePackage() *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdatePackage()
+	})
+}
+
+// SetRepo sets the "repo" field.
+func (u *ExtensionVerificationFailureUpsertBulk) SetRepo(v string) *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetRepo(v)
+	})
+}
+
+// UpdateRepo sets the "repo" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertBulk) UpdateRepo() *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateRepo()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtensionVerificationFailureUpsertBulk) SetStatus(v extensionverificationfailure.Status) *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertBulk) UpdateStatus() *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *ExtensionVerificationFailureUpsertBulk) SetHash(v string) *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertBulk) UpdateHash() *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ExtensionVerificationFailureUpsertBulk) SetReason(v string) *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertBulk) UpdateReason() *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *ExtensionVerificationFailureUpsertBulk) SetFailedAt(v time.Time) *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.SetFailedAt(v)
+	})
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *ExtensionVerificationFailureUpsertBulk) UpdateFailedAt() *ExtensionVerificationFailureUpsertBulk {
+	return u.Update(func(s *ExtensionVerificationFailureUpsert) {
+		s.UpdateFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtensionVerificationFailureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExtensionVerificationFailureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtensionVerificationFailureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtensionVerificationFailureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionVerificationFailureDelete is the builder for deleting a ExtensionVerificationFailure entity.
+type ExtensionVerificationFailureDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionVerificationFailureMutation
+}
+
+// Where appends a list predicates to the ExtensionVerificationFailureDelete builder.
+func (_d *ExtensionVerificationFailureDelete) Where(ps ...predicate.ExtensionVerificationFailure) *ExtensionVerificationFailureDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ExtensionVerificationFailureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExtensionVerificationFailureDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ExtensionVerificationFailureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(extensionverificationfailure.Table, sqlgraph.NewFieldSpec(extensionverificationfailure.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ExtensionVerificationFailureDeleteOne is the builder for deleting a single ExtensionVerificationFailure entity.
+type ExtensionVerificationFailureDeleteOne struct {
+	_d *ExtensionVerificationFailureDelete
+}
+
+// Where appends a list predicates to the ExtensionVerificationFailureDelete builder.
+func (_d *ExtensionVerificationFailureDeleteOne) Where(ps ...predicate.ExtensionVerificationFailure) *ExtensionVerificationFailureDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ExtensionVerificationFailureDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{extensionverificationfailure.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExtensionVerificationFailureDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
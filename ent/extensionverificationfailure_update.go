@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
+	"github.com/miru-project/miru-core/ent/predicate"
+)
+
+// ExtensionVerificationFailureUpdate is the builder for updating ExtensionVerificationFailure entities.
+type ExtensionVerificationFailureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExtensionVerificationFailureMutation
+}
+
+// Where appends a list predicates to the ExtensionVerificationFailureUpdate builder.
+func (_u *ExtensionVerificationFailureUpdate) Where(ps ...predicate.ExtensionVerificationFailure) *ExtensionVerificationFailureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionVerificationFailureUpdate) SetPackage(v string) *ExtensionVerificationFailureUpdate {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdate) SetNillablePackage(v *string) *ExtensionVerificationFailureUpdate {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetRepo sets the "repo" field.
+func (_u *ExtensionVerificationFailureUpdate) SetRepo(v string) *ExtensionVerificationFailureUpdate {
+	_u.mutation.SetRepo(v)
+	return _u
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdate) SetNillableRepo(v *string) *ExtensionVerificationFailureUpdate {
+	if v != nil {
+		_u.SetRepo(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExtensionVerificationFailureUpdate) SetStatus(v extensionverificationfailure.Status) *ExtensionVerificationFailureUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdate) SetNillableStatus(v *extensionverificationfailure.Status) *ExtensionVerificationFailureUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ExtensionVerificationFailureUpdate) SetHash(v string) *ExtensionVerificationFailureUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdate) SetNillableHash(v *string) *ExtensionVerificationFailureUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ExtensionVerificationFailureUpdate) SetReason(v string) *ExtensionVerificationFailureUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdate) SetNillableReason(v *string) *ExtensionVerificationFailureUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_u *ExtensionVerificationFailureUpdate) SetFailedAt(v time.Time) *ExtensionVerificationFailureUpdate {
+	_u.mutation.SetFailedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtensionVerificationFailureMutation object of the builder.
+func (_u *ExtensionVerificationFailureUpdate) Mutation() *ExtensionVerificationFailureMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExtensionVerificationFailureUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionVerificationFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExtensionVerificationFailureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionVerificationFailureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtensionVerificationFailureUpdate) defaults() {
+	if _, ok := _u.mutation.FailedAt(); !ok {
+		v := extensionverificationfailure.UpdateDefaultFailedAt()
+		_u.mutation.SetFailedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionVerificationFailureUpdate) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensionverificationfailure.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerificationFailure.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := extensionverificationfailure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerificationFailure.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionVerificationFailureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensionverificationfailure.Table, extensionverificationfailure.Columns, sqlgraph.NewFieldSpec(extensionverificationfailure.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensionverificationfailure.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repo(); ok {
+		_spec.SetField(extensionverificationfailure.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(extensionverificationfailure.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(extensionverificationfailure.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(extensionverificationfailure.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailedAt(); ok {
+		_spec.SetField(extensionverificationfailure.FieldFailedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensionverificationfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExtensionVerificationFailureUpdateOne is the builder for updating a single ExtensionVerificationFailure entity.
+type ExtensionVerificationFailureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExtensionVerificationFailureMutation
+}
+
+// SetPackage sets the "package" field.
+func (_u *ExtensionVerificationFailureUpdateOne) SetPackage(v string) *ExtensionVerificationFailureUpdateOne {
+	_u.mutation.SetPackage(v)
+	return _u
+}
+
+// SetNillablePackage sets the "package" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdateOne) SetNillablePackage(v *string) *ExtensionVerificationFailureUpdateOne {
+	if v != nil {
+		_u.SetPackage(*v)
+	}
+	return _u
+}
+
+// SetRepo sets the "repo" field.
+func (_u *ExtensionVerificationFailureUpdateOne) SetRepo(v string) *ExtensionVerificationFailureUpdateOne {
+	_u.mutation.SetRepo(v)
+	return _u
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdateOne) SetNillableRepo(v *string) *ExtensionVerificationFailureUpdateOne {
+	if v != nil {
+		_u.SetRepo(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExtensionVerificationFailureUpdateOne) SetStatus(v extensionverificationfailure.Status) *ExtensionVerificationFailureUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdateOne) SetNillableStatus(v *extensionverificationfailure.Status) *ExtensionVerificationFailureUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ExtensionVerificationFailureUpdateOne) SetHash(v string) *ExtensionVerificationFailureUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdateOne) SetNillableHash(v *string) *ExtensionVerificationFailureUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ExtensionVerificationFailureUpdateOne) SetReason(v string) *ExtensionVerificationFailureUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ExtensionVerificationFailureUpdateOne) SetNillableReason(v *string) *ExtensionVerificationFailureUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_u *ExtensionVerificationFailureUpdateOne) SetFailedAt(v time.Time) *ExtensionVerificationFailureUpdateOne {
+	_u.mutation.SetFailedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtensionVerificationFailureMutation object of the builder.
+func (_u *ExtensionVerificationFailureUpdateOne) Mutation() *ExtensionVerificationFailureMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExtensionVerificationFailureUpdate builder.
+func (_u *ExtensionVerificationFailureUpdateOne) Where(ps ...predicate.ExtensionVerificationFailure) *ExtensionVerificationFailureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExtensionVerificationFailureUpdateOne) Select(field string, fields ...string) *ExtensionVerificationFailureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExtensionVerificationFailure entity.
+func (_u *ExtensionVerificationFailureUpdateOne) Save(ctx context.Context) (*ExtensionVerificationFailure, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtensionVerificationFailureUpdateOne) SaveX(ctx context.Context) *ExtensionVerificationFailure {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExtensionVerificationFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtensionVerificationFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtensionVerificationFailureUpdateOne) defaults() {
+	if _, ok := _u.mutation.FailedAt(); !ok {
+		v := extensionverificationfailure.UpdateDefaultFailedAt()
+		_u.mutation.SetFailedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtensionVerificationFailureUpdateOne) check() error {
+	if v, ok := _u.mutation.Package(); ok {
+		if err := extensionverificationfailure.PackageValidator(v); err != nil {
+			return &ValidationError{Name: "package", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerificationFailure.package": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := extensionverificationfailure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExtensionVerificationFailure.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtensionVerificationFailureUpdateOne) sqlSave(ctx context.Context) (_node *ExtensionVerificationFailure, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extensionverificationfailure.Table, extensionverificationfailure.Columns, sqlgraph.NewFieldSpec(extensionverificationfailure.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExtensionVerificationFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, extensionverificationfailure.FieldID)
+		for _, f := range fields {
+			if !extensionverificationfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != extensionverificationfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Package(); ok {
+		_spec.SetField(extensionverificationfailure.FieldPackage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repo(); ok {
+		_spec.SetField(extensionverificationfailure.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(extensionverificationfailure.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(extensionverificationfailure.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(extensionverificationfailure.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailedAt(); ok {
+		_spec.SetField(extensionverificationfailure.FieldFailedAt, field.TypeTime, value)
+	}
+	_node = &ExtensionVerificationFailure{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extensionverificationfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
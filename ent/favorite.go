@@ -30,6 +30,8 @@ type Favorite struct {
 	Cover *string `json:"cover,omitempty"`
 	// Date when the favorite was created/updated
 	Date time.Time `json:"date,omitempty"`
+	// Last time the favorite was checked for new episodes
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FavoriteQuery when eager-loading is set.
 	Edges        FavoriteEdges `json:"edges"`
@@ -63,7 +65,7 @@ func (*Favorite) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case favorite.FieldPackage, favorite.FieldURL, favorite.FieldType, favorite.FieldTitle, favorite.FieldCover:
 			values[i] = new(sql.NullString)
-		case favorite.FieldDate:
+		case favorite.FieldDate, favorite.FieldCheckedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -123,6 +125,13 @@ func (_m *Favorite) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Date = value.Time
 			}
+		case favorite.FieldCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
+			} else if value.Valid {
+				_m.CheckedAt = new(time.Time)
+				*_m.CheckedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -183,6 +192,11 @@ func (_m *Favorite) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("date=")
 	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CheckedAt; v != nil {
+		builder.WriteString("checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -26,6 +26,8 @@ const (
 	FieldCover = "cover"
 	// FieldDate holds the string denoting the date field in the database.
 	FieldDate = "date"
+	// FieldCheckedAt holds the string denoting the checked_at field in the database.
+	FieldCheckedAt = "checked_at"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// Table holds the table name of the favorite in the database.
@@ -46,6 +48,7 @@ var Columns = []string{
 	FieldTitle,
 	FieldCover,
 	FieldDate,
+	FieldCheckedAt,
 }
 
 var (
@@ -117,6 +120,11 @@ func ByDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDate, opts...).ToFunc()
 }
 
+// ByCheckedAt orders the results by the checked_at field.
+func ByCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedAt, opts...).ToFunc()
+}
+
 // ByGroupCount orders the results by group count.
 func ByGroupCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -85,6 +85,11 @@ func Date(v time.Time) predicate.Favorite {
 	return predicate.Favorite(sql.FieldEQ(FieldDate, v))
 }
 
+// CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
+func CheckedAt(v time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldEQ(FieldCheckedAt, v))
+}
+
 // PackageEQ applies the EQ predicate on the "package" field.
 func PackageEQ(v string) predicate.Favorite {
 	return predicate.Favorite(sql.FieldEQ(FieldPackage, v))
@@ -460,6 +465,56 @@ func DateLTE(v time.Time) predicate.Favorite {
 	return predicate.Favorite(sql.FieldLTE(FieldDate, v))
 }
 
+// CheckedAtEQ applies the EQ predicate on the "checked_at" field.
+func CheckedAtEQ(v time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtNEQ applies the NEQ predicate on the "checked_at" field.
+func CheckedAtNEQ(v time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldNEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtIn applies the In predicate on the "checked_at" field.
+func CheckedAtIn(vs ...time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtNotIn applies the NotIn predicate on the "checked_at" field.
+func CheckedAtNotIn(vs ...time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldNotIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtGT applies the GT predicate on the "checked_at" field.
+func CheckedAtGT(v time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldGT(FieldCheckedAt, v))
+}
+
+// CheckedAtGTE applies the GTE predicate on the "checked_at" field.
+func CheckedAtGTE(v time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldGTE(FieldCheckedAt, v))
+}
+
+// CheckedAtLT applies the LT predicate on the "checked_at" field.
+func CheckedAtLT(v time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldLT(FieldCheckedAt, v))
+}
+
+// CheckedAtLTE applies the LTE predicate on the "checked_at" field.
+func CheckedAtLTE(v time.Time) predicate.Favorite {
+	return predicate.Favorite(sql.FieldLTE(FieldCheckedAt, v))
+}
+
+// CheckedAtIsNil applies the IsNil predicate on the "checked_at" field.
+func CheckedAtIsNil() predicate.Favorite {
+	return predicate.Favorite(sql.FieldIsNull(FieldCheckedAt))
+}
+
+// CheckedAtNotNil applies the NotNil predicate on the "checked_at" field.
+func CheckedAtNotNil() predicate.Favorite {
+	return predicate.Favorite(sql.FieldNotNull(FieldCheckedAt))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.Favorite {
 	return predicate.Favorite(func(s *sql.Selector) {
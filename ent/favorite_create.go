@@ -75,6 +75,20 @@ func (_c *FavoriteCreate) SetNillableDate(v *time.Time) *FavoriteCreate {
 	return _c
 }
 
+// SetCheckedAt sets the "checked_at" field.
+func (_c *FavoriteCreate) SetCheckedAt(v time.Time) *FavoriteCreate {
+	_c.mutation.SetCheckedAt(v)
+	return _c
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_c *FavoriteCreate) SetNillableCheckedAt(v *time.Time) *FavoriteCreate {
+	if v != nil {
+		_c.SetCheckedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FavoriteCreate) SetID(v int) *FavoriteCreate {
 	_c.mutation.SetID(v)
@@ -236,6 +250,10 @@ func (_c *FavoriteCreate) createSpec() (*Favorite, *sqlgraph.CreateSpec) {
 		_spec.SetField(favorite.FieldDate, field.TypeTime, value)
 		_node.Date = value
 	}
+	if value, ok := _c.mutation.CheckedAt(); ok {
+		_spec.SetField(favorite.FieldCheckedAt, field.TypeTime, value)
+		_node.CheckedAt = &value
+	}
 	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -382,6 +400,24 @@ func (u *FavoriteUpsert) UpdateDate() *FavoriteUpsert {
 	return u
 }
 
+// SetCheckedAt sets the "checked_at" field.
+func (u *FavoriteUpsert) SetCheckedAt(v time.Time) *FavoriteUpsert {
+	u.Set(favorite.FieldCheckedAt, v)
+	return u
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *FavoriteUpsert) UpdateCheckedAt() *FavoriteUpsert {
+	u.SetExcluded(favorite.FieldCheckedAt)
+	return u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *FavoriteUpsert) ClearCheckedAt() *FavoriteUpsert {
+	u.SetNull(favorite.FieldCheckedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -521,6 +557,27 @@ func (u *FavoriteUpsertOne) UpdateDate() *FavoriteUpsertOne {
 	})
 }
 
+// SetCheckedAt sets the "checked_at" field.
+func (u *FavoriteUpsertOne) SetCheckedAt(v time.Time) *FavoriteUpsertOne {
+	return u.Update(func(s *FavoriteUpsert) {
+		s.SetCheckedAt(v)
+	})
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *FavoriteUpsertOne) UpdateCheckedAt() *FavoriteUpsertOne {
+	return u.Update(func(s *FavoriteUpsert) {
+		s.UpdateCheckedAt()
+	})
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *FavoriteUpsertOne) ClearCheckedAt() *FavoriteUpsertOne {
+	return u.Update(func(s *FavoriteUpsert) {
+		s.ClearCheckedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *FavoriteUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -826,6 +883,27 @@ func (u *FavoriteUpsertBulk) UpdateDate() *FavoriteUpsertBulk {
 	})
 }
 
+// SetCheckedAt sets the "checked_at" field.
+func (u *FavoriteUpsertBulk) SetCheckedAt(v time.Time) *FavoriteUpsertBulk {
+	return u.Update(func(s *FavoriteUpsert) {
+		s.SetCheckedAt(v)
+	})
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *FavoriteUpsertBulk) UpdateCheckedAt() *FavoriteUpsertBulk {
+	return u.Update(func(s *FavoriteUpsert) {
+		s.UpdateCheckedAt()
+	})
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *FavoriteUpsertBulk) ClearCheckedAt() *FavoriteUpsertBulk {
+	return u.Update(func(s *FavoriteUpsert) {
+		s.ClearCheckedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *FavoriteUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -119,6 +119,26 @@ func (_u *FavoriteUpdate) SetNillableDate(v *time.Time) *FavoriteUpdate {
 	return _u
 }
 
+// SetCheckedAt sets the "checked_at" field.
+func (_u *FavoriteUpdate) SetCheckedAt(v time.Time) *FavoriteUpdate {
+	_u.mutation.SetCheckedAt(v)
+	return _u
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_u *FavoriteUpdate) SetNillableCheckedAt(v *time.Time) *FavoriteUpdate {
+	if v != nil {
+		_u.SetCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (_u *FavoriteUpdate) ClearCheckedAt() *FavoriteUpdate {
+	_u.mutation.ClearCheckedAt()
+	return _u
+}
+
 // AddGroupIDs adds the "group" edge to the FavoriteGroup entity by IDs.
 func (_u *FavoriteUpdate) AddGroupIDs(ids ...int) *FavoriteUpdate {
 	_u.mutation.AddGroupIDs(ids...)
@@ -245,6 +265,12 @@ func (_u *FavoriteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Date(); ok {
 		_spec.SetField(favorite.FieldDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.CheckedAt(); ok {
+		_spec.SetField(favorite.FieldCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckedAtCleared() {
+		_spec.ClearField(favorite.FieldCheckedAt, field.TypeTime)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -400,6 +426,26 @@ func (_u *FavoriteUpdateOne) SetNillableDate(v *time.Time) *FavoriteUpdateOne {
 	return _u
 }
 
+// SetCheckedAt sets the "checked_at" field.
+func (_u *FavoriteUpdateOne) SetCheckedAt(v time.Time) *FavoriteUpdateOne {
+	_u.mutation.SetCheckedAt(v)
+	return _u
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (_u *FavoriteUpdateOne) SetNillableCheckedAt(v *time.Time) *FavoriteUpdateOne {
+	if v != nil {
+		_u.SetCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (_u *FavoriteUpdateOne) ClearCheckedAt() *FavoriteUpdateOne {
+	_u.mutation.ClearCheckedAt()
+	return _u
+}
+
 // AddGroupIDs adds the "group" edge to the FavoriteGroup entity by IDs.
 func (_u *FavoriteUpdateOne) AddGroupIDs(ids ...int) *FavoriteUpdateOne {
 	_u.mutation.AddGroupIDs(ids...)
@@ -556,6 +602,12 @@ func (_u *FavoriteUpdateOne) sqlSave(ctx context.Context) (_node *Favorite, err
 	if value, ok := _u.mutation.Date(); ok {
 		_spec.SetField(favorite.FieldDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.CheckedAt(); ok {
+		_spec.SetField(favorite.FieldCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckedAtCleared() {
+		_spec.ClearField(favorite.FieldCheckedAt, field.TypeTime)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
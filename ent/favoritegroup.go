@@ -22,6 +22,8 @@ type FavoriteGroup struct {
 	Name string `json:"name,omitempty"`
 	// Date when the group was created/updated
 	Date time.Time `json:"date,omitempty"`
+	// Minutes between checks of its favorites for new episodes, 0 never checks and nil uses the default
+	CheckInterval *int `json:"check_interval,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FavoriteGroupQuery when eager-loading is set.
 	Edges        FavoriteGroupEdges `json:"edges"`
@@ -51,7 +53,7 @@ func (*FavoriteGroup) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case favoritegroup.FieldID:
+		case favoritegroup.FieldID, favoritegroup.FieldCheckInterval:
 			values[i] = new(sql.NullInt64)
 		case favoritegroup.FieldName:
 			values[i] = new(sql.NullString)
@@ -90,6 +92,13 @@ func (_m *FavoriteGroup) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Date = value.Time
 			}
+		case favoritegroup.FieldCheckInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_interval", values[i])
+			} else if value.Valid {
+				_m.CheckInterval = new(int)
+				*_m.CheckInterval = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -136,6 +145,11 @@ func (_m *FavoriteGroup) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("date=")
 	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CheckInterval; v != nil {
+		builder.WriteString("check_interval=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
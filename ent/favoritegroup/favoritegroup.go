@@ -18,6 +18,8 @@ const (
 	FieldName = "name"
 	// FieldDate holds the string denoting the date field in the database.
 	FieldDate = "date"
+	// FieldCheckInterval holds the string denoting the check_interval field in the database.
+	FieldCheckInterval = "check_interval"
 	// EdgeFavorites holds the string denoting the favorites edge name in mutations.
 	EdgeFavorites = "favorites"
 	// Table holds the table name of the favoritegroup in the database.
@@ -34,6 +36,7 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldDate,
+	FieldCheckInterval,
 }
 
 var (
@@ -57,6 +60,8 @@ var (
 	NameValidator func(string) error
 	// DefaultDate holds the default value on creation for the "date" field.
 	DefaultDate func() time.Time
+	// CheckIntervalValidator is a validator for the "check_interval" field. It is called by the builders before save.
+	CheckIntervalValidator func(int) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -79,6 +84,11 @@ func ByDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDate, opts...).ToFunc()
 }
 
+// ByCheckInterval orders the results by the check_interval field.
+func ByCheckInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckInterval, opts...).ToFunc()
+}
+
 // ByFavoritesCount orders the results by favorites count.
 func ByFavoritesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -65,6 +65,11 @@ func Date(v time.Time) predicate.FavoriteGroup {
 	return predicate.FavoriteGroup(sql.FieldEQ(FieldDate, v))
 }
 
+// CheckInterval applies equality check predicate on the "check_interval" field. It's identical to CheckIntervalEQ.
+func CheckInterval(v int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldEQ(FieldCheckInterval, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.FavoriteGroup {
 	return predicate.FavoriteGroup(sql.FieldEQ(FieldName, v))
@@ -170,6 +175,56 @@ func DateLTE(v time.Time) predicate.FavoriteGroup {
 	return predicate.FavoriteGroup(sql.FieldLTE(FieldDate, v))
 }
 
+// CheckIntervalEQ applies the EQ predicate on the "check_interval" field.
+func CheckIntervalEQ(v int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldEQ(FieldCheckInterval, v))
+}
+
+// CheckIntervalNEQ applies the NEQ predicate on the "check_interval" field.
+func CheckIntervalNEQ(v int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldNEQ(FieldCheckInterval, v))
+}
+
+// CheckIntervalIn applies the In predicate on the "check_interval" field.
+func CheckIntervalIn(vs ...int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldIn(FieldCheckInterval, vs...))
+}
+
+// CheckIntervalNotIn applies the NotIn predicate on the "check_interval" field.
+func CheckIntervalNotIn(vs ...int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldNotIn(FieldCheckInterval, vs...))
+}
+
+// CheckIntervalGT applies the GT predicate on the "check_interval" field.
+func CheckIntervalGT(v int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldGT(FieldCheckInterval, v))
+}
+
+// CheckIntervalGTE applies the GTE predicate on the "check_interval" field.
+func CheckIntervalGTE(v int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldGTE(FieldCheckInterval, v))
+}
+
+// CheckIntervalLT applies the LT predicate on the "check_interval" field.
+func CheckIntervalLT(v int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldLT(FieldCheckInterval, v))
+}
+
+// CheckIntervalLTE applies the LTE predicate on the "check_interval" field.
+func CheckIntervalLTE(v int) predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldLTE(FieldCheckInterval, v))
+}
+
+// CheckIntervalIsNil applies the IsNil predicate on the "check_interval" field.
+func CheckIntervalIsNil() predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldIsNull(FieldCheckInterval))
+}
+
+// CheckIntervalNotNil applies the NotNil predicate on the "check_interval" field.
+func CheckIntervalNotNil() predicate.FavoriteGroup {
+	return predicate.FavoriteGroup(sql.FieldNotNull(FieldCheckInterval))
+}
+
 // HasFavorites applies the HasEdge predicate on the "favorites" edge.
 func HasFavorites() predicate.FavoriteGroup {
 	return predicate.FavoriteGroup(func(s *sql.Selector) {
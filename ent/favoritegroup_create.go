@@ -43,6 +43,20 @@ func (_c *FavoriteGroupCreate) SetNillableDate(v *time.Time) *FavoriteGroupCreat
 	return _c
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (_c *FavoriteGroupCreate) SetCheckInterval(v int) *FavoriteGroupCreate {
+	_c.mutation.SetCheckInterval(v)
+	return _c
+}
+
+// SetNillableCheckInterval sets the "check_interval" field if the given value is not nil.
+func (_c *FavoriteGroupCreate) SetNillableCheckInterval(v *int) *FavoriteGroupCreate {
+	if v != nil {
+		_c.SetCheckInterval(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FavoriteGroupCreate) SetID(v int) *FavoriteGroupCreate {
 	_c.mutation.SetID(v)
@@ -118,6 +132,11 @@ func (_c *FavoriteGroupCreate) check() error {
 	if _, ok := _c.mutation.Date(); !ok {
 		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "FavoriteGroup.date"`)}
 	}
+	if v, ok := _c.mutation.CheckInterval(); ok {
+		if err := favoritegroup.CheckIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "check_interval", err: fmt.Errorf(`ent: validator failed for field "FavoriteGroup.check_interval": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := favoritegroup.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "FavoriteGroup.id": %w`, err)}
@@ -164,6 +183,10 @@ func (_c *FavoriteGroupCreate) createSpec() (*FavoriteGroup, *sqlgraph.CreateSpe
 		_spec.SetField(favoritegroup.FieldDate, field.TypeTime, value)
 		_node.Date = value
 	}
+	if value, ok := _c.mutation.CheckInterval(); ok {
+		_spec.SetField(favoritegroup.FieldCheckInterval, field.TypeInt, value)
+		_node.CheckInterval = &value
+	}
 	if nodes := _c.mutation.FavoritesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -256,6 +279,30 @@ func (u *FavoriteGroupUpsert) UpdateDate() *FavoriteGroupUpsert {
 	return u
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (u *FavoriteGroupUpsert) SetCheckInterval(v int) *FavoriteGroupUpsert {
+	u.Set(favoritegroup.FieldCheckInterval, v)
+	return u
+}
+
+// UpdateCheckInterval sets the "check_interval" field to the value that was provided on create.
+func (u *FavoriteGroupUpsert) UpdateCheckInterval() *FavoriteGroupUpsert {
+	u.SetExcluded(favoritegroup.FieldCheckInterval)
+	return u
+}
+
+// AddCheckInterval adds v to the "check_interval" field.
+func (u *FavoriteGroupUpsert) AddCheckInterval(v int) *FavoriteGroupUpsert {
+	u.Add(favoritegroup.FieldCheckInterval, v)
+	return u
+}
+
+// ClearCheckInterval clears the value of the "check_interval" field.
+func (u *FavoriteGroupUpsert) ClearCheckInterval() *FavoriteGroupUpsert {
+	u.SetNull(favoritegroup.FieldCheckInterval)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -332,6 +379,34 @@ func (u *FavoriteGroupUpsertOne) UpdateDate() *FavoriteGroupUpsertOne {
 	})
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (u *FavoriteGroupUpsertOne) SetCheckInterval(v int) *FavoriteGroupUpsertOne {
+	return u.Update(func(s *FavoriteGroupUpsert) {
+		s.SetCheckInterval(v)
+	})
+}
+
+// AddCheckInterval adds v to the "check_interval" field.
+func (u *FavoriteGroupUpsertOne) AddCheckInterval(v int) *FavoriteGroupUpsertOne {
+	return u.Update(func(s *FavoriteGroupUpsert) {
+		s.AddCheckInterval(v)
+	})
+}
+
+// UpdateCheckInterval sets the "check_interval" field to the value that was provided on create.
+func (u *FavoriteGroupUpsertOne) UpdateCheckInterval() *FavoriteGroupUpsertOne {
+	return u.Update(func(s *FavoriteGroupUpsert) {
+		s.UpdateCheckInterval()
+	})
+}
+
+// ClearCheckInterval clears the value of the "check_interval" field.
+func (u *FavoriteGroupUpsertOne) ClearCheckInterval() *FavoriteGroupUpsertOne {
+	return u.Update(func(s *FavoriteGroupUpsert) {
+		s.ClearCheckInterval()
+	})
+}
+
 // Exec executes the query.
 func (u *FavoriteGroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -574,6 +649,34 @@ func (u *FavoriteGroupUpsertBulk) UpdateDate() *FavoriteGroupUpsertBulk {
 	})
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (u *FavoriteGroupUpsertBulk) SetCheckInterval(v int) *FavoriteGroupUpsertBulk {
+	return u.Update(func(s *FavoriteGroupUpsert) {
+		s.SetCheckInterval(v)
+	})
+}
+
+// AddCheckInterval adds v to the "check_interval" field.
+func (u *FavoriteGroupUpsertBulk) AddCheckInterval(v int) *FavoriteGroupUpsertBulk {
+	return u.Update(func(s *FavoriteGroupUpsert) {
+		s.AddCheckInterval(v)
+	})
+}
+
+// UpdateCheckInterval sets the "check_interval" field to the value that was provided on create.
+func (u *FavoriteGroupUpsertBulk) UpdateCheckInterval() *FavoriteGroupUpsertBulk {
+	return u.Update(func(s *FavoriteGroupUpsert) {
+		s.UpdateCheckInterval()
+	})
+}
+
+// ClearCheckInterval clears the value of the "check_interval" field.
+func (u *FavoriteGroupUpsertBulk) ClearCheckInterval() *FavoriteGroupUpsertBulk {
+	return u.Update(func(s *FavoriteGroupUpsert) {
+		s.ClearCheckInterval()
+	})
+}
+
 // Exec executes the query.
 func (u *FavoriteGroupUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -57,6 +57,33 @@ func (_u *FavoriteGroupUpdate) SetNillableDate(v *time.Time) *FavoriteGroupUpdat
 	return _u
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (_u *FavoriteGroupUpdate) SetCheckInterval(v int) *FavoriteGroupUpdate {
+	_u.mutation.ResetCheckInterval()
+	_u.mutation.SetCheckInterval(v)
+	return _u
+}
+
+// SetNillableCheckInterval sets the "check_interval" field if the given value is not nil.
+func (_u *FavoriteGroupUpdate) SetNillableCheckInterval(v *int) *FavoriteGroupUpdate {
+	if v != nil {
+		_u.SetCheckInterval(*v)
+	}
+	return _u
+}
+
+// AddCheckInterval adds value to the "check_interval" field.
+func (_u *FavoriteGroupUpdate) AddCheckInterval(v int) *FavoriteGroupUpdate {
+	_u.mutation.AddCheckInterval(v)
+	return _u
+}
+
+// ClearCheckInterval clears the value of the "check_interval" field.
+func (_u *FavoriteGroupUpdate) ClearCheckInterval() *FavoriteGroupUpdate {
+	_u.mutation.ClearCheckInterval()
+	return _u
+}
+
 // AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
 func (_u *FavoriteGroupUpdate) AddFavoriteIDs(ids ...int) *FavoriteGroupUpdate {
 	_u.mutation.AddFavoriteIDs(ids...)
@@ -132,6 +159,11 @@ func (_u *FavoriteGroupUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FavoriteGroup.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CheckInterval(); ok {
+		if err := favoritegroup.CheckIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "check_interval", err: fmt.Errorf(`ent: validator failed for field "FavoriteGroup.check_interval": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -153,6 +185,15 @@ func (_u *FavoriteGroupUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.Date(); ok {
 		_spec.SetField(favoritegroup.FieldDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.CheckInterval(); ok {
+		_spec.SetField(favoritegroup.FieldCheckInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckInterval(); ok {
+		_spec.AddField(favoritegroup.FieldCheckInterval, field.TypeInt, value)
+	}
+	if _u.mutation.CheckIntervalCleared() {
+		_spec.ClearField(favoritegroup.FieldCheckInterval, field.TypeInt)
+	}
 	if _u.mutation.FavoritesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -246,6 +287,33 @@ func (_u *FavoriteGroupUpdateOne) SetNillableDate(v *time.Time) *FavoriteGroupUp
 	return _u
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (_u *FavoriteGroupUpdateOne) SetCheckInterval(v int) *FavoriteGroupUpdateOne {
+	_u.mutation.ResetCheckInterval()
+	_u.mutation.SetCheckInterval(v)
+	return _u
+}
+
+// SetNillableCheckInterval sets the "check_interval" field if the given value is not nil.
+func (_u *FavoriteGroupUpdateOne) SetNillableCheckInterval(v *int) *FavoriteGroupUpdateOne {
+	if v != nil {
+		_u.SetCheckInterval(*v)
+	}
+	return _u
+}
+
+// AddCheckInterval adds value to the "check_interval" field.
+func (_u *FavoriteGroupUpdateOne) AddCheckInterval(v int) *FavoriteGroupUpdateOne {
+	_u.mutation.AddCheckInterval(v)
+	return _u
+}
+
+// ClearCheckInterval clears the value of the "check_interval" field.
+func (_u *FavoriteGroupUpdateOne) ClearCheckInterval() *FavoriteGroupUpdateOne {
+	_u.mutation.ClearCheckInterval()
+	return _u
+}
+
 // AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
 func (_u *FavoriteGroupUpdateOne) AddFavoriteIDs(ids ...int) *FavoriteGroupUpdateOne {
 	_u.mutation.AddFavoriteIDs(ids...)
@@ -334,6 +402,11 @@ func (_u *FavoriteGroupUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FavoriteGroup.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CheckInterval(); ok {
+		if err := favoritegroup.CheckIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "check_interval", err: fmt.Errorf(`ent: validator failed for field "FavoriteGroup.check_interval": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -372,6 +445,15 @@ func (_u *FavoriteGroupUpdateOne) sqlSave(ctx context.Context) (_node *FavoriteG
 	if value, ok := _u.mutation.Date(); ok {
 		_spec.SetField(favoritegroup.FieldDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.CheckInterval(); ok {
+		_spec.SetField(favoritegroup.FieldCheckInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckInterval(); ok {
+		_spec.AddField(favoritegroup.FieldCheckInterval, field.TypeInt, value)
+	}
+	if _u.mutation.CheckIntervalCleared() {
+		_spec.ClearField(favoritegroup.FieldCheckInterval, field.TypeInt)
+	}
 	if _u.mutation.FavoritesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -45,6 +45,30 @@ func (f DownloadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DownloadMutation", m)
 }
 
+// The EpisodeUpdateFunc type is an adapter to allow the use of ordinary
+// function as EpisodeUpdate mutator.
+type EpisodeUpdateFunc func(context.Context, *ent.EpisodeUpdateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EpisodeUpdateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EpisodeUpdateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EpisodeUpdateMutation", m)
+}
+
+// The ExtensionGrantFunc type is an adapter to allow the use of ordinary
+// function as ExtensionGrant mutator.
+type ExtensionGrantFunc func(context.Context, *ent.ExtensionGrantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExtensionGrantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExtensionGrantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtensionGrantMutation", m)
+}
+
 // The ExtensionRepoSettingFunc type is an adapter to allow the use of ordinary
 // function as ExtensionRepoSetting mutator.
 type ExtensionRepoSettingFunc func(context.Context, *ent.ExtensionRepoSettingMutation) (ent.Value, error)
@@ -69,6 +93,54 @@ func (f ExtensionSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtensionSettingMutation", m)
 }
 
+// The ExtensionStateFunc type is an adapter to allow the use of ordinary
+// function as ExtensionState mutator.
+type ExtensionStateFunc func(context.Context, *ent.ExtensionStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExtensionStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExtensionStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtensionStateMutation", m)
+}
+
+// The ExtensionStorageFunc type is an adapter to allow the use of ordinary
+// function as ExtensionStorage mutator.
+type ExtensionStorageFunc func(context.Context, *ent.ExtensionStorageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExtensionStorageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExtensionStorageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtensionStorageMutation", m)
+}
+
+// The ExtensionVerificationFunc type is an adapter to allow the use of ordinary
+// function as ExtensionVerification mutator.
+type ExtensionVerificationFunc func(context.Context, *ent.ExtensionVerificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExtensionVerificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExtensionVerificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtensionVerificationMutation", m)
+}
+
+// The ExtensionVerificationFailureFunc type is an adapter to allow the use of ordinary
+// function as ExtensionVerificationFailure mutator.
+type ExtensionVerificationFailureFunc func(context.Context, *ent.ExtensionVerificationFailureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExtensionVerificationFailureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExtensionVerificationFailureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtensionVerificationFailureMutation", m)
+}
+
 // The FavoriteFunc type is an adapter to allow the use of ordinary
 // function as Favorite mutator.
 type FavoriteFunc func(context.Context, *ent.FavoriteMutation) (ent.Value, error)
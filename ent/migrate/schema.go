@@ -75,11 +75,55 @@ var (
 			},
 		},
 	}
+	// EpisodeUpdatesColumns holds the columns for the "episode_updates" table.
+	EpisodeUpdatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "package", Type: field.TypeString},
+		{Name: "detail_url", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "group_title", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "date", Type: field.TypeTime},
+	}
+	// EpisodeUpdatesTable holds the schema information for the "episode_updates" table.
+	EpisodeUpdatesTable = &schema.Table{
+		Name:       "episode_updates",
+		Columns:    EpisodeUpdatesColumns,
+		PrimaryKey: []*schema.Column{EpisodeUpdatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "episodeupdate_package_detail_url_url",
+				Unique:  true,
+				Columns: []*schema.Column{EpisodeUpdatesColumns[1], EpisodeUpdatesColumns[2], EpisodeUpdatesColumns[6]},
+			},
+		},
+	}
+	// ExtensionGrantsColumns holds the columns for the "extension_grants" table.
+	ExtensionGrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "package", Type: field.TypeString},
+		{Name: "domain", Type: field.TypeString},
+	}
+	// ExtensionGrantsTable holds the schema information for the "extension_grants" table.
+	ExtensionGrantsTable = &schema.Table{
+		Name:       "extension_grants",
+		Columns:    ExtensionGrantsColumns,
+		PrimaryKey: []*schema.Column{ExtensionGrantsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_extension_grant_package_domain",
+				Unique:  true,
+				Columns: []*schema.Column{ExtensionGrantsColumns[1], ExtensionGrantsColumns[2]},
+			},
+		},
+	}
 	// ExtensionRepoSettingsColumns holds the columns for the "extension_repo_settings" table.
 	ExtensionRepoSettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "link", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "trusted_keys", Type: field.TypeJSON, Nullable: true},
 	}
 	// ExtensionRepoSettingsTable holds the schema information for the "extension_repo_settings" table.
 	ExtensionRepoSettingsTable = &schema.Table{
@@ -95,7 +139,7 @@ var (
 		{Name: "key", Type: field.TypeString},
 		{Name: "value", Type: field.TypeString, Nullable: true},
 		{Name: "default_value", Type: field.TypeString, Default: ""},
-		{Name: "db_type", Type: field.TypeEnum, Enums: []string{"input", "radio", "toggle"}, Default: "input"},
+		{Name: "db_type", Type: field.TypeEnum, Enums: []string{"input", "radio", "toggle", "number", "multi_select", "secret"}, Default: "input"},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "options", Type: field.TypeString, Nullable: true},
 	}
@@ -112,6 +156,76 @@ var (
 			},
 		},
 	}
+	// ExtensionStatesColumns holds the columns for the "extension_states" table.
+	ExtensionStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "package", Type: field.TypeString, Unique: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "pinned_version", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ExtensionStatesTable holds the schema information for the "extension_states" table.
+	ExtensionStatesTable = &schema.Table{
+		Name:       "extension_states",
+		Columns:    ExtensionStatesColumns,
+		PrimaryKey: []*schema.Column{ExtensionStatesColumns[0]},
+	}
+	// ExtensionStoragesColumns holds the columns for the "extension_storages" table.
+	ExtensionStoragesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "package", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString, Size: 2147483647},
+		{Name: "size", Type: field.TypeInt},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ExtensionStoragesTable holds the schema information for the "extension_storages" table.
+	ExtensionStoragesTable = &schema.Table{
+		Name:       "extension_storages",
+		Columns:    ExtensionStoragesColumns,
+		PrimaryKey: []*schema.Column{ExtensionStoragesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_extension_storage_package_key",
+				Unique:  true,
+				Columns: []*schema.Column{ExtensionStoragesColumns[1], ExtensionStoragesColumns[2]},
+			},
+		},
+	}
+	// ExtensionVerificationsColumns holds the columns for the "extension_verifications" table.
+	ExtensionVerificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "package", Type: field.TypeString, Unique: true},
+		{Name: "repo", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"signed", "hashed", "unverified"}},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "verified_at", Type: field.TypeTime},
+	}
+	// ExtensionVerificationsTable holds the schema information for the "extension_verifications" table.
+	ExtensionVerificationsTable = &schema.Table{
+		Name:       "extension_verifications",
+		Columns:    ExtensionVerificationsColumns,
+		PrimaryKey: []*schema.Column{ExtensionVerificationsColumns[0]},
+	}
+	// ExtensionVerificationFailuresColumns holds the columns for the "extension_verification_failures" table.
+	ExtensionVerificationFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "package", Type: field.TypeString, Unique: true},
+		{Name: "repo", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"rejected", "quarantined"}},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "failed_at", Type: field.TypeTime},
+	}
+	// ExtensionVerificationFailuresTable holds the schema information for the "extension_verification_failures" table.
+	ExtensionVerificationFailuresTable = &schema.Table{
+		Name:       "extension_verification_failures",
+		Columns:    ExtensionVerificationFailuresColumns,
+		PrimaryKey: []*schema.Column{ExtensionVerificationFailuresColumns[0]},
+	}
 	// FavoritesColumns holds the columns for the "favorites" table.
 	FavoritesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -121,6 +235,7 @@ var (
 		{Name: "title", Type: field.TypeString},
 		{Name: "cover", Type: field.TypeString, Nullable: true},
 		{Name: "date", Type: field.TypeTime},
+		{Name: "checked_at", Type: field.TypeTime, Nullable: true},
 	}
 	// FavoritesTable holds the schema information for the "favorites" table.
 	FavoritesTable = &schema.Table{
@@ -140,6 +255,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "date", Type: field.TypeTime},
+		{Name: "check_interval", Type: field.TypeInt, Nullable: true},
 	}
 	// FavoriteGroupsTable holds the schema information for the "favorite_groups" table.
 	FavoriteGroupsTable = &schema.Table{
@@ -284,8 +400,14 @@ var (
 		AppSettingsTable,
 		DetailsTable,
 		DownloadsTable,
+		EpisodeUpdatesTable,
+		ExtensionGrantsTable,
 		ExtensionRepoSettingsTable,
 		ExtensionSettingsTable,
+		ExtensionStatesTable,
+		ExtensionStoragesTable,
+		ExtensionVerificationsTable,
+		ExtensionVerificationFailuresTable,
 		FavoritesTable,
 		FavoriteGroupsTable,
 		HistoriesTable,
@@ -14,8 +14,14 @@ import (
 	"github.com/miru-project/miru-core/ent/appsetting"
 	"github.com/miru-project/miru-core/ent/detail"
 	"github.com/miru-project/miru-core/ent/download"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+	"github.com/miru-project/miru-core/ent/extensiongrant"
 	"github.com/miru-project/miru-core/ent/extensionreposetting"
 	"github.com/miru-project/miru-core/ent/extensionsetting"
+	"github.com/miru-project/miru-core/ent/extensionstate"
+	"github.com/miru-project/miru-core/ent/extensionstorage"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
 	"github.com/miru-project/miru-core/ent/favorite"
 	"github.com/miru-project/miru-core/ent/favoritegroup"
 	"github.com/miru-project/miru-core/ent/history"
@@ -33,16 +39,22 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAppSetting           = "AppSetting"
-	TypeDetail               = "Detail"
-	TypeDownload             = "Download"
-	TypeExtensionRepoSetting = "ExtensionRepoSetting"
-	TypeExtensionSetting     = "ExtensionSetting"
-	TypeFavorite             = "Favorite"
-	TypeFavoriteGroup        = "FavoriteGroup"
-	TypeHistory              = "History"
-	TypeTrack                = "Track"
-	TypeTracker              = "Tracker"
+	TypeAppSetting                   = "AppSetting"
+	TypeDetail                       = "Detail"
+	TypeDownload                     = "Download"
+	TypeEpisodeUpdate                = "EpisodeUpdate"
+	TypeExtensionGrant               = "ExtensionGrant"
+	TypeExtensionRepoSetting         = "ExtensionRepoSetting"
+	TypeExtensionSetting             = "ExtensionSetting"
+	TypeExtensionState               = "ExtensionState"
+	TypeExtensionStorage             = "ExtensionStorage"
+	TypeExtensionVerification        = "ExtensionVerification"
+	TypeExtensionVerificationFailure = "ExtensionVerificationFailure"
+	TypeFavorite                     = "Favorite"
+	TypeFavoriteGroup                = "FavoriteGroup"
+	TypeHistory                      = "History"
+	TypeTrack                        = "Track"
+	TypeTracker                      = "Tracker"
 )
 
 // AppSettingMutation represents an operation that mutates the AppSetting nodes in the graph.
@@ -2454,31 +2466,36 @@ func (m *DownloadMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Download edge %s", name)
 }
 
-// ExtensionRepoSettingMutation represents an operation that mutates the ExtensionRepoSetting nodes in the graph.
-type ExtensionRepoSettingMutation struct {
+// EpisodeUpdateMutation represents an operation that mutates the EpisodeUpdate nodes in the graph.
+type EpisodeUpdateMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	link          *string
+	_package      *string
+	detail_url    *string
+	title         *string
+	group_title   *string
 	name          *string
+	url           *string
+	date          *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*ExtensionRepoSetting, error)
-	predicates    []predicate.ExtensionRepoSetting
+	oldValue      func(context.Context) (*EpisodeUpdate, error)
+	predicates    []predicate.EpisodeUpdate
 }
 
-var _ ent.Mutation = (*ExtensionRepoSettingMutation)(nil)
+var _ ent.Mutation = (*EpisodeUpdateMutation)(nil)
 
-// extensionreposettingOption allows management of the mutation configuration using functional options.
-type extensionreposettingOption func(*ExtensionRepoSettingMutation)
+// episodeupdateOption allows management of the mutation configuration using functional options.
+type episodeupdateOption func(*EpisodeUpdateMutation)
 
-// newExtensionRepoSettingMutation creates new mutation for the ExtensionRepoSetting entity.
-func newExtensionRepoSettingMutation(c config, op Op, opts ...extensionreposettingOption) *ExtensionRepoSettingMutation {
-	m := &ExtensionRepoSettingMutation{
+// newEpisodeUpdateMutation creates new mutation for the EpisodeUpdate entity.
+func newEpisodeUpdateMutation(c config, op Op, opts ...episodeupdateOption) *EpisodeUpdateMutation {
+	m := &EpisodeUpdateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExtensionRepoSetting,
+		typ:           TypeEpisodeUpdate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2487,20 +2504,20 @@ func newExtensionRepoSettingMutation(c config, op Op, opts ...extensionreposetti
 	return m
 }
 
-// withExtensionRepoSettingID sets the ID field of the mutation.
-func withExtensionRepoSettingID(id int) extensionreposettingOption {
-	return func(m *ExtensionRepoSettingMutation) {
+// withEpisodeUpdateID sets the ID field of the mutation.
+func withEpisodeUpdateID(id int) episodeupdateOption {
+	return func(m *EpisodeUpdateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExtensionRepoSetting
+			value *EpisodeUpdate
 		)
-		m.oldValue = func(ctx context.Context) (*ExtensionRepoSetting, error) {
+		m.oldValue = func(ctx context.Context) (*EpisodeUpdate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExtensionRepoSetting.Get(ctx, id)
+					value, err = m.Client().EpisodeUpdate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2509,10 +2526,10 @@ func withExtensionRepoSettingID(id int) extensionreposettingOption {
 	}
 }
 
-// withExtensionRepoSetting sets the old ExtensionRepoSetting of the mutation.
-func withExtensionRepoSetting(node *ExtensionRepoSetting) extensionreposettingOption {
-	return func(m *ExtensionRepoSettingMutation) {
-		m.oldValue = func(context.Context) (*ExtensionRepoSetting, error) {
+// withEpisodeUpdate sets the old EpisodeUpdate of the mutation.
+func withEpisodeUpdate(node *EpisodeUpdate) episodeupdateOption {
+	return func(m *EpisodeUpdateMutation) {
+		m.oldValue = func(context.Context) (*EpisodeUpdate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2521,7 +2538,7 @@ func withExtensionRepoSetting(node *ExtensionRepoSetting) extensionreposettingOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExtensionRepoSettingMutation) Client() *Client {
+func (m EpisodeUpdateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2529,7 +2546,7 @@ func (m ExtensionRepoSettingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExtensionRepoSettingMutation) Tx() (*Tx, error) {
+func (m EpisodeUpdateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2540,7 +2557,7 @@ func (m ExtensionRepoSettingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExtensionRepoSettingMutation) ID() (id int, exists bool) {
+func (m *EpisodeUpdateMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2551,7 +2568,7 @@ func (m *ExtensionRepoSettingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExtensionRepoSettingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EpisodeUpdateMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2560,55 +2577,163 @@ func (m *ExtensionRepoSettingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExtensionRepoSetting.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EpisodeUpdate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLink sets the "link" field.
-func (m *ExtensionRepoSettingMutation) SetLink(s string) {
-	m.link = &s
+// SetPackage sets the "package" field.
+func (m *EpisodeUpdateMutation) SetPackage(s string) {
+	m._package = &s
 }
 
-// Link returns the value of the "link" field in the mutation.
-func (m *ExtensionRepoSettingMutation) Link() (r string, exists bool) {
-	v := m.link
+// Package returns the value of the "package" field in the mutation.
+func (m *EpisodeUpdateMutation) Package() (r string, exists bool) {
+	v := m._package
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLink returns the old "link" field's value of the ExtensionRepoSetting entity.
-// If the ExtensionRepoSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldPackage returns the old "package" field's value of the EpisodeUpdate entity.
+// If the EpisodeUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionRepoSettingMutation) OldLink(ctx context.Context) (v string, err error) {
+func (m *EpisodeUpdateMutation) OldPackage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+		return v, errors.New("OldPackage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
+		return v, errors.New("OldPackage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+		return v, fmt.Errorf("querying old value for OldPackage: %w", err)
 	}
-	return oldValue.Link, nil
+	return oldValue.Package, nil
 }
 
-// ResetLink resets all changes to the "link" field.
-func (m *ExtensionRepoSettingMutation) ResetLink() {
-	m.link = nil
+// ResetPackage resets all changes to the "package" field.
+func (m *EpisodeUpdateMutation) ResetPackage() {
+	m._package = nil
+}
+
+// SetDetailURL sets the "detail_url" field.
+func (m *EpisodeUpdateMutation) SetDetailURL(s string) {
+	m.detail_url = &s
+}
+
+// DetailURL returns the value of the "detail_url" field in the mutation.
+func (m *EpisodeUpdateMutation) DetailURL() (r string, exists bool) {
+	v := m.detail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetailURL returns the old "detail_url" field's value of the EpisodeUpdate entity.
+// If the EpisodeUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeUpdateMutation) OldDetailURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetailURL: %w", err)
+	}
+	return oldValue.DetailURL, nil
+}
+
+// ResetDetailURL resets all changes to the "detail_url" field.
+func (m *EpisodeUpdateMutation) ResetDetailURL() {
+	m.detail_url = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *EpisodeUpdateMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *EpisodeUpdateMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the EpisodeUpdate entity.
+// If the EpisodeUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeUpdateMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *EpisodeUpdateMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetGroupTitle sets the "group_title" field.
+func (m *EpisodeUpdateMutation) SetGroupTitle(s string) {
+	m.group_title = &s
+}
+
+// GroupTitle returns the value of the "group_title" field in the mutation.
+func (m *EpisodeUpdateMutation) GroupTitle() (r string, exists bool) {
+	v := m.group_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupTitle returns the old "group_title" field's value of the EpisodeUpdate entity.
+// If the EpisodeUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeUpdateMutation) OldGroupTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupTitle: %w", err)
+	}
+	return oldValue.GroupTitle, nil
+}
+
+// ResetGroupTitle resets all changes to the "group_title" field.
+func (m *EpisodeUpdateMutation) ResetGroupTitle() {
+	m.group_title = nil
 }
 
 // SetName sets the "name" field.
-func (m *ExtensionRepoSettingMutation) SetName(s string) {
+func (m *EpisodeUpdateMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ExtensionRepoSettingMutation) Name() (r string, exists bool) {
+func (m *EpisodeUpdateMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -2616,10 +2741,10 @@ func (m *ExtensionRepoSettingMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ExtensionRepoSetting entity.
-// If the ExtensionRepoSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the EpisodeUpdate entity.
+// If the EpisodeUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionRepoSettingMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *EpisodeUpdateMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -2634,19 +2759,91 @@ func (m *ExtensionRepoSettingMutation) OldName(ctx context.Context) (v string, e
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ExtensionRepoSettingMutation) ResetName() {
+func (m *EpisodeUpdateMutation) ResetName() {
 	m.name = nil
 }
 
-// Where appends a list predicates to the ExtensionRepoSettingMutation builder.
-func (m *ExtensionRepoSettingMutation) Where(ps ...predicate.ExtensionRepoSetting) {
+// SetURL sets the "url" field.
+func (m *EpisodeUpdateMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *EpisodeUpdateMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the EpisodeUpdate entity.
+// If the EpisodeUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeUpdateMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *EpisodeUpdateMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetDate sets the "date" field.
+func (m *EpisodeUpdateMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *EpisodeUpdateMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the EpisodeUpdate entity.
+// If the EpisodeUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeUpdateMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *EpisodeUpdateMutation) ResetDate() {
+	m.date = nil
+}
+
+// Where appends a list predicates to the EpisodeUpdateMutation builder.
+func (m *EpisodeUpdateMutation) Where(ps ...predicate.EpisodeUpdate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ExtensionRepoSettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EpisodeUpdateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExtensionRepoSettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ExtensionRepoSetting, len(ps))
+func (m *EpisodeUpdateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EpisodeUpdate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2654,30 +2851,45 @@ func (m *ExtensionRepoSettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ExtensionRepoSettingMutation) Op() Op {
+func (m *EpisodeUpdateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ExtensionRepoSettingMutation) SetOp(op Op) {
+func (m *EpisodeUpdateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ExtensionRepoSetting).
-func (m *ExtensionRepoSettingMutation) Type() string {
+// Type returns the node type of this mutation (EpisodeUpdate).
+func (m *EpisodeUpdateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ExtensionRepoSettingMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.link != nil {
-		fields = append(fields, extensionreposetting.FieldLink)
+func (m *EpisodeUpdateMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m._package != nil {
+		fields = append(fields, episodeupdate.FieldPackage)
+	}
+	if m.detail_url != nil {
+		fields = append(fields, episodeupdate.FieldDetailURL)
+	}
+	if m.title != nil {
+		fields = append(fields, episodeupdate.FieldTitle)
+	}
+	if m.group_title != nil {
+		fields = append(fields, episodeupdate.FieldGroupTitle)
 	}
 	if m.name != nil {
-		fields = append(fields, extensionreposetting.FieldName)
+		fields = append(fields, episodeupdate.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, episodeupdate.FieldURL)
+	}
+	if m.date != nil {
+		fields = append(fields, episodeupdate.FieldDate)
 	}
 	return fields
 }
@@ -2685,12 +2897,22 @@ func (m *ExtensionRepoSettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExtensionRepoSettingMutation) Field(name string) (ent.Value, bool) {
+func (m *EpisodeUpdateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case extensionreposetting.FieldLink:
-		return m.Link()
-	case extensionreposetting.FieldName:
+	case episodeupdate.FieldPackage:
+		return m.Package()
+	case episodeupdate.FieldDetailURL:
+		return m.DetailURL()
+	case episodeupdate.FieldTitle:
+		return m.Title()
+	case episodeupdate.FieldGroupTitle:
+		return m.GroupTitle()
+	case episodeupdate.FieldName:
 		return m.Name()
+	case episodeupdate.FieldURL:
+		return m.URL()
+	case episodeupdate.FieldDate:
+		return m.Date()
 	}
 	return nil, false
 }
@@ -2698,173 +2920,3703 @@ func (m *ExtensionRepoSettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExtensionRepoSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EpisodeUpdateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case extensionreposetting.FieldLink:
-		return m.OldLink(ctx)
-	case extensionreposetting.FieldName:
+	case episodeupdate.FieldPackage:
+		return m.OldPackage(ctx)
+	case episodeupdate.FieldDetailURL:
+		return m.OldDetailURL(ctx)
+	case episodeupdate.FieldTitle:
+		return m.OldTitle(ctx)
+	case episodeupdate.FieldGroupTitle:
+		return m.OldGroupTitle(ctx)
+	case episodeupdate.FieldName:
 		return m.OldName(ctx)
+	case episodeupdate.FieldURL:
+		return m.OldURL(ctx)
+	case episodeupdate.FieldDate:
+		return m.OldDate(ctx)
 	}
-	return nil, fmt.Errorf("unknown ExtensionRepoSetting field %s", name)
+	return nil, fmt.Errorf("unknown EpisodeUpdate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExtensionRepoSettingMutation) SetField(name string, value ent.Value) error {
+func (m *EpisodeUpdateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case extensionreposetting.FieldLink:
+	case episodeupdate.FieldPackage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLink(v)
+		m.SetPackage(v)
 		return nil
-	case extensionreposetting.FieldName:
+	case episodeupdate.FieldDetailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetailURL(v)
+		return nil
+	case episodeupdate.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case episodeupdate.FieldGroupTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupTitle(v)
+		return nil
+	case episodeupdate.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	}
-	return fmt.Errorf("unknown ExtensionRepoSetting field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
+	case episodeupdate.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case episodeupdate.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EpisodeUpdate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExtensionRepoSettingMutation) AddedFields() []string {
+func (m *EpisodeUpdateMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExtensionRepoSettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EpisodeUpdateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExtensionRepoSettingMutation) AddField(name string, value ent.Value) error {
+func (m *EpisodeUpdateMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ExtensionRepoSetting numeric field %s", name)
+	return fmt.Errorf("unknown EpisodeUpdate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExtensionRepoSettingMutation) ClearedFields() []string {
+func (m *EpisodeUpdateMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExtensionRepoSettingMutation) FieldCleared(name string) bool {
+func (m *EpisodeUpdateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExtensionRepoSettingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ExtensionRepoSetting nullable field %s", name)
+func (m *EpisodeUpdateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EpisodeUpdate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExtensionRepoSettingMutation) ResetField(name string) error {
+func (m *EpisodeUpdateMutation) ResetField(name string) error {
 	switch name {
-	case extensionreposetting.FieldLink:
-		m.ResetLink()
+	case episodeupdate.FieldPackage:
+		m.ResetPackage()
 		return nil
-	case extensionreposetting.FieldName:
+	case episodeupdate.FieldDetailURL:
+		m.ResetDetailURL()
+		return nil
+	case episodeupdate.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case episodeupdate.FieldGroupTitle:
+		m.ResetGroupTitle()
+		return nil
+	case episodeupdate.FieldName:
 		m.ResetName()
 		return nil
+	case episodeupdate.FieldURL:
+		m.ResetURL()
+		return nil
+	case episodeupdate.FieldDate:
+		m.ResetDate()
+		return nil
 	}
-	return fmt.Errorf("unknown ExtensionRepoSetting field %s", name)
+	return fmt.Errorf("unknown EpisodeUpdate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExtensionRepoSettingMutation) AddedEdges() []string {
+func (m *EpisodeUpdateMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExtensionRepoSettingMutation) AddedIDs(name string) []ent.Value {
+func (m *EpisodeUpdateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExtensionRepoSettingMutation) RemovedEdges() []string {
+func (m *EpisodeUpdateMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExtensionRepoSettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *EpisodeUpdateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExtensionRepoSettingMutation) ClearedEdges() []string {
+func (m *EpisodeUpdateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EpisodeUpdateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EpisodeUpdateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EpisodeUpdate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EpisodeUpdateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EpisodeUpdate edge %s", name)
+}
+
+// ExtensionGrantMutation represents an operation that mutates the ExtensionGrant nodes in the graph.
+type ExtensionGrantMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	_package      *string
+	domain        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExtensionGrant, error)
+	predicates    []predicate.ExtensionGrant
+}
+
+var _ ent.Mutation = (*ExtensionGrantMutation)(nil)
+
+// extensiongrantOption allows management of the mutation configuration using functional options.
+type extensiongrantOption func(*ExtensionGrantMutation)
+
+// newExtensionGrantMutation creates new mutation for the ExtensionGrant entity.
+func newExtensionGrantMutation(c config, op Op, opts ...extensiongrantOption) *ExtensionGrantMutation {
+	m := &ExtensionGrantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtensionGrant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtensionGrantID sets the ID field of the mutation.
+func withExtensionGrantID(id int) extensiongrantOption {
+	return func(m *ExtensionGrantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExtensionGrant
+		)
+		m.oldValue = func(ctx context.Context) (*ExtensionGrant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExtensionGrant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtensionGrant sets the old ExtensionGrant of the mutation.
+func withExtensionGrant(node *ExtensionGrant) extensiongrantOption {
+	return func(m *ExtensionGrantMutation) {
+		m.oldValue = func(context.Context) (*ExtensionGrant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtensionGrantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtensionGrantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtensionGrantMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtensionGrantMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExtensionGrant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackage sets the "package" field.
+func (m *ExtensionGrantMutation) SetPackage(s string) {
+	m._package = &s
+}
+
+// Package returns the value of the "package" field in the mutation.
+func (m *ExtensionGrantMutation) Package() (r string, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackage returns the old "package" field's value of the ExtensionGrant entity.
+// If the ExtensionGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionGrantMutation) OldPackage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackage: %w", err)
+	}
+	return oldValue.Package, nil
+}
+
+// ResetPackage resets all changes to the "package" field.
+func (m *ExtensionGrantMutation) ResetPackage() {
+	m._package = nil
+}
+
+// SetDomain sets the "domain" field.
+func (m *ExtensionGrantMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *ExtensionGrantMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the ExtensionGrant entity.
+// If the ExtensionGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionGrantMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *ExtensionGrantMutation) ResetDomain() {
+	m.domain = nil
+}
+
+// Where appends a list predicates to the ExtensionGrantMutation builder.
+func (m *ExtensionGrantMutation) Where(ps ...predicate.ExtensionGrant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtensionGrantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtensionGrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtensionGrant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtensionGrantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtensionGrantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExtensionGrant).
+func (m *ExtensionGrantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExtensionGrantMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m._package != nil {
+		fields = append(fields, extensiongrant.FieldPackage)
+	}
+	if m.domain != nil {
+		fields = append(fields, extensiongrant.FieldDomain)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExtensionGrantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case extensiongrant.FieldPackage:
+		return m.Package()
+	case extensiongrant.FieldDomain:
+		return m.Domain()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExtensionGrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case extensiongrant.FieldPackage:
+		return m.OldPackage(ctx)
+	case extensiongrant.FieldDomain:
+		return m.OldDomain(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExtensionGrant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionGrantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case extensiongrant.FieldPackage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackage(v)
+		return nil
+	case extensiongrant.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionGrant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExtensionGrantMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExtensionGrantMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionGrantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExtensionGrant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExtensionGrantMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExtensionGrantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExtensionGrantMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExtensionGrant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExtensionGrantMutation) ResetField(name string) error {
+	switch name {
+	case extensiongrant.FieldPackage:
+		m.ResetPackage()
+		return nil
+	case extensiongrant.FieldDomain:
+		m.ResetDomain()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionGrant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExtensionGrantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExtensionGrantMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExtensionGrantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtensionGrantMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtensionGrantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExtensionGrantMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExtensionGrantMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionGrant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExtensionGrantMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionGrant edge %s", name)
+}
+
+// ExtensionRepoSettingMutation represents an operation that mutates the ExtensionRepoSetting nodes in the graph.
+type ExtensionRepoSettingMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	link               *string
+	name               *string
+	trusted_keys       *[]string
+	appendtrusted_keys []string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ExtensionRepoSetting, error)
+	predicates         []predicate.ExtensionRepoSetting
+}
+
+var _ ent.Mutation = (*ExtensionRepoSettingMutation)(nil)
+
+// extensionreposettingOption allows management of the mutation configuration using functional options.
+type extensionreposettingOption func(*ExtensionRepoSettingMutation)
+
+// newExtensionRepoSettingMutation creates new mutation for the ExtensionRepoSetting entity.
+func newExtensionRepoSettingMutation(c config, op Op, opts ...extensionreposettingOption) *ExtensionRepoSettingMutation {
+	m := &ExtensionRepoSettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtensionRepoSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtensionRepoSettingID sets the ID field of the mutation.
+func withExtensionRepoSettingID(id int) extensionreposettingOption {
+	return func(m *ExtensionRepoSettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExtensionRepoSetting
+		)
+		m.oldValue = func(ctx context.Context) (*ExtensionRepoSetting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExtensionRepoSetting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtensionRepoSetting sets the old ExtensionRepoSetting of the mutation.
+func withExtensionRepoSetting(node *ExtensionRepoSetting) extensionreposettingOption {
+	return func(m *ExtensionRepoSettingMutation) {
+		m.oldValue = func(context.Context) (*ExtensionRepoSetting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtensionRepoSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtensionRepoSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtensionRepoSettingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtensionRepoSettingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExtensionRepoSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLink sets the "link" field.
+func (m *ExtensionRepoSettingMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *ExtensionRepoSettingMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the ExtensionRepoSetting entity.
+// If the ExtensionRepoSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionRepoSettingMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *ExtensionRepoSettingMutation) ResetLink() {
+	m.link = nil
+}
+
+// SetName sets the "name" field.
+func (m *ExtensionRepoSettingMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ExtensionRepoSettingMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ExtensionRepoSetting entity.
+// If the ExtensionRepoSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionRepoSettingMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ExtensionRepoSettingMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTrustedKeys sets the "trusted_keys" field.
+func (m *ExtensionRepoSettingMutation) SetTrustedKeys(s []string) {
+	m.trusted_keys = &s
+	m.appendtrusted_keys = nil
+}
+
+// TrustedKeys returns the value of the "trusted_keys" field in the mutation.
+func (m *ExtensionRepoSettingMutation) TrustedKeys() (r []string, exists bool) {
+	v := m.trusted_keys
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrustedKeys returns the old "trusted_keys" field's value of the ExtensionRepoSetting entity.
+// If the ExtensionRepoSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionRepoSettingMutation) OldTrustedKeys(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrustedKeys is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrustedKeys requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrustedKeys: %w", err)
+	}
+	return oldValue.TrustedKeys, nil
+}
+
+// AppendTrustedKeys adds s to the "trusted_keys" field.
+func (m *ExtensionRepoSettingMutation) AppendTrustedKeys(s []string) {
+	m.appendtrusted_keys = append(m.appendtrusted_keys, s...)
+}
+
+// AppendedTrustedKeys returns the list of values that were appended to the "trusted_keys" field in this mutation.
+func (m *ExtensionRepoSettingMutation) AppendedTrustedKeys() ([]string, bool) {
+	if len(m.appendtrusted_keys) == 0 {
+		return nil, false
+	}
+	return m.appendtrusted_keys, true
+}
+
+// ClearTrustedKeys clears the value of the "trusted_keys" field.
+func (m *ExtensionRepoSettingMutation) ClearTrustedKeys() {
+	m.trusted_keys = nil
+	m.appendtrusted_keys = nil
+	m.clearedFields[extensionreposetting.FieldTrustedKeys] = struct{}{}
+}
+
+// TrustedKeysCleared returns if the "trusted_keys" field was cleared in this mutation.
+func (m *ExtensionRepoSettingMutation) TrustedKeysCleared() bool {
+	_, ok := m.clearedFields[extensionreposetting.FieldTrustedKeys]
+	return ok
+}
+
+// ResetTrustedKeys resets all changes to the "trusted_keys" field.
+func (m *ExtensionRepoSettingMutation) ResetTrustedKeys() {
+	m.trusted_keys = nil
+	m.appendtrusted_keys = nil
+	delete(m.clearedFields, extensionreposetting.FieldTrustedKeys)
+}
+
+// Where appends a list predicates to the ExtensionRepoSettingMutation builder.
+func (m *ExtensionRepoSettingMutation) Where(ps ...predicate.ExtensionRepoSetting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtensionRepoSettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtensionRepoSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtensionRepoSetting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtensionRepoSettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtensionRepoSettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExtensionRepoSetting).
+func (m *ExtensionRepoSettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExtensionRepoSettingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.link != nil {
+		fields = append(fields, extensionreposetting.FieldLink)
+	}
+	if m.name != nil {
+		fields = append(fields, extensionreposetting.FieldName)
+	}
+	if m.trusted_keys != nil {
+		fields = append(fields, extensionreposetting.FieldTrustedKeys)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExtensionRepoSettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case extensionreposetting.FieldLink:
+		return m.Link()
+	case extensionreposetting.FieldName:
+		return m.Name()
+	case extensionreposetting.FieldTrustedKeys:
+		return m.TrustedKeys()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExtensionRepoSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case extensionreposetting.FieldLink:
+		return m.OldLink(ctx)
+	case extensionreposetting.FieldName:
+		return m.OldName(ctx)
+	case extensionreposetting.FieldTrustedKeys:
+		return m.OldTrustedKeys(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExtensionRepoSetting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionRepoSettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case extensionreposetting.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case extensionreposetting.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case extensionreposetting.FieldTrustedKeys:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrustedKeys(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionRepoSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExtensionRepoSettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExtensionRepoSettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionRepoSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExtensionRepoSetting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExtensionRepoSettingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(extensionreposetting.FieldTrustedKeys) {
+		fields = append(fields, extensionreposetting.FieldTrustedKeys)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExtensionRepoSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExtensionRepoSettingMutation) ClearField(name string) error {
+	switch name {
+	case extensionreposetting.FieldTrustedKeys:
+		m.ClearTrustedKeys()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionRepoSetting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExtensionRepoSettingMutation) ResetField(name string) error {
+	switch name {
+	case extensionreposetting.FieldLink:
+		m.ResetLink()
+		return nil
+	case extensionreposetting.FieldName:
+		m.ResetName()
+		return nil
+	case extensionreposetting.FieldTrustedKeys:
+		m.ResetTrustedKeys()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionRepoSetting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExtensionRepoSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExtensionRepoSettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExtensionRepoSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtensionRepoSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtensionRepoSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExtensionRepoSettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExtensionRepoSettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionRepoSetting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExtensionRepoSettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionRepoSetting edge %s", name)
+}
+
+// ExtensionSettingMutation represents an operation that mutates the ExtensionSetting nodes in the graph.
+type ExtensionSettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	_package      *string
+	title         *string
+	key           *string
+	value         *string
+	default_value *string
+	db_type       *extensionsetting.DbType
+	description   *string
+	options       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExtensionSetting, error)
+	predicates    []predicate.ExtensionSetting
+}
+
+var _ ent.Mutation = (*ExtensionSettingMutation)(nil)
+
+// extensionsettingOption allows management of the mutation configuration using functional options.
+type extensionsettingOption func(*ExtensionSettingMutation)
+
+// newExtensionSettingMutation creates new mutation for the ExtensionSetting entity.
+func newExtensionSettingMutation(c config, op Op, opts ...extensionsettingOption) *ExtensionSettingMutation {
+	m := &ExtensionSettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtensionSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtensionSettingID sets the ID field of the mutation.
+func withExtensionSettingID(id int) extensionsettingOption {
+	return func(m *ExtensionSettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExtensionSetting
+		)
+		m.oldValue = func(ctx context.Context) (*ExtensionSetting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExtensionSetting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtensionSetting sets the old ExtensionSetting of the mutation.
+func withExtensionSetting(node *ExtensionSetting) extensionsettingOption {
+	return func(m *ExtensionSettingMutation) {
+		m.oldValue = func(context.Context) (*ExtensionSetting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtensionSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtensionSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtensionSettingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtensionSettingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExtensionSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackage sets the "package" field.
+func (m *ExtensionSettingMutation) SetPackage(s string) {
+	m._package = &s
+}
+
+// Package returns the value of the "package" field in the mutation.
+func (m *ExtensionSettingMutation) Package() (r string, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackage returns the old "package" field's value of the ExtensionSetting entity.
+// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionSettingMutation) OldPackage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackage: %w", err)
+	}
+	return oldValue.Package, nil
+}
+
+// ResetPackage resets all changes to the "package" field.
+func (m *ExtensionSettingMutation) ResetPackage() {
+	m._package = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ExtensionSettingMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ExtensionSettingMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ExtensionSetting entity.
+// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionSettingMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ExtensionSettingMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetKey sets the "key" field.
+func (m *ExtensionSettingMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ExtensionSettingMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ExtensionSetting entity.
+// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionSettingMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ExtensionSettingMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ExtensionSettingMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ExtensionSettingMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the ExtensionSetting entity.
+// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionSettingMutation) OldValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ClearValue clears the value of the "value" field.
+func (m *ExtensionSettingMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[extensionsetting.FieldValue] = struct{}{}
+}
+
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *ExtensionSettingMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[extensionsetting.FieldValue]
+	return ok
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ExtensionSettingMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, extensionsetting.FieldValue)
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (m *ExtensionSettingMutation) SetDefaultValue(s string) {
+	m.default_value = &s
+}
+
+// DefaultValue returns the value of the "default_value" field in the mutation.
+func (m *ExtensionSettingMutation) DefaultValue() (r string, exists bool) {
+	v := m.default_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultValue returns the old "default_value" field's value of the ExtensionSetting entity.
+// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionSettingMutation) OldDefaultValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultValue: %w", err)
+	}
+	return oldValue.DefaultValue, nil
+}
+
+// ResetDefaultValue resets all changes to the "default_value" field.
+func (m *ExtensionSettingMutation) ResetDefaultValue() {
+	m.default_value = nil
+}
+
+// SetDbType sets the "db_type" field.
+func (m *ExtensionSettingMutation) SetDbType(et extensionsetting.DbType) {
+	m.db_type = &et
+}
+
+// DbType returns the value of the "db_type" field in the mutation.
+func (m *ExtensionSettingMutation) DbType() (r extensionsetting.DbType, exists bool) {
+	v := m.db_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDbType returns the old "db_type" field's value of the ExtensionSetting entity.
+// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionSettingMutation) OldDbType(ctx context.Context) (v extensionsetting.DbType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDbType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDbType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDbType: %w", err)
+	}
+	return oldValue.DbType, nil
+}
+
+// ResetDbType resets all changes to the "db_type" field.
+func (m *ExtensionSettingMutation) ResetDbType() {
+	m.db_type = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ExtensionSettingMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ExtensionSettingMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ExtensionSetting entity.
+// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionSettingMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ExtensionSettingMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[extensionsetting.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ExtensionSettingMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[extensionsetting.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ExtensionSettingMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, extensionsetting.FieldDescription)
+}
+
+// SetOptions sets the "options" field.
+func (m *ExtensionSettingMutation) SetOptions(s string) {
+	m.options = &s
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *ExtensionSettingMutation) Options() (r string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the ExtensionSetting entity.
+// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionSettingMutation) OldOptions(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *ExtensionSettingMutation) ClearOptions() {
+	m.options = nil
+	m.clearedFields[extensionsetting.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *ExtensionSettingMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[extensionsetting.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *ExtensionSettingMutation) ResetOptions() {
+	m.options = nil
+	delete(m.clearedFields, extensionsetting.FieldOptions)
+}
+
+// Where appends a list predicates to the ExtensionSettingMutation builder.
+func (m *ExtensionSettingMutation) Where(ps ...predicate.ExtensionSetting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtensionSettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtensionSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtensionSetting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtensionSettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtensionSettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExtensionSetting).
+func (m *ExtensionSettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExtensionSettingMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m._package != nil {
+		fields = append(fields, extensionsetting.FieldPackage)
+	}
+	if m.title != nil {
+		fields = append(fields, extensionsetting.FieldTitle)
+	}
+	if m.key != nil {
+		fields = append(fields, extensionsetting.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, extensionsetting.FieldValue)
+	}
+	if m.default_value != nil {
+		fields = append(fields, extensionsetting.FieldDefaultValue)
+	}
+	if m.db_type != nil {
+		fields = append(fields, extensionsetting.FieldDbType)
+	}
+	if m.description != nil {
+		fields = append(fields, extensionsetting.FieldDescription)
+	}
+	if m.options != nil {
+		fields = append(fields, extensionsetting.FieldOptions)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExtensionSettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case extensionsetting.FieldPackage:
+		return m.Package()
+	case extensionsetting.FieldTitle:
+		return m.Title()
+	case extensionsetting.FieldKey:
+		return m.Key()
+	case extensionsetting.FieldValue:
+		return m.Value()
+	case extensionsetting.FieldDefaultValue:
+		return m.DefaultValue()
+	case extensionsetting.FieldDbType:
+		return m.DbType()
+	case extensionsetting.FieldDescription:
+		return m.Description()
+	case extensionsetting.FieldOptions:
+		return m.Options()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExtensionSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case extensionsetting.FieldPackage:
+		return m.OldPackage(ctx)
+	case extensionsetting.FieldTitle:
+		return m.OldTitle(ctx)
+	case extensionsetting.FieldKey:
+		return m.OldKey(ctx)
+	case extensionsetting.FieldValue:
+		return m.OldValue(ctx)
+	case extensionsetting.FieldDefaultValue:
+		return m.OldDefaultValue(ctx)
+	case extensionsetting.FieldDbType:
+		return m.OldDbType(ctx)
+	case extensionsetting.FieldDescription:
+		return m.OldDescription(ctx)
+	case extensionsetting.FieldOptions:
+		return m.OldOptions(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExtensionSetting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionSettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case extensionsetting.FieldPackage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackage(v)
+		return nil
+	case extensionsetting.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case extensionsetting.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case extensionsetting.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case extensionsetting.FieldDefaultValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultValue(v)
+		return nil
+	case extensionsetting.FieldDbType:
+		v, ok := value.(extensionsetting.DbType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDbType(v)
+		return nil
+	case extensionsetting.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case extensionsetting.FieldOptions:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExtensionSettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExtensionSettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExtensionSetting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExtensionSettingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(extensionsetting.FieldValue) {
+		fields = append(fields, extensionsetting.FieldValue)
+	}
+	if m.FieldCleared(extensionsetting.FieldDescription) {
+		fields = append(fields, extensionsetting.FieldDescription)
+	}
+	if m.FieldCleared(extensionsetting.FieldOptions) {
+		fields = append(fields, extensionsetting.FieldOptions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExtensionSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExtensionSettingMutation) ClearField(name string) error {
+	switch name {
+	case extensionsetting.FieldValue:
+		m.ClearValue()
+		return nil
+	case extensionsetting.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case extensionsetting.FieldOptions:
+		m.ClearOptions()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionSetting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExtensionSettingMutation) ResetField(name string) error {
+	switch name {
+	case extensionsetting.FieldPackage:
+		m.ResetPackage()
+		return nil
+	case extensionsetting.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case extensionsetting.FieldKey:
+		m.ResetKey()
+		return nil
+	case extensionsetting.FieldValue:
+		m.ResetValue()
+		return nil
+	case extensionsetting.FieldDefaultValue:
+		m.ResetDefaultValue()
+		return nil
+	case extensionsetting.FieldDbType:
+		m.ResetDbType()
+		return nil
+	case extensionsetting.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case extensionsetting.FieldOptions:
+		m.ResetOptions()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionSetting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExtensionSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExtensionSettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExtensionSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtensionSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtensionSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExtensionSettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExtensionSettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionSetting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExtensionSettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionSetting edge %s", name)
+}
+
+// ExtensionStateMutation represents an operation that mutates the ExtensionState nodes in the graph.
+type ExtensionStateMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	_package       *string
+	enabled        *bool
+	pinned_version *string
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ExtensionState, error)
+	predicates     []predicate.ExtensionState
+}
+
+var _ ent.Mutation = (*ExtensionStateMutation)(nil)
+
+// extensionstateOption allows management of the mutation configuration using functional options.
+type extensionstateOption func(*ExtensionStateMutation)
+
+// newExtensionStateMutation creates new mutation for the ExtensionState entity.
+func newExtensionStateMutation(c config, op Op, opts ...extensionstateOption) *ExtensionStateMutation {
+	m := &ExtensionStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtensionState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtensionStateID sets the ID field of the mutation.
+func withExtensionStateID(id int) extensionstateOption {
+	return func(m *ExtensionStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExtensionState
+		)
+		m.oldValue = func(ctx context.Context) (*ExtensionState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExtensionState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtensionState sets the old ExtensionState of the mutation.
+func withExtensionState(node *ExtensionState) extensionstateOption {
+	return func(m *ExtensionStateMutation) {
+		m.oldValue = func(context.Context) (*ExtensionState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtensionStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtensionStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtensionStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtensionStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExtensionState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackage sets the "package" field.
+func (m *ExtensionStateMutation) SetPackage(s string) {
+	m._package = &s
+}
+
+// Package returns the value of the "package" field in the mutation.
+func (m *ExtensionStateMutation) Package() (r string, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackage returns the old "package" field's value of the ExtensionState entity.
+// If the ExtensionState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStateMutation) OldPackage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackage: %w", err)
+	}
+	return oldValue.Package, nil
+}
+
+// ResetPackage resets all changes to the "package" field.
+func (m *ExtensionStateMutation) ResetPackage() {
+	m._package = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ExtensionStateMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ExtensionStateMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ExtensionState entity.
+// If the ExtensionState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStateMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ExtensionStateMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetPinnedVersion sets the "pinned_version" field.
+func (m *ExtensionStateMutation) SetPinnedVersion(s string) {
+	m.pinned_version = &s
+}
+
+// PinnedVersion returns the value of the "pinned_version" field in the mutation.
+func (m *ExtensionStateMutation) PinnedVersion() (r string, exists bool) {
+	v := m.pinned_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinnedVersion returns the old "pinned_version" field's value of the ExtensionState entity.
+// If the ExtensionState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStateMutation) OldPinnedVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinnedVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinnedVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinnedVersion: %w", err)
+	}
+	return oldValue.PinnedVersion, nil
+}
+
+// ClearPinnedVersion clears the value of the "pinned_version" field.
+func (m *ExtensionStateMutation) ClearPinnedVersion() {
+	m.pinned_version = nil
+	m.clearedFields[extensionstate.FieldPinnedVersion] = struct{}{}
+}
+
+// PinnedVersionCleared returns if the "pinned_version" field was cleared in this mutation.
+func (m *ExtensionStateMutation) PinnedVersionCleared() bool {
+	_, ok := m.clearedFields[extensionstate.FieldPinnedVersion]
+	return ok
+}
+
+// ResetPinnedVersion resets all changes to the "pinned_version" field.
+func (m *ExtensionStateMutation) ResetPinnedVersion() {
+	m.pinned_version = nil
+	delete(m.clearedFields, extensionstate.FieldPinnedVersion)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExtensionStateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExtensionStateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ExtensionState entity.
+// If the ExtensionState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExtensionStateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ExtensionStateMutation builder.
+func (m *ExtensionStateMutation) Where(ps ...predicate.ExtensionState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtensionStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtensionStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtensionState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtensionStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtensionStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExtensionState).
+func (m *ExtensionStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExtensionStateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m._package != nil {
+		fields = append(fields, extensionstate.FieldPackage)
+	}
+	if m.enabled != nil {
+		fields = append(fields, extensionstate.FieldEnabled)
+	}
+	if m.pinned_version != nil {
+		fields = append(fields, extensionstate.FieldPinnedVersion)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, extensionstate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExtensionStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case extensionstate.FieldPackage:
+		return m.Package()
+	case extensionstate.FieldEnabled:
+		return m.Enabled()
+	case extensionstate.FieldPinnedVersion:
+		return m.PinnedVersion()
+	case extensionstate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExtensionStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case extensionstate.FieldPackage:
+		return m.OldPackage(ctx)
+	case extensionstate.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case extensionstate.FieldPinnedVersion:
+		return m.OldPinnedVersion(ctx)
+	case extensionstate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExtensionState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case extensionstate.FieldPackage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackage(v)
+		return nil
+	case extensionstate.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case extensionstate.FieldPinnedVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedVersion(v)
+		return nil
+	case extensionstate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExtensionStateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExtensionStateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExtensionState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExtensionStateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(extensionstate.FieldPinnedVersion) {
+		fields = append(fields, extensionstate.FieldPinnedVersion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExtensionStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExtensionStateMutation) ClearField(name string) error {
+	switch name {
+	case extensionstate.FieldPinnedVersion:
+		m.ClearPinnedVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExtensionStateMutation) ResetField(name string) error {
+	switch name {
+	case extensionstate.FieldPackage:
+		m.ResetPackage()
+		return nil
+	case extensionstate.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case extensionstate.FieldPinnedVersion:
+		m.ResetPinnedVersion()
+		return nil
+	case extensionstate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExtensionStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExtensionStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExtensionStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtensionStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtensionStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExtensionStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExtensionStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExtensionStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionState edge %s", name)
+}
+
+// ExtensionStorageMutation represents an operation that mutates the ExtensionStorage nodes in the graph.
+type ExtensionStorageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	_package      *string
+	key           *string
+	value         *string
+	size          *int
+	addsize       *int
+	expires_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExtensionStorage, error)
+	predicates    []predicate.ExtensionStorage
+}
+
+var _ ent.Mutation = (*ExtensionStorageMutation)(nil)
+
+// extensionstorageOption allows management of the mutation configuration using functional options.
+type extensionstorageOption func(*ExtensionStorageMutation)
+
+// newExtensionStorageMutation creates new mutation for the ExtensionStorage entity.
+func newExtensionStorageMutation(c config, op Op, opts ...extensionstorageOption) *ExtensionStorageMutation {
+	m := &ExtensionStorageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtensionStorage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtensionStorageID sets the ID field of the mutation.
+func withExtensionStorageID(id int) extensionstorageOption {
+	return func(m *ExtensionStorageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExtensionStorage
+		)
+		m.oldValue = func(ctx context.Context) (*ExtensionStorage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExtensionStorage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtensionStorage sets the old ExtensionStorage of the mutation.
+func withExtensionStorage(node *ExtensionStorage) extensionstorageOption {
+	return func(m *ExtensionStorageMutation) {
+		m.oldValue = func(context.Context) (*ExtensionStorage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtensionStorageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtensionStorageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtensionStorageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtensionStorageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExtensionStorage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackage sets the "package" field.
+func (m *ExtensionStorageMutation) SetPackage(s string) {
+	m._package = &s
+}
+
+// Package returns the value of the "package" field in the mutation.
+func (m *ExtensionStorageMutation) Package() (r string, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackage returns the old "package" field's value of the ExtensionStorage entity.
+// If the ExtensionStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStorageMutation) OldPackage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackage: %w", err)
+	}
+	return oldValue.Package, nil
+}
+
+// ResetPackage resets all changes to the "package" field.
+func (m *ExtensionStorageMutation) ResetPackage() {
+	m._package = nil
+}
+
+// SetKey sets the "key" field.
+func (m *ExtensionStorageMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ExtensionStorageMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ExtensionStorage entity.
+// If the ExtensionStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStorageMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ExtensionStorageMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ExtensionStorageMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ExtensionStorageMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the ExtensionStorage entity.
+// If the ExtensionStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStorageMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ExtensionStorageMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetSize sets the "size" field.
+func (m *ExtensionStorageMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *ExtensionStorageMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the ExtensionStorage entity.
+// If the ExtensionStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStorageMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *ExtensionStorageMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *ExtensionStorageMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *ExtensionStorageMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ExtensionStorageMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ExtensionStorageMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ExtensionStorage entity.
+// If the ExtensionStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStorageMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ExtensionStorageMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[extensionstorage.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ExtensionStorageMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[extensionstorage.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ExtensionStorageMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, extensionstorage.FieldExpiresAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExtensionStorageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExtensionStorageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ExtensionStorage entity.
+// If the ExtensionStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionStorageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExtensionStorageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ExtensionStorageMutation builder.
+func (m *ExtensionStorageMutation) Where(ps ...predicate.ExtensionStorage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtensionStorageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtensionStorageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtensionStorage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtensionStorageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtensionStorageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExtensionStorage).
+func (m *ExtensionStorageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExtensionStorageMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._package != nil {
+		fields = append(fields, extensionstorage.FieldPackage)
+	}
+	if m.key != nil {
+		fields = append(fields, extensionstorage.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, extensionstorage.FieldValue)
+	}
+	if m.size != nil {
+		fields = append(fields, extensionstorage.FieldSize)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, extensionstorage.FieldExpiresAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, extensionstorage.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExtensionStorageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case extensionstorage.FieldPackage:
+		return m.Package()
+	case extensionstorage.FieldKey:
+		return m.Key()
+	case extensionstorage.FieldValue:
+		return m.Value()
+	case extensionstorage.FieldSize:
+		return m.Size()
+	case extensionstorage.FieldExpiresAt:
+		return m.ExpiresAt()
+	case extensionstorage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExtensionStorageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case extensionstorage.FieldPackage:
+		return m.OldPackage(ctx)
+	case extensionstorage.FieldKey:
+		return m.OldKey(ctx)
+	case extensionstorage.FieldValue:
+		return m.OldValue(ctx)
+	case extensionstorage.FieldSize:
+		return m.OldSize(ctx)
+	case extensionstorage.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case extensionstorage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExtensionStorage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionStorageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case extensionstorage.FieldPackage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackage(v)
+		return nil
+	case extensionstorage.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case extensionstorage.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case extensionstorage.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case extensionstorage.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case extensionstorage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionStorage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExtensionStorageMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, extensionstorage.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExtensionStorageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case extensionstorage.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionStorageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case extensionstorage.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionStorage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExtensionStorageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(extensionstorage.FieldExpiresAt) {
+		fields = append(fields, extensionstorage.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExtensionStorageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExtensionStorageMutation) ClearField(name string) error {
+	switch name {
+	case extensionstorage.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionStorage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExtensionStorageMutation) ResetField(name string) error {
+	switch name {
+	case extensionstorage.FieldPackage:
+		m.ResetPackage()
+		return nil
+	case extensionstorage.FieldKey:
+		m.ResetKey()
+		return nil
+	case extensionstorage.FieldValue:
+		m.ResetValue()
+		return nil
+	case extensionstorage.FieldSize:
+		m.ResetSize()
+		return nil
+	case extensionstorage.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case extensionstorage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionStorage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExtensionStorageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExtensionStorageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExtensionStorageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtensionStorageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtensionStorageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExtensionStorageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExtensionStorageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionStorage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExtensionStorageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionStorage edge %s", name)
+}
+
+// ExtensionVerificationMutation represents an operation that mutates the ExtensionVerification nodes in the graph.
+type ExtensionVerificationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	_package      *string
+	repo          *string
+	status        *extensionverification.Status
+	hash          *string
+	key           *string
+	reason        *string
+	verified_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExtensionVerification, error)
+	predicates    []predicate.ExtensionVerification
+}
+
+var _ ent.Mutation = (*ExtensionVerificationMutation)(nil)
+
+// extensionverificationOption allows management of the mutation configuration using functional options.
+type extensionverificationOption func(*ExtensionVerificationMutation)
+
+// newExtensionVerificationMutation creates new mutation for the ExtensionVerification entity.
+func newExtensionVerificationMutation(c config, op Op, opts ...extensionverificationOption) *ExtensionVerificationMutation {
+	m := &ExtensionVerificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtensionVerification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtensionVerificationID sets the ID field of the mutation.
+func withExtensionVerificationID(id int) extensionverificationOption {
+	return func(m *ExtensionVerificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExtensionVerification
+		)
+		m.oldValue = func(ctx context.Context) (*ExtensionVerification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExtensionVerification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtensionVerification sets the old ExtensionVerification of the mutation.
+func withExtensionVerification(node *ExtensionVerification) extensionverificationOption {
+	return func(m *ExtensionVerificationMutation) {
+		m.oldValue = func(context.Context) (*ExtensionVerification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtensionVerificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtensionVerificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtensionVerificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtensionVerificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExtensionVerification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackage sets the "package" field.
+func (m *ExtensionVerificationMutation) SetPackage(s string) {
+	m._package = &s
+}
+
+// Package returns the value of the "package" field in the mutation.
+func (m *ExtensionVerificationMutation) Package() (r string, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackage returns the old "package" field's value of the ExtensionVerification entity.
+// If the ExtensionVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionVerificationMutation) OldPackage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackage: %w", err)
+	}
+	return oldValue.Package, nil
+}
+
+// ResetPackage resets all changes to the "package" field.
+func (m *ExtensionVerificationMutation) ResetPackage() {
+	m._package = nil
+}
+
+// SetRepo sets the "repo" field.
+func (m *ExtensionVerificationMutation) SetRepo(s string) {
+	m.repo = &s
+}
+
+// Repo returns the value of the "repo" field in the mutation.
+func (m *ExtensionVerificationMutation) Repo() (r string, exists bool) {
+	v := m.repo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepo returns the old "repo" field's value of the ExtensionVerification entity.
+// If the ExtensionVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionVerificationMutation) OldRepo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepo: %w", err)
+	}
+	return oldValue.Repo, nil
+}
+
+// ResetRepo resets all changes to the "repo" field.
+func (m *ExtensionVerificationMutation) ResetRepo() {
+	m.repo = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ExtensionVerificationMutation) SetStatus(e extensionverification.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ExtensionVerificationMutation) Status() (r extensionverification.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ExtensionVerification entity.
+// If the ExtensionVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionVerificationMutation) OldStatus(ctx context.Context) (v extensionverification.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ExtensionVerificationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *ExtensionVerificationMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *ExtensionVerificationMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the ExtensionVerification entity.
+// If the ExtensionVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionVerificationMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *ExtensionVerificationMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetKey sets the "key" field.
+func (m *ExtensionVerificationMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ExtensionVerificationMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ExtensionVerification entity.
+// If the ExtensionVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionVerificationMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ClearKey clears the value of the "key" field.
+func (m *ExtensionVerificationMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[extensionverification.FieldKey] = struct{}{}
+}
+
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *ExtensionVerificationMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[extensionverification.FieldKey]
+	return ok
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ExtensionVerificationMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, extensionverification.FieldKey)
+}
+
+// SetReason sets the "reason" field.
+func (m *ExtensionVerificationMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ExtensionVerificationMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ExtensionVerification entity.
+// If the ExtensionVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionVerificationMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ExtensionVerificationMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[extensionverification.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ExtensionVerificationMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[extensionverification.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ExtensionVerificationMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, extensionverification.FieldReason)
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (m *ExtensionVerificationMutation) SetVerifiedAt(t time.Time) {
+	m.verified_at = &t
+}
+
+// VerifiedAt returns the value of the "verified_at" field in the mutation.
+func (m *ExtensionVerificationMutation) VerifiedAt() (r time.Time, exists bool) {
+	v := m.verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifiedAt returns the old "verified_at" field's value of the ExtensionVerification entity.
+// If the ExtensionVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionVerificationMutation) OldVerifiedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifiedAt: %w", err)
+	}
+	return oldValue.VerifiedAt, nil
+}
+
+// ResetVerifiedAt resets all changes to the "verified_at" field.
+func (m *ExtensionVerificationMutation) ResetVerifiedAt() {
+	m.verified_at = nil
+}
+
+// Where appends a list predicates to the ExtensionVerificationMutation builder.
+func (m *ExtensionVerificationMutation) Where(ps ...predicate.ExtensionVerification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtensionVerificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtensionVerificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtensionVerification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtensionVerificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtensionVerificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExtensionVerification).
+func (m *ExtensionVerificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExtensionVerificationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m._package != nil {
+		fields = append(fields, extensionverification.FieldPackage)
+	}
+	if m.repo != nil {
+		fields = append(fields, extensionverification.FieldRepo)
+	}
+	if m.status != nil {
+		fields = append(fields, extensionverification.FieldStatus)
+	}
+	if m.hash != nil {
+		fields = append(fields, extensionverification.FieldHash)
+	}
+	if m.key != nil {
+		fields = append(fields, extensionverification.FieldKey)
+	}
+	if m.reason != nil {
+		fields = append(fields, extensionverification.FieldReason)
+	}
+	if m.verified_at != nil {
+		fields = append(fields, extensionverification.FieldVerifiedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExtensionVerificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case extensionverification.FieldPackage:
+		return m.Package()
+	case extensionverification.FieldRepo:
+		return m.Repo()
+	case extensionverification.FieldStatus:
+		return m.Status()
+	case extensionverification.FieldHash:
+		return m.Hash()
+	case extensionverification.FieldKey:
+		return m.Key()
+	case extensionverification.FieldReason:
+		return m.Reason()
+	case extensionverification.FieldVerifiedAt:
+		return m.VerifiedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExtensionVerificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case extensionverification.FieldPackage:
+		return m.OldPackage(ctx)
+	case extensionverification.FieldRepo:
+		return m.OldRepo(ctx)
+	case extensionverification.FieldStatus:
+		return m.OldStatus(ctx)
+	case extensionverification.FieldHash:
+		return m.OldHash(ctx)
+	case extensionverification.FieldKey:
+		return m.OldKey(ctx)
+	case extensionverification.FieldReason:
+		return m.OldReason(ctx)
+	case extensionverification.FieldVerifiedAt:
+		return m.OldVerifiedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExtensionVerification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionVerificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case extensionverification.FieldPackage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackage(v)
+		return nil
+	case extensionverification.FieldRepo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepo(v)
+		return nil
+	case extensionverification.FieldStatus:
+		v, ok := value.(extensionverification.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case extensionverification.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case extensionverification.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case extensionverification.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case extensionverification.FieldVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifiedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionVerification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExtensionVerificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExtensionVerificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtensionVerificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExtensionVerification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExtensionVerificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(extensionverification.FieldKey) {
+		fields = append(fields, extensionverification.FieldKey)
+	}
+	if m.FieldCleared(extensionverification.FieldReason) {
+		fields = append(fields, extensionverification.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExtensionVerificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExtensionVerificationMutation) ClearField(name string) error {
+	switch name {
+	case extensionverification.FieldKey:
+		m.ClearKey()
+		return nil
+	case extensionverification.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionVerification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExtensionVerificationMutation) ResetField(name string) error {
+	switch name {
+	case extensionverification.FieldPackage:
+		m.ResetPackage()
+		return nil
+	case extensionverification.FieldRepo:
+		m.ResetRepo()
+		return nil
+	case extensionverification.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case extensionverification.FieldHash:
+		m.ResetHash()
+		return nil
+	case extensionverification.FieldKey:
+		m.ResetKey()
+		return nil
+	case extensionverification.FieldReason:
+		m.ResetReason()
+		return nil
+	case extensionverification.FieldVerifiedAt:
+		m.ResetVerifiedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtensionVerification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExtensionVerificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExtensionVerificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExtensionVerificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtensionVerificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtensionVerificationMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExtensionRepoSettingMutation) EdgeCleared(name string) bool {
+func (m *ExtensionVerificationMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExtensionRepoSettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ExtensionRepoSetting unique edge %s", name)
+func (m *ExtensionVerificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionVerification unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExtensionRepoSettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ExtensionRepoSetting edge %s", name)
+func (m *ExtensionVerificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionVerification edge %s", name)
 }
 
-// ExtensionSettingMutation represents an operation that mutates the ExtensionSetting nodes in the graph.
-type ExtensionSettingMutation struct {
+// ExtensionVerificationFailureMutation represents an operation that mutates the ExtensionVerificationFailure nodes in the graph.
+type ExtensionVerificationFailureMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	_package      *string
-	title         *string
-	key           *string
-	value         *string
-	default_value *string
-	db_type       *extensionsetting.DbType
-	description   *string
-	options       *string
+	repo          *string
+	status        *extensionverificationfailure.Status
+	hash          *string
+	reason        *string
+	failed_at     *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*ExtensionSetting, error)
-	predicates    []predicate.ExtensionSetting
+	oldValue      func(context.Context) (*ExtensionVerificationFailure, error)
+	predicates    []predicate.ExtensionVerificationFailure
 }
 
-var _ ent.Mutation = (*ExtensionSettingMutation)(nil)
+var _ ent.Mutation = (*ExtensionVerificationFailureMutation)(nil)
 
-// extensionsettingOption allows management of the mutation configuration using functional options.
-type extensionsettingOption func(*ExtensionSettingMutation)
+// extensionverificationfailureOption allows management of the mutation configuration using functional options.
+type extensionverificationfailureOption func(*ExtensionVerificationFailureMutation)
 
-// newExtensionSettingMutation creates new mutation for the ExtensionSetting entity.
-func newExtensionSettingMutation(c config, op Op, opts ...extensionsettingOption) *ExtensionSettingMutation {
-	m := &ExtensionSettingMutation{
+// newExtensionVerificationFailureMutation creates new mutation for the ExtensionVerificationFailure entity.
+func newExtensionVerificationFailureMutation(c config, op Op, opts ...extensionverificationfailureOption) *ExtensionVerificationFailureMutation {
+	m := &ExtensionVerificationFailureMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExtensionSetting,
+		typ:           TypeExtensionVerificationFailure,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2873,20 +6625,20 @@ func newExtensionSettingMutation(c config, op Op, opts ...extensionsettingOption
 	return m
 }
 
-// withExtensionSettingID sets the ID field of the mutation.
-func withExtensionSettingID(id int) extensionsettingOption {
-	return func(m *ExtensionSettingMutation) {
+// withExtensionVerificationFailureID sets the ID field of the mutation.
+func withExtensionVerificationFailureID(id int) extensionverificationfailureOption {
+	return func(m *ExtensionVerificationFailureMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExtensionSetting
+			value *ExtensionVerificationFailure
 		)
-		m.oldValue = func(ctx context.Context) (*ExtensionSetting, error) {
+		m.oldValue = func(ctx context.Context) (*ExtensionVerificationFailure, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExtensionSetting.Get(ctx, id)
+					value, err = m.Client().ExtensionVerificationFailure.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2895,10 +6647,10 @@ func withExtensionSettingID(id int) extensionsettingOption {
 	}
 }
 
-// withExtensionSetting sets the old ExtensionSetting of the mutation.
-func withExtensionSetting(node *ExtensionSetting) extensionsettingOption {
-	return func(m *ExtensionSettingMutation) {
-		m.oldValue = func(context.Context) (*ExtensionSetting, error) {
+// withExtensionVerificationFailure sets the old ExtensionVerificationFailure of the mutation.
+func withExtensionVerificationFailure(node *ExtensionVerificationFailure) extensionverificationfailureOption {
+	return func(m *ExtensionVerificationFailureMutation) {
+		m.oldValue = func(context.Context) (*ExtensionVerificationFailure, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2907,7 +6659,7 @@ func withExtensionSetting(node *ExtensionSetting) extensionsettingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExtensionSettingMutation) Client() *Client {
+func (m ExtensionVerificationFailureMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2915,7 +6667,7 @@ func (m ExtensionSettingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExtensionSettingMutation) Tx() (*Tx, error) {
+func (m ExtensionVerificationFailureMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2926,7 +6678,7 @@ func (m ExtensionSettingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExtensionSettingMutation) ID() (id int, exists bool) {
+func (m *ExtensionVerificationFailureMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2937,7 +6689,7 @@ func (m *ExtensionSettingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExtensionSettingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ExtensionVerificationFailureMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2946,19 +6698,19 @@ func (m *ExtensionSettingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExtensionSetting.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExtensionVerificationFailure.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetPackage sets the "package" field.
-func (m *ExtensionSettingMutation) SetPackage(s string) {
+func (m *ExtensionVerificationFailureMutation) SetPackage(s string) {
 	m._package = &s
 }
 
 // Package returns the value of the "package" field in the mutation.
-func (m *ExtensionSettingMutation) Package() (r string, exists bool) {
+func (m *ExtensionVerificationFailureMutation) Package() (r string, exists bool) {
 	v := m._package
 	if v == nil {
 		return
@@ -2966,10 +6718,10 @@ func (m *ExtensionSettingMutation) Package() (r string, exists bool) {
 	return *v, true
 }
 
-// OldPackage returns the old "package" field's value of the ExtensionSetting entity.
-// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldPackage returns the old "package" field's value of the ExtensionVerificationFailure entity.
+// If the ExtensionVerificationFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionSettingMutation) OldPackage(ctx context.Context) (v string, err error) {
+func (m *ExtensionVerificationFailureMutation) OldPackage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPackage is only allowed on UpdateOne operations")
 	}
@@ -2984,310 +6736,199 @@ func (m *ExtensionSettingMutation) OldPackage(ctx context.Context) (v string, er
 }
 
 // ResetPackage resets all changes to the "package" field.
-func (m *ExtensionSettingMutation) ResetPackage() {
+func (m *ExtensionVerificationFailureMutation) ResetPackage() {
 	m._package = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *ExtensionSettingMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *ExtensionSettingMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the ExtensionSetting entity.
-// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionSettingMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *ExtensionSettingMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetKey sets the "key" field.
-func (m *ExtensionSettingMutation) SetKey(s string) {
-	m.key = &s
-}
-
-// Key returns the value of the "key" field in the mutation.
-func (m *ExtensionSettingMutation) Key() (r string, exists bool) {
-	v := m.key
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldKey returns the old "key" field's value of the ExtensionSetting entity.
-// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionSettingMutation) OldKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
-	}
-	return oldValue.Key, nil
-}
-
-// ResetKey resets all changes to the "key" field.
-func (m *ExtensionSettingMutation) ResetKey() {
-	m.key = nil
-}
-
-// SetValue sets the "value" field.
-func (m *ExtensionSettingMutation) SetValue(s string) {
-	m.value = &s
+// SetRepo sets the "repo" field.
+func (m *ExtensionVerificationFailureMutation) SetRepo(s string) {
+	m.repo = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *ExtensionSettingMutation) Value() (r string, exists bool) {
-	v := m.value
+// Repo returns the value of the "repo" field in the mutation.
+func (m *ExtensionVerificationFailureMutation) Repo() (r string, exists bool) {
+	v := m.repo
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the ExtensionSetting entity.
-// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldRepo returns the old "repo" field's value of the ExtensionVerificationFailure entity.
+// If the ExtensionVerificationFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionSettingMutation) OldValue(ctx context.Context) (v *string, err error) {
+func (m *ExtensionVerificationFailureMutation) OldRepo(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldRepo is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldRepo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldRepo: %w", err)
 	}
-	return oldValue.Value, nil
-}
-
-// ClearValue clears the value of the "value" field.
-func (m *ExtensionSettingMutation) ClearValue() {
-	m.value = nil
-	m.clearedFields[extensionsetting.FieldValue] = struct{}{}
-}
-
-// ValueCleared returns if the "value" field was cleared in this mutation.
-func (m *ExtensionSettingMutation) ValueCleared() bool {
-	_, ok := m.clearedFields[extensionsetting.FieldValue]
-	return ok
+	return oldValue.Repo, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *ExtensionSettingMutation) ResetValue() {
-	m.value = nil
-	delete(m.clearedFields, extensionsetting.FieldValue)
+// ResetRepo resets all changes to the "repo" field.
+func (m *ExtensionVerificationFailureMutation) ResetRepo() {
+	m.repo = nil
 }
 
-// SetDefaultValue sets the "default_value" field.
-func (m *ExtensionSettingMutation) SetDefaultValue(s string) {
-	m.default_value = &s
+// SetStatus sets the "status" field.
+func (m *ExtensionVerificationFailureMutation) SetStatus(e extensionverificationfailure.Status) {
+	m.status = &e
 }
 
-// DefaultValue returns the value of the "default_value" field in the mutation.
-func (m *ExtensionSettingMutation) DefaultValue() (r string, exists bool) {
-	v := m.default_value
+// Status returns the value of the "status" field in the mutation.
+func (m *ExtensionVerificationFailureMutation) Status() (r extensionverificationfailure.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDefaultValue returns the old "default_value" field's value of the ExtensionSetting entity.
-// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ExtensionVerificationFailure entity.
+// If the ExtensionVerificationFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionSettingMutation) OldDefaultValue(ctx context.Context) (v *string, err error) {
+func (m *ExtensionVerificationFailureMutation) OldStatus(ctx context.Context) (v extensionverificationfailure.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultValue requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.DefaultValue, nil
+	return oldValue.Status, nil
 }
 
-// ResetDefaultValue resets all changes to the "default_value" field.
-func (m *ExtensionSettingMutation) ResetDefaultValue() {
-	m.default_value = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *ExtensionVerificationFailureMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetDbType sets the "db_type" field.
-func (m *ExtensionSettingMutation) SetDbType(et extensionsetting.DbType) {
-	m.db_type = &et
+// SetHash sets the "hash" field.
+func (m *ExtensionVerificationFailureMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// DbType returns the value of the "db_type" field in the mutation.
-func (m *ExtensionSettingMutation) DbType() (r extensionsetting.DbType, exists bool) {
-	v := m.db_type
+// Hash returns the value of the "hash" field in the mutation.
+func (m *ExtensionVerificationFailureMutation) Hash() (r string, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDbType returns the old "db_type" field's value of the ExtensionSetting entity.
-// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the ExtensionVerificationFailure entity.
+// If the ExtensionVerificationFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionSettingMutation) OldDbType(ctx context.Context) (v extensionsetting.DbType, err error) {
+func (m *ExtensionVerificationFailureMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDbType is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDbType requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDbType: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.DbType, nil
+	return oldValue.Hash, nil
 }
 
-// ResetDbType resets all changes to the "db_type" field.
-func (m *ExtensionSettingMutation) ResetDbType() {
-	m.db_type = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *ExtensionVerificationFailureMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ExtensionSettingMutation) SetDescription(s string) {
-	m.description = &s
+// SetReason sets the "reason" field.
+func (m *ExtensionVerificationFailureMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ExtensionSettingMutation) Description() (r string, exists bool) {
-	v := m.description
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ExtensionVerificationFailureMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ExtensionSetting entity.
-// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the ExtensionVerificationFailure entity.
+// If the ExtensionVerificationFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionSettingMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *ExtensionVerificationFailureMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ExtensionSettingMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[extensionsetting.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ExtensionSettingMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[extensionsetting.FieldDescription]
-	return ok
+	return oldValue.Reason, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ExtensionSettingMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, extensionsetting.FieldDescription)
+// ResetReason resets all changes to the "reason" field.
+func (m *ExtensionVerificationFailureMutation) ResetReason() {
+	m.reason = nil
 }
 
-// SetOptions sets the "options" field.
-func (m *ExtensionSettingMutation) SetOptions(s string) {
-	m.options = &s
+// SetFailedAt sets the "failed_at" field.
+func (m *ExtensionVerificationFailureMutation) SetFailedAt(t time.Time) {
+	m.failed_at = &t
 }
 
-// Options returns the value of the "options" field in the mutation.
-func (m *ExtensionSettingMutation) Options() (r string, exists bool) {
-	v := m.options
+// FailedAt returns the value of the "failed_at" field in the mutation.
+func (m *ExtensionVerificationFailureMutation) FailedAt() (r time.Time, exists bool) {
+	v := m.failed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOptions returns the old "options" field's value of the ExtensionSetting entity.
-// If the ExtensionSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldFailedAt returns the old "failed_at" field's value of the ExtensionVerificationFailure entity.
+// If the ExtensionVerificationFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionSettingMutation) OldOptions(ctx context.Context) (v *string, err error) {
+func (m *ExtensionVerificationFailureMutation) OldFailedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+		return v, errors.New("OldFailedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptions requires an ID field in the mutation")
+		return v, errors.New("OldFailedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+		return v, fmt.Errorf("querying old value for OldFailedAt: %w", err)
 	}
-	return oldValue.Options, nil
-}
-
-// ClearOptions clears the value of the "options" field.
-func (m *ExtensionSettingMutation) ClearOptions() {
-	m.options = nil
-	m.clearedFields[extensionsetting.FieldOptions] = struct{}{}
-}
-
-// OptionsCleared returns if the "options" field was cleared in this mutation.
-func (m *ExtensionSettingMutation) OptionsCleared() bool {
-	_, ok := m.clearedFields[extensionsetting.FieldOptions]
-	return ok
+	return oldValue.FailedAt, nil
 }
 
-// ResetOptions resets all changes to the "options" field.
-func (m *ExtensionSettingMutation) ResetOptions() {
-	m.options = nil
-	delete(m.clearedFields, extensionsetting.FieldOptions)
+// ResetFailedAt resets all changes to the "failed_at" field.
+func (m *ExtensionVerificationFailureMutation) ResetFailedAt() {
+	m.failed_at = nil
 }
 
-// Where appends a list predicates to the ExtensionSettingMutation builder.
-func (m *ExtensionSettingMutation) Where(ps ...predicate.ExtensionSetting) {
+// Where appends a list predicates to the ExtensionVerificationFailureMutation builder.
+func (m *ExtensionVerificationFailureMutation) Where(ps ...predicate.ExtensionVerificationFailure) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ExtensionSettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ExtensionVerificationFailureMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExtensionSettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ExtensionSetting, len(ps))
+func (m *ExtensionVerificationFailureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtensionVerificationFailure, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3295,48 +6936,42 @@ func (m *ExtensionSettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ExtensionSettingMutation) Op() Op {
+func (m *ExtensionVerificationFailureMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ExtensionSettingMutation) SetOp(op Op) {
+func (m *ExtensionVerificationFailureMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ExtensionSetting).
-func (m *ExtensionSettingMutation) Type() string {
+// Type returns the node type of this mutation (ExtensionVerificationFailure).
+func (m *ExtensionVerificationFailureMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ExtensionSettingMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+// AddedFields().
+func (m *ExtensionVerificationFailureMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m._package != nil {
-		fields = append(fields, extensionsetting.FieldPackage)
-	}
-	if m.title != nil {
-		fields = append(fields, extensionsetting.FieldTitle)
-	}
-	if m.key != nil {
-		fields = append(fields, extensionsetting.FieldKey)
+		fields = append(fields, extensionverificationfailure.FieldPackage)
 	}
-	if m.value != nil {
-		fields = append(fields, extensionsetting.FieldValue)
+	if m.repo != nil {
+		fields = append(fields, extensionverificationfailure.FieldRepo)
 	}
-	if m.default_value != nil {
-		fields = append(fields, extensionsetting.FieldDefaultValue)
+	if m.status != nil {
+		fields = append(fields, extensionverificationfailure.FieldStatus)
 	}
-	if m.db_type != nil {
-		fields = append(fields, extensionsetting.FieldDbType)
+	if m.hash != nil {
+		fields = append(fields, extensionverificationfailure.FieldHash)
 	}
-	if m.description != nil {
-		fields = append(fields, extensionsetting.FieldDescription)
+	if m.reason != nil {
+		fields = append(fields, extensionverificationfailure.FieldReason)
 	}
-	if m.options != nil {
-		fields = append(fields, extensionsetting.FieldOptions)
+	if m.failed_at != nil {
+		fields = append(fields, extensionverificationfailure.FieldFailedAt)
 	}
 	return fields
 }
@@ -3344,24 +6979,20 @@ func (m *ExtensionSettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExtensionSettingMutation) Field(name string) (ent.Value, bool) {
+func (m *ExtensionVerificationFailureMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case extensionsetting.FieldPackage:
+	case extensionverificationfailure.FieldPackage:
 		return m.Package()
-	case extensionsetting.FieldTitle:
-		return m.Title()
-	case extensionsetting.FieldKey:
-		return m.Key()
-	case extensionsetting.FieldValue:
-		return m.Value()
-	case extensionsetting.FieldDefaultValue:
-		return m.DefaultValue()
-	case extensionsetting.FieldDbType:
-		return m.DbType()
-	case extensionsetting.FieldDescription:
-		return m.Description()
-	case extensionsetting.FieldOptions:
-		return m.Options()
+	case extensionverificationfailure.FieldRepo:
+		return m.Repo()
+	case extensionverificationfailure.FieldStatus:
+		return m.Status()
+	case extensionverificationfailure.FieldHash:
+		return m.Hash()
+	case extensionverificationfailure.FieldReason:
+		return m.Reason()
+	case extensionverificationfailure.FieldFailedAt:
+		return m.FailedAt()
 	}
 	return nil, false
 }
@@ -3369,233 +7000,188 @@ func (m *ExtensionSettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExtensionSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ExtensionVerificationFailureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case extensionsetting.FieldPackage:
+	case extensionverificationfailure.FieldPackage:
 		return m.OldPackage(ctx)
-	case extensionsetting.FieldTitle:
-		return m.OldTitle(ctx)
-	case extensionsetting.FieldKey:
-		return m.OldKey(ctx)
-	case extensionsetting.FieldValue:
-		return m.OldValue(ctx)
-	case extensionsetting.FieldDefaultValue:
-		return m.OldDefaultValue(ctx)
-	case extensionsetting.FieldDbType:
-		return m.OldDbType(ctx)
-	case extensionsetting.FieldDescription:
-		return m.OldDescription(ctx)
-	case extensionsetting.FieldOptions:
-		return m.OldOptions(ctx)
+	case extensionverificationfailure.FieldRepo:
+		return m.OldRepo(ctx)
+	case extensionverificationfailure.FieldStatus:
+		return m.OldStatus(ctx)
+	case extensionverificationfailure.FieldHash:
+		return m.OldHash(ctx)
+	case extensionverificationfailure.FieldReason:
+		return m.OldReason(ctx)
+	case extensionverificationfailure.FieldFailedAt:
+		return m.OldFailedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ExtensionSetting field %s", name)
+	return nil, fmt.Errorf("unknown ExtensionVerificationFailure field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExtensionSettingMutation) SetField(name string, value ent.Value) error {
+func (m *ExtensionVerificationFailureMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case extensionsetting.FieldPackage:
+	case extensionverificationfailure.FieldPackage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPackage(v)
 		return nil
-	case extensionsetting.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case extensionsetting.FieldKey:
+	case extensionverificationfailure.FieldRepo:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetRepo(v)
 		return nil
-	case extensionsetting.FieldValue:
-		v, ok := value.(string)
+	case extensionverificationfailure.FieldStatus:
+		v, ok := value.(extensionverificationfailure.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetStatus(v)
 		return nil
-	case extensionsetting.FieldDefaultValue:
+	case extensionverificationfailure.FieldHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDefaultValue(v)
-		return nil
-	case extensionsetting.FieldDbType:
-		v, ok := value.(extensionsetting.DbType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDbType(v)
+		m.SetHash(v)
 		return nil
-	case extensionsetting.FieldDescription:
+	case extensionverificationfailure.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetReason(v)
 		return nil
-	case extensionsetting.FieldOptions:
-		v, ok := value.(string)
+	case extensionverificationfailure.FieldFailedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOptions(v)
+		m.SetFailedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ExtensionSetting field %s", name)
+	return fmt.Errorf("unknown ExtensionVerificationFailure field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExtensionSettingMutation) AddedFields() []string {
+func (m *ExtensionVerificationFailureMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExtensionSettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExtensionVerificationFailureMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExtensionSettingMutation) AddField(name string, value ent.Value) error {
+func (m *ExtensionVerificationFailureMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ExtensionSetting numeric field %s", name)
+	return fmt.Errorf("unknown ExtensionVerificationFailure numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExtensionSettingMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(extensionsetting.FieldValue) {
-		fields = append(fields, extensionsetting.FieldValue)
-	}
-	if m.FieldCleared(extensionsetting.FieldDescription) {
-		fields = append(fields, extensionsetting.FieldDescription)
-	}
-	if m.FieldCleared(extensionsetting.FieldOptions) {
-		fields = append(fields, extensionsetting.FieldOptions)
-	}
-	return fields
+func (m *ExtensionVerificationFailureMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExtensionSettingMutation) FieldCleared(name string) bool {
+func (m *ExtensionVerificationFailureMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExtensionSettingMutation) ClearField(name string) error {
-	switch name {
-	case extensionsetting.FieldValue:
-		m.ClearValue()
-		return nil
-	case extensionsetting.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case extensionsetting.FieldOptions:
-		m.ClearOptions()
-		return nil
-	}
-	return fmt.Errorf("unknown ExtensionSetting nullable field %s", name)
+func (m *ExtensionVerificationFailureMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExtensionVerificationFailure nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExtensionSettingMutation) ResetField(name string) error {
+func (m *ExtensionVerificationFailureMutation) ResetField(name string) error {
 	switch name {
-	case extensionsetting.FieldPackage:
+	case extensionverificationfailure.FieldPackage:
 		m.ResetPackage()
 		return nil
-	case extensionsetting.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case extensionsetting.FieldKey:
-		m.ResetKey()
-		return nil
-	case extensionsetting.FieldValue:
-		m.ResetValue()
+	case extensionverificationfailure.FieldRepo:
+		m.ResetRepo()
 		return nil
-	case extensionsetting.FieldDefaultValue:
-		m.ResetDefaultValue()
+	case extensionverificationfailure.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case extensionsetting.FieldDbType:
-		m.ResetDbType()
+	case extensionverificationfailure.FieldHash:
+		m.ResetHash()
 		return nil
-	case extensionsetting.FieldDescription:
-		m.ResetDescription()
+	case extensionverificationfailure.FieldReason:
+		m.ResetReason()
 		return nil
-	case extensionsetting.FieldOptions:
-		m.ResetOptions()
+	case extensionverificationfailure.FieldFailedAt:
+		m.ResetFailedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ExtensionSetting field %s", name)
+	return fmt.Errorf("unknown ExtensionVerificationFailure field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExtensionSettingMutation) AddedEdges() []string {
+func (m *ExtensionVerificationFailureMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExtensionSettingMutation) AddedIDs(name string) []ent.Value {
+func (m *ExtensionVerificationFailureMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExtensionSettingMutation) RemovedEdges() []string {
+func (m *ExtensionVerificationFailureMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExtensionSettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *ExtensionVerificationFailureMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExtensionSettingMutation) ClearedEdges() []string {
+func (m *ExtensionVerificationFailureMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExtensionSettingMutation) EdgeCleared(name string) bool {
+func (m *ExtensionVerificationFailureMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExtensionSettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ExtensionSetting unique edge %s", name)
+func (m *ExtensionVerificationFailureMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionVerificationFailure unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExtensionSettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ExtensionSetting edge %s", name)
+func (m *ExtensionVerificationFailureMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtensionVerificationFailure edge %s", name)
 }
 
 // FavoriteMutation represents an operation that mutates the Favorite nodes in the graph.
@@ -3610,6 +7196,7 @@ type FavoriteMutation struct {
 	title         *string
 	cover         *string
 	date          *time.Time
+	checked_at    *time.Time
 	clearedFields map[string]struct{}
 	group         map[int]struct{}
 	removedgroup  map[int]struct{}
@@ -3952,6 +7539,55 @@ func (m *FavoriteMutation) ResetDate() {
 	m.date = nil
 }
 
+// SetCheckedAt sets the "checked_at" field.
+func (m *FavoriteMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
+}
+
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *FavoriteMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedAt returns the old "checked_at" field's value of the Favorite entity.
+// If the Favorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FavoriteMutation) OldCheckedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (m *FavoriteMutation) ClearCheckedAt() {
+	m.checked_at = nil
+	m.clearedFields[favorite.FieldCheckedAt] = struct{}{}
+}
+
+// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
+func (m *FavoriteMutation) CheckedAtCleared() bool {
+	_, ok := m.clearedFields[favorite.FieldCheckedAt]
+	return ok
+}
+
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *FavoriteMutation) ResetCheckedAt() {
+	m.checked_at = nil
+	delete(m.clearedFields, favorite.FieldCheckedAt)
+}
+
 // AddGroupIDs adds the "group" edge to the FavoriteGroup entity by ids.
 func (m *FavoriteMutation) AddGroupIDs(ids ...int) {
 	if m.group == nil {
@@ -4040,7 +7676,7 @@ func (m *FavoriteMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *FavoriteMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 7)
 	if m._package != nil {
 		fields = append(fields, favorite.FieldPackage)
 	}
@@ -4059,6 +7695,9 @@ func (m *FavoriteMutation) Fields() []string {
 	if m.date != nil {
 		fields = append(fields, favorite.FieldDate)
 	}
+	if m.checked_at != nil {
+		fields = append(fields, favorite.FieldCheckedAt)
+	}
 	return fields
 }
 
@@ -4079,6 +7718,8 @@ func (m *FavoriteMutation) Field(name string) (ent.Value, bool) {
 		return m.Cover()
 	case favorite.FieldDate:
 		return m.Date()
+	case favorite.FieldCheckedAt:
+		return m.CheckedAt()
 	}
 	return nil, false
 }
@@ -4100,6 +7741,8 @@ func (m *FavoriteMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldCover(ctx)
 	case favorite.FieldDate:
 		return m.OldDate(ctx)
+	case favorite.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Favorite field %s", name)
 }
@@ -4151,6 +7794,13 @@ func (m *FavoriteMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDate(v)
 		return nil
+	case favorite.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Favorite field %s", name)
 }
@@ -4184,6 +7834,9 @@ func (m *FavoriteMutation) ClearedFields() []string {
 	if m.FieldCleared(favorite.FieldCover) {
 		fields = append(fields, favorite.FieldCover)
 	}
+	if m.FieldCleared(favorite.FieldCheckedAt) {
+		fields = append(fields, favorite.FieldCheckedAt)
+	}
 	return fields
 }
 
@@ -4201,6 +7854,9 @@ func (m *FavoriteMutation) ClearField(name string) error {
 	case favorite.FieldCover:
 		m.ClearCover()
 		return nil
+	case favorite.FieldCheckedAt:
+		m.ClearCheckedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Favorite nullable field %s", name)
 }
@@ -4227,6 +7883,9 @@ func (m *FavoriteMutation) ResetField(name string) error {
 	case favorite.FieldDate:
 		m.ResetDate()
 		return nil
+	case favorite.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Favorite field %s", name)
 }
@@ -4318,18 +7977,20 @@ func (m *FavoriteMutation) ResetEdge(name string) error {
 // FavoriteGroupMutation represents an operation that mutates the FavoriteGroup nodes in the graph.
 type FavoriteGroupMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	name             *string
-	date             *time.Time
-	clearedFields    map[string]struct{}
-	favorites        map[int]struct{}
-	removedfavorites map[int]struct{}
-	clearedfavorites bool
-	done             bool
-	oldValue         func(context.Context) (*FavoriteGroup, error)
-	predicates       []predicate.FavoriteGroup
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	date              *time.Time
+	check_interval    *int
+	addcheck_interval *int
+	clearedFields     map[string]struct{}
+	favorites         map[int]struct{}
+	removedfavorites  map[int]struct{}
+	clearedfavorites  bool
+	done              bool
+	oldValue          func(context.Context) (*FavoriteGroup, error)
+	predicates        []predicate.FavoriteGroup
 }
 
 var _ ent.Mutation = (*FavoriteGroupMutation)(nil)
@@ -4508,6 +8169,76 @@ func (m *FavoriteGroupMutation) ResetDate() {
 	m.date = nil
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (m *FavoriteGroupMutation) SetCheckInterval(i int) {
+	m.check_interval = &i
+	m.addcheck_interval = nil
+}
+
+// CheckInterval returns the value of the "check_interval" field in the mutation.
+func (m *FavoriteGroupMutation) CheckInterval() (r int, exists bool) {
+	v := m.check_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckInterval returns the old "check_interval" field's value of the FavoriteGroup entity.
+// If the FavoriteGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FavoriteGroupMutation) OldCheckInterval(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckInterval: %w", err)
+	}
+	return oldValue.CheckInterval, nil
+}
+
+// AddCheckInterval adds i to the "check_interval" field.
+func (m *FavoriteGroupMutation) AddCheckInterval(i int) {
+	if m.addcheck_interval != nil {
+		*m.addcheck_interval += i
+	} else {
+		m.addcheck_interval = &i
+	}
+}
+
+// AddedCheckInterval returns the value that was added to the "check_interval" field in this mutation.
+func (m *FavoriteGroupMutation) AddedCheckInterval() (r int, exists bool) {
+	v := m.addcheck_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCheckInterval clears the value of the "check_interval" field.
+func (m *FavoriteGroupMutation) ClearCheckInterval() {
+	m.check_interval = nil
+	m.addcheck_interval = nil
+	m.clearedFields[favoritegroup.FieldCheckInterval] = struct{}{}
+}
+
+// CheckIntervalCleared returns if the "check_interval" field was cleared in this mutation.
+func (m *FavoriteGroupMutation) CheckIntervalCleared() bool {
+	_, ok := m.clearedFields[favoritegroup.FieldCheckInterval]
+	return ok
+}
+
+// ResetCheckInterval resets all changes to the "check_interval" field.
+func (m *FavoriteGroupMutation) ResetCheckInterval() {
+	m.check_interval = nil
+	m.addcheck_interval = nil
+	delete(m.clearedFields, favoritegroup.FieldCheckInterval)
+}
+
 // AddFavoriteIDs adds the "favorites" edge to the Favorite entity by ids.
 func (m *FavoriteGroupMutation) AddFavoriteIDs(ids ...int) {
 	if m.favorites == nil {
@@ -4596,13 +8327,16 @@ func (m *FavoriteGroupMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *FavoriteGroupMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
 	if m.name != nil {
 		fields = append(fields, favoritegroup.FieldName)
 	}
 	if m.date != nil {
 		fields = append(fields, favoritegroup.FieldDate)
 	}
+	if m.check_interval != nil {
+		fields = append(fields, favoritegroup.FieldCheckInterval)
+	}
 	return fields
 }
 
@@ -4615,6 +8349,8 @@ func (m *FavoriteGroupMutation) Field(name string) (ent.Value, bool) {
 		return m.Name()
 	case favoritegroup.FieldDate:
 		return m.Date()
+	case favoritegroup.FieldCheckInterval:
+		return m.CheckInterval()
 	}
 	return nil, false
 }
@@ -4628,6 +8364,8 @@ func (m *FavoriteGroupMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldName(ctx)
 	case favoritegroup.FieldDate:
 		return m.OldDate(ctx)
+	case favoritegroup.FieldCheckInterval:
+		return m.OldCheckInterval(ctx)
 	}
 	return nil, fmt.Errorf("unknown FavoriteGroup field %s", name)
 }
@@ -4651,6 +8389,13 @@ func (m *FavoriteGroupMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDate(v)
 		return nil
+	case favoritegroup.FieldCheckInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckInterval(v)
+		return nil
 	}
 	return fmt.Errorf("unknown FavoriteGroup field %s", name)
 }
@@ -4658,13 +8403,21 @@ func (m *FavoriteGroupMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *FavoriteGroupMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addcheck_interval != nil {
+		fields = append(fields, favoritegroup.FieldCheckInterval)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *FavoriteGroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case favoritegroup.FieldCheckInterval:
+		return m.AddedCheckInterval()
+	}
 	return nil, false
 }
 
@@ -4673,6 +8426,13 @@ func (m *FavoriteGroupMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *FavoriteGroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case favoritegroup.FieldCheckInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCheckInterval(v)
+		return nil
 	}
 	return fmt.Errorf("unknown FavoriteGroup numeric field %s", name)
 }
@@ -4680,7 +8440,11 @@ func (m *FavoriteGroupMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *FavoriteGroupMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(favoritegroup.FieldCheckInterval) {
+		fields = append(fields, favoritegroup.FieldCheckInterval)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -4693,6 +8457,11 @@ func (m *FavoriteGroupMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *FavoriteGroupMutation) ClearField(name string) error {
+	switch name {
+	case favoritegroup.FieldCheckInterval:
+		m.ClearCheckInterval()
+		return nil
+	}
 	return fmt.Errorf("unknown FavoriteGroup nullable field %s", name)
 }
 
@@ -4706,6 +8475,9 @@ func (m *FavoriteGroupMutation) ResetField(name string) error {
 	case favoritegroup.FieldDate:
 		m.ResetDate()
 		return nil
+	case favoritegroup.FieldCheckInterval:
+		m.ResetCheckInterval()
+		return nil
 	}
 	return fmt.Errorf("unknown FavoriteGroup field %s", name)
 }
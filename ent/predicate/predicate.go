@@ -15,12 +15,30 @@ type Detail func(*sql.Selector)
 // Download is the predicate function for download builders.
 type Download func(*sql.Selector)
 
+// EpisodeUpdate is the predicate function for episodeupdate builders.
+type EpisodeUpdate func(*sql.Selector)
+
+// ExtensionGrant is the predicate function for extensiongrant builders.
+type ExtensionGrant func(*sql.Selector)
+
 // ExtensionRepoSetting is the predicate function for extensionreposetting builders.
 type ExtensionRepoSetting func(*sql.Selector)
 
 // ExtensionSetting is the predicate function for extensionsetting builders.
 type ExtensionSetting func(*sql.Selector)
 
+// ExtensionState is the predicate function for extensionstate builders.
+type ExtensionState func(*sql.Selector)
+
+// ExtensionStorage is the predicate function for extensionstorage builders.
+type ExtensionStorage func(*sql.Selector)
+
+// ExtensionVerification is the predicate function for extensionverification builders.
+type ExtensionVerification func(*sql.Selector)
+
+// ExtensionVerificationFailure is the predicate function for extensionverificationfailure builders.
+type ExtensionVerificationFailure func(*sql.Selector)
+
 // Favorite is the predicate function for favorite builders.
 type Favorite func(*sql.Selector)
 
@@ -8,8 +8,14 @@ import (
 	"github.com/miru-project/miru-core/ent/appsetting"
 	"github.com/miru-project/miru-core/ent/detail"
 	"github.com/miru-project/miru-core/ent/download"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+	"github.com/miru-project/miru-core/ent/extensiongrant"
 	"github.com/miru-project/miru-core/ent/extensionreposetting"
 	"github.com/miru-project/miru-core/ent/extensionsetting"
+	"github.com/miru-project/miru-core/ent/extensionstate"
+	"github.com/miru-project/miru-core/ent/extensionstorage"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
 	"github.com/miru-project/miru-core/ent/favorite"
 	"github.com/miru-project/miru-core/ent/favoritegroup"
 	"github.com/miru-project/miru-core/ent/history"
@@ -87,6 +93,34 @@ func init() {
 	downloadDescID := downloadFields[0].Descriptor()
 	// download.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	download.IDValidator = downloadDescID.Validators[0].(func(int) error)
+	episodeupdateFields := schema.EpisodeUpdate{}.Fields()
+	_ = episodeupdateFields
+	// episodeupdateDescPackage is the schema descriptor for package field.
+	episodeupdateDescPackage := episodeupdateFields[0].Descriptor()
+	// episodeupdate.PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	episodeupdate.PackageValidator = episodeupdateDescPackage.Validators[0].(func(string) error)
+	// episodeupdateDescDetailURL is the schema descriptor for detail_url field.
+	episodeupdateDescDetailURL := episodeupdateFields[1].Descriptor()
+	// episodeupdate.DetailURLValidator is a validator for the "detail_url" field. It is called by the builders before save.
+	episodeupdate.DetailURLValidator = episodeupdateDescDetailURL.Validators[0].(func(string) error)
+	// episodeupdateDescURL is the schema descriptor for url field.
+	episodeupdateDescURL := episodeupdateFields[5].Descriptor()
+	// episodeupdate.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	episodeupdate.URLValidator = episodeupdateDescURL.Validators[0].(func(string) error)
+	// episodeupdateDescDate is the schema descriptor for date field.
+	episodeupdateDescDate := episodeupdateFields[6].Descriptor()
+	// episodeupdate.DefaultDate holds the default value on creation for the date field.
+	episodeupdate.DefaultDate = episodeupdateDescDate.Default.(func() time.Time)
+	extensiongrantFields := schema.ExtensionGrant{}.Fields()
+	_ = extensiongrantFields
+	// extensiongrantDescPackage is the schema descriptor for package field.
+	extensiongrantDescPackage := extensiongrantFields[0].Descriptor()
+	// extensiongrant.PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	extensiongrant.PackageValidator = extensiongrantDescPackage.Validators[0].(func(string) error)
+	// extensiongrantDescDomain is the schema descriptor for domain field.
+	extensiongrantDescDomain := extensiongrantFields[1].Descriptor()
+	// extensiongrant.DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	extensiongrant.DomainValidator = extensiongrantDescDomain.Validators[0].(func(string) error)
 	extensionreposettingHooks := schema.ExtensionRepoSetting{}.Hooks()
 	extensionreposetting.Hooks[0] = extensionreposettingHooks[0]
 	extensionreposettingFields := schema.ExtensionRepoSetting{}.Fields()
@@ -119,8 +153,66 @@ func init() {
 	extensionsettingDescDefaultValue := extensionsettingFields[4].Descriptor()
 	// extensionsetting.DefaultDefaultValue holds the default value on creation for the default_value field.
 	extensionsetting.DefaultDefaultValue = extensionsettingDescDefaultValue.Default.(string)
-	// extensionsetting.DefaultValueValidator is a validator for the "default_value" field. It is called by the builders before save.
-	extensionsetting.DefaultValueValidator = extensionsettingDescDefaultValue.Validators[0].(func(string) error)
+	extensionstateFields := schema.ExtensionState{}.Fields()
+	_ = extensionstateFields
+	// extensionstateDescPackage is the schema descriptor for package field.
+	extensionstateDescPackage := extensionstateFields[0].Descriptor()
+	// extensionstate.PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	extensionstate.PackageValidator = extensionstateDescPackage.Validators[0].(func(string) error)
+	// extensionstateDescEnabled is the schema descriptor for enabled field.
+	extensionstateDescEnabled := extensionstateFields[1].Descriptor()
+	// extensionstate.DefaultEnabled holds the default value on creation for the enabled field.
+	extensionstate.DefaultEnabled = extensionstateDescEnabled.Default.(bool)
+	// extensionstateDescUpdatedAt is the schema descriptor for updated_at field.
+	extensionstateDescUpdatedAt := extensionstateFields[3].Descriptor()
+	// extensionstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	extensionstate.DefaultUpdatedAt = extensionstateDescUpdatedAt.Default.(func() time.Time)
+	// extensionstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	extensionstate.UpdateDefaultUpdatedAt = extensionstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	extensionstorageFields := schema.ExtensionStorage{}.Fields()
+	_ = extensionstorageFields
+	// extensionstorageDescPackage is the schema descriptor for package field.
+	extensionstorageDescPackage := extensionstorageFields[0].Descriptor()
+	// extensionstorage.PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	extensionstorage.PackageValidator = extensionstorageDescPackage.Validators[0].(func(string) error)
+	// extensionstorageDescKey is the schema descriptor for key field.
+	extensionstorageDescKey := extensionstorageFields[1].Descriptor()
+	// extensionstorage.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	extensionstorage.KeyValidator = extensionstorageDescKey.Validators[0].(func(string) error)
+	// extensionstorageDescSize is the schema descriptor for size field.
+	extensionstorageDescSize := extensionstorageFields[3].Descriptor()
+	// extensionstorage.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	extensionstorage.SizeValidator = extensionstorageDescSize.Validators[0].(func(int) error)
+	// extensionstorageDescUpdatedAt is the schema descriptor for updated_at field.
+	extensionstorageDescUpdatedAt := extensionstorageFields[5].Descriptor()
+	// extensionstorage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	extensionstorage.DefaultUpdatedAt = extensionstorageDescUpdatedAt.Default.(func() time.Time)
+	// extensionstorage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	extensionstorage.UpdateDefaultUpdatedAt = extensionstorageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	extensionverificationFields := schema.ExtensionVerification{}.Fields()
+	_ = extensionverificationFields
+	// extensionverificationDescPackage is the schema descriptor for package field.
+	extensionverificationDescPackage := extensionverificationFields[0].Descriptor()
+	// extensionverification.PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	extensionverification.PackageValidator = extensionverificationDescPackage.Validators[0].(func(string) error)
+	// extensionverificationDescVerifiedAt is the schema descriptor for verified_at field.
+	extensionverificationDescVerifiedAt := extensionverificationFields[6].Descriptor()
+	// extensionverification.DefaultVerifiedAt holds the default value on creation for the verified_at field.
+	extensionverification.DefaultVerifiedAt = extensionverificationDescVerifiedAt.Default.(func() time.Time)
+	// extensionverification.UpdateDefaultVerifiedAt holds the default value on update for the verified_at field.
+	extensionverification.UpdateDefaultVerifiedAt = extensionverificationDescVerifiedAt.UpdateDefault.(func() time.Time)
+	extensionverificationfailureFields := schema.ExtensionVerificationFailure{}.Fields()
+	_ = extensionverificationfailureFields
+	// extensionverificationfailureDescPackage is the schema descriptor for package field.
+	extensionverificationfailureDescPackage := extensionverificationfailureFields[0].Descriptor()
+	// extensionverificationfailure.PackageValidator is a validator for the "package" field. It is called by the builders before save.
+	extensionverificationfailure.PackageValidator = extensionverificationfailureDescPackage.Validators[0].(func(string) error)
+	// extensionverificationfailureDescFailedAt is the schema descriptor for failed_at field.
+	extensionverificationfailureDescFailedAt := extensionverificationfailureFields[5].Descriptor()
+	// extensionverificationfailure.DefaultFailedAt holds the default value on creation for the failed_at field.
+	extensionverificationfailure.DefaultFailedAt = extensionverificationfailureDescFailedAt.Default.(func() time.Time)
+	// extensionverificationfailure.UpdateDefaultFailedAt holds the default value on update for the failed_at field.
+	extensionverificationfailure.UpdateDefaultFailedAt = extensionverificationfailureDescFailedAt.UpdateDefault.(func() time.Time)
 	favoriteFields := schema.Favorite{}.Fields()
 	_ = favoriteFields
 	// favoriteDescPackage is the schema descriptor for package field.
@@ -157,6 +249,10 @@ func init() {
 	favoritegroupDescDate := favoritegroupFields[2].Descriptor()
 	// favoritegroup.DefaultDate holds the default value on creation for the date field.
 	favoritegroup.DefaultDate = favoritegroupDescDate.Default.(func() time.Time)
+	// favoritegroupDescCheckInterval is the schema descriptor for check_interval field.
+	favoritegroupDescCheckInterval := favoritegroupFields[3].Descriptor()
+	// favoritegroup.CheckIntervalValidator is a validator for the "check_interval" field. It is called by the builders before save.
+	favoritegroup.CheckIntervalValidator = favoritegroupDescCheckInterval.Validators[0].(func(int) error)
 	// favoritegroupDescID is the schema descriptor for id field.
 	favoritegroupDescID := favoritegroupFields[0].Descriptor()
 	// favoritegroup.IDValidator is a validator for the "id" field. It is called by the builders before save.
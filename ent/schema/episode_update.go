@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EpisodeUpdate is an episode or chapter of a favorite found by the update
+// checker that was not in the stored detail.
+type EpisodeUpdate struct {
+	ent.Schema
+}
+
+// Fields of the EpisodeUpdate.
+func (EpisodeUpdate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("package").
+			NotEmpty().
+			Comment("The package identifier"),
+		field.String("detail_url").
+			NotEmpty().
+			Comment("The Detail URL of the favorite"),
+		field.String("title").
+			Comment("Title of the favorite"),
+		field.String("group_title").
+			Comment("Title of the episode group the episode is in"),
+		field.String("name").
+			Comment("Name of the episode"),
+		field.String("url").
+			NotEmpty().
+			Comment("Watch URL of the episode"),
+		field.Time("date").
+			Default(time.Now).
+			Comment("Date when the episode was found"),
+	}
+}
+
+// Edges of the EpisodeUpdate.
+func (EpisodeUpdate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EpisodeUpdate.
+func (EpisodeUpdate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("package", "detail_url", "url").
+			Unique(),
+	}
+}
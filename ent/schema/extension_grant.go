@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExtensionGrant holds the domains a user allowed an extension to access
+// on top of the ones it declares.
+type ExtensionGrant struct {
+	ent.Schema
+}
+
+// Fields of the ExtensionGrant.
+func (ExtensionGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("package").
+			NotEmpty().
+			Comment("Package name of the extension"),
+		field.String("domain").
+			NotEmpty().
+			Comment("Granted host, *.example.com matches subdomains"),
+	}
+}
+
+// Edges of the ExtensionGrant.
+func (ExtensionGrant) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ExtensionGrant.
+func (ExtensionGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("package", "domain").
+			Unique().
+			StorageKey("idx_extension_grant_package_domain"),
+	}
+}
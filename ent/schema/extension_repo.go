@@ -17,6 +17,7 @@ func (ExtensionRepoSetting) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("link").Unique().NotEmpty().Comment("The URL of the extension repository"),
 		field.String("name").NotEmpty().Comment("The name of the extension repository"),
+		field.Strings("trusted_keys").Optional().Comment("Base64 ed25519 public keys trusted to sign the extensions of the repository"),
 	}
 }
 
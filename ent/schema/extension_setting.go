@@ -31,14 +31,13 @@ func (ExtensionSetting) Fields() []ent.Field {
 			Nillable().
 			Comment("Value"),
 		field.String("default_value").
-			NotEmpty().
 			Nillable().
 			Default("").
 			Comment("Default value"),
 		field.Enum("db_type").
-			Values("input", "radio", "toggle").
+			Values("input", "radio", "toggle", "number", "multi_select", "secret").
 			Default("input").
-			Comment("Type stored as string: input, radio, toggle"),
+			Comment("Type stored as string: input, radio, toggle, number, multi_select, secret"),
 		field.String("description").
 			Optional().
 			Nillable().
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ExtensionState holds what the user decided about an installed extension,
+// packages without a state are enabled and unpinned.
+type ExtensionState struct {
+	ent.Schema
+}
+
+// Fields of the ExtensionState.
+func (ExtensionState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("package").
+			Unique().
+			NotEmpty().
+			Comment("Package name of the extension"),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Disabled extensions are listed but never run"),
+		field.String("pinned_version").
+			Optional().
+			Comment("Version the extension is held at, upgrades skip pinned extensions"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ExtensionState.
+func (ExtensionState) Edges() []ent.Edge {
+	return nil
+}
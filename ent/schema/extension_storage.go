@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExtensionStorage holds the values extensions keep with Miru.storage, apart
+// from their settings so none of it shows up in the settings UI.
+type ExtensionStorage struct {
+	ent.Schema
+}
+
+// Fields of the ExtensionStorage.
+func (ExtensionStorage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("package").
+			NotEmpty().
+			Comment("Package name of the extension"),
+		field.String("key").
+			NotEmpty(),
+		field.Text("value").
+			Comment("JSON encoded value"),
+		field.Int("size").
+			NonNegative().
+			Comment("Bytes of key and value counted against the quota of the package"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Expired entries are never returned, nil keeps the entry forever"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ExtensionStorage.
+func (ExtensionStorage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ExtensionStorage.
+func (ExtensionStorage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("package", "key").
+			Unique().
+			StorageKey("idx_extension_storage_package_key"),
+	}
+}
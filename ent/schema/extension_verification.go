@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ExtensionVerification holds the outcome of verifying the last script
+// downloaded for an extension against its repository index.
+type ExtensionVerification struct {
+	ent.Schema
+}
+
+// Fields of the ExtensionVerification.
+func (ExtensionVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("package").
+			Unique().
+			NotEmpty().
+			Comment("Package name of the extension"),
+		field.String("repo").
+			Comment("URL of the repository the script was downloaded from"),
+		field.Enum("status").
+			Values("signed", "hashed", "unverified").
+			Comment("signed and hashed scripts matched the index, failures are recorded as ExtensionVerificationFailure"),
+		field.String("hash").
+			Comment("sha256 of the verified script in hex"),
+		field.String("key").
+			Optional().
+			Comment("Trusted key the script was signed with"),
+		field.String("reason").
+			Optional().
+			Comment("Why the script failed verification"),
+		field.Time("verified_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ExtensionVerification.
+func (ExtensionVerification) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ExtensionVerificationFailure holds the last script downloaded for an
+// extension that failed verification. It is kept apart from the verification
+// of the installed script, which stays in place.
+type ExtensionVerificationFailure struct {
+	ent.Schema
+}
+
+// Fields of the ExtensionVerificationFailure.
+func (ExtensionVerificationFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("package").
+			Unique().
+			NotEmpty().
+			Comment("Package name of the extension"),
+		field.String("repo").
+			Comment("URL of the repository the script was downloaded from"),
+		field.Enum("status").
+			Values("rejected", "quarantined").
+			Comment("Whether the script was dropped or kept in the quarantine directory"),
+		field.String("hash").
+			Comment("sha256 of the failed script in hex"),
+		field.String("reason").
+			Comment("Why the script failed verification"),
+		field.Time("failed_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ExtensionVerificationFailure.
+func (ExtensionVerificationFailure) Edges() []ent.Edge {
+	return nil
+}
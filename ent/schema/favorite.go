@@ -45,6 +45,11 @@ func (Favorite) Fields() []ent.Field {
 		field.Time("date").
 			Default(time.Now).
 			Comment("Date when the favorite was created/updated"),
+
+		field.Time("checked_at").
+			Optional().
+			Nillable().
+			Comment("Last time the favorite was checked for new episodes"),
 	}
 }
 
@@ -28,6 +28,12 @@ func (FavoriteGroup) Fields() []ent.Field {
 		field.Time("date").
 			Default(time.Now).
 			Comment("Date when the group was created/updated"),
+
+		field.Int("check_interval").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Minutes between checks of its favorites for new episodes, 0 never checks and nil uses the default"),
 	}
 }
 
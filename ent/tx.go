@@ -18,10 +18,22 @@ type Tx struct {
 	Detail *DetailClient
 	// Download is the client for interacting with the Download builders.
 	Download *DownloadClient
+	// EpisodeUpdate is the client for interacting with the EpisodeUpdate builders.
+	EpisodeUpdate *EpisodeUpdateClient
+	// ExtensionGrant is the client for interacting with the ExtensionGrant builders.
+	ExtensionGrant *ExtensionGrantClient
 	// ExtensionRepoSetting is the client for interacting with the ExtensionRepoSetting builders.
 	ExtensionRepoSetting *ExtensionRepoSettingClient
 	// ExtensionSetting is the client for interacting with the ExtensionSetting builders.
 	ExtensionSetting *ExtensionSettingClient
+	// ExtensionState is the client for interacting with the ExtensionState builders.
+	ExtensionState *ExtensionStateClient
+	// ExtensionStorage is the client for interacting with the ExtensionStorage builders.
+	ExtensionStorage *ExtensionStorageClient
+	// ExtensionVerification is the client for interacting with the ExtensionVerification builders.
+	ExtensionVerification *ExtensionVerificationClient
+	// ExtensionVerificationFailure is the client for interacting with the ExtensionVerificationFailure builders.
+	ExtensionVerificationFailure *ExtensionVerificationFailureClient
 	// Favorite is the client for interacting with the Favorite builders.
 	Favorite *FavoriteClient
 	// FavoriteGroup is the client for interacting with the FavoriteGroup builders.
@@ -166,8 +178,14 @@ func (tx *Tx) init() {
 	tx.AppSetting = NewAppSettingClient(tx.config)
 	tx.Detail = NewDetailClient(tx.config)
 	tx.Download = NewDownloadClient(tx.config)
+	tx.EpisodeUpdate = NewEpisodeUpdateClient(tx.config)
+	tx.ExtensionGrant = NewExtensionGrantClient(tx.config)
 	tx.ExtensionRepoSetting = NewExtensionRepoSettingClient(tx.config)
 	tx.ExtensionSetting = NewExtensionSettingClient(tx.config)
+	tx.ExtensionState = NewExtensionStateClient(tx.config)
+	tx.ExtensionStorage = NewExtensionStorageClient(tx.config)
+	tx.ExtensionVerification = NewExtensionVerificationClient(tx.config)
+	tx.ExtensionVerificationFailure = NewExtensionVerificationFailureClient(tx.config)
 	tx.Favorite = NewFavoriteClient(tx.config)
 	tx.FavoriteGroup = NewFavoriteGroupClient(tx.config)
 	tx.History = NewHistoryClient(tx.config)
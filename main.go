@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"os"
 
 	"github.com/miru-project/miru-core/binary"
 )
@@ -12,6 +13,9 @@ func main() {
 
 	configPath := flag.String("config", "config.json", "Path to configuration file")
 	flag.Parse()
+	if flag.Arg(0) == "test-extension" {
+		os.Exit(binary.TestExtension(flag.Args()[1:]))
+	}
 	binary.InitProgram(configPath)
 
 }
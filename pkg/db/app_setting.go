@@ -3,11 +3,13 @@ package db
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"sync"
 
 	"github.com/miru-project/miru-core/ent"
 	"github.com/miru-project/miru-core/ent/appsetting"
+	"github.com/miru-project/miru-core/ent/extensionreposetting"
 	"github.com/miru-project/miru-core/ext"
 )
 
@@ -125,8 +127,33 @@ func GetAllRepositories() ([]*ent.ExtensionRepoSetting, error) {
 	return ext.EntClient().ExtensionRepoSetting.Query().All(context.Background())
 }
 
+// SetRepositoryTrustedKeys replaces the keys trusted to sign the extensions of a repository
+func SetRepositoryTrustedKeys(url string, keys []string) error {
+	n, e := ext.EntClient().ExtensionRepoSetting.Update().
+		Where(extensionreposetting.LinkEQ(url)).
+		SetTrustedKeys(keys).
+		Save(context.Background())
+	if e == nil && n == 0 {
+		return fmt.Errorf("repository %s not found", url)
+	}
+	return e
+}
+
+func GetRepositoryTrustedKeys(url string) ([]string, error) {
+	repo, e := ext.EntClient().ExtensionRepoSetting.Query().
+		Where(extensionreposetting.LinkEQ(url)).
+		Only(context.Background())
+	if e != nil {
+		return nil, e
+	}
+	return repo.TrustedKeys, nil
+}
+
 func Initialize() {
 	appSettings.init()
+	if e := migrateExtensionSettingsOnce(); e != nil {
+		log.Println("Failed to migrate extension settings:", e)
+	}
 
 	// Init official Miru extension
 	if _, e := ext.EntClient().ExtensionRepoSetting.Query().First(context.Background()); e != nil {
@@ -95,3 +95,13 @@ func DetailFilter(ps ...predicate.Detail) func(*ent.DetailQuery) {
 		q.Where(ps...)
 	}
 }
+
+// SetDetailEpisodes updates only the episodes of a detail.
+func SetDetailEpisodes(pkg, url string, episodes string) error {
+	client := ext.EntClient()
+	_, err := client.Detail.Update().
+		Where(detail.Package(pkg), detail.DetailUrl(url)).
+		SetEpisodes(episodes).
+		Save(context.Background())
+	return err
+}
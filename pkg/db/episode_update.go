@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/ent/episodeupdate"
+	"github.com/miru-project/miru-core/ext"
+)
+
+// AddEpisodeUpdates records new episodes of favorites and returns the ones
+// that were inserted. Episodes recorded before are skipped.
+func AddEpisodeUpdates(updates []*ent.EpisodeUpdate) ([]*ent.EpisodeUpdate, error) {
+	ctx := context.Background()
+	tx, err := ext.EntClient().Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := addEpisodeUpdates(ctx, tx, updates)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return created, tx.Commit()
+}
+
+func addEpisodeUpdates(ctx context.Context, tx *ent.Tx, updates []*ent.EpisodeUpdate) ([]*ent.EpisodeUpdate, error) {
+	type key struct{ pkg, detailURL, url string }
+	recorded := map[key]bool{}
+	var builders []*ent.EpisodeUpdateCreate
+	for _, u := range updates {
+		k := key{u.Package, u.DetailURL, u.URL}
+		if recorded[k] {
+			continue
+		}
+		exists, err := tx.EpisodeUpdate.Query().
+			Where(
+				episodeupdate.PackageEQ(u.Package),
+				episodeupdate.DetailURLEQ(u.DetailURL),
+				episodeupdate.URLEQ(u.URL),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		recorded[k] = true
+		if exists {
+			continue
+		}
+		builders = append(builders, tx.EpisodeUpdate.Create().
+			SetPackage(u.Package).
+			SetDetailURL(u.DetailURL).
+			SetTitle(u.Title).
+			SetGroupTitle(u.GroupTitle).
+			SetName(u.Name).
+			SetURL(u.URL).
+			SetDate(u.Date))
+	}
+	if len(builders) == 0 {
+		return nil, nil
+	}
+	return tx.EpisodeUpdate.CreateBulk(builders...).Save(ctx)
+}
+
+// GetEpisodeUpdates returns the recorded new episodes, newest first. Empty
+// pkg and url match every favorite.
+func GetEpisodeUpdates(pkg, url string) ([]*ent.EpisodeUpdate, error) {
+	client := ext.EntClient()
+	q := client.EpisodeUpdate.Query()
+	if pkg != "" {
+		q.Where(episodeupdate.PackageEQ(pkg))
+	}
+	if url != "" {
+		q.Where(episodeupdate.DetailURLEQ(url))
+	}
+	return q.Order(ent.Desc(episodeupdate.FieldDate), ent.Desc(episodeupdate.FieldID)).All(context.Background())
+}
+
+// DeleteEpisodeUpdates removes the recorded new episodes, e.g. once the user
+// saw them. Empty pkg and url match every favorite.
+func DeleteEpisodeUpdates(pkg, url string) error {
+	client := ext.EntClient()
+	d := client.EpisodeUpdate.Delete()
+	if pkg != "" {
+		d.Where(episodeupdate.PackageEQ(pkg))
+	}
+	if url != "" {
+		d.Where(episodeupdate.DetailURLEQ(url))
+	}
+	_, err := d.Exec(context.Background())
+	return err
+}
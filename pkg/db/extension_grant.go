@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+
+	"github.com/miru-project/miru-core/ent/extensiongrant"
+	"github.com/miru-project/miru-core/ext"
+)
+
+// GrantDomains allows an extension to access domains it did not declare
+func GrantDomains(pkg string, domains []string) error {
+	client := ext.EntClient()
+	ctx := context.Background()
+	for _, domain := range domains {
+		err := client.ExtensionGrant.Create().
+			SetPackage(pkg).
+			SetDomain(domain).
+			OnConflictColumns(extensiongrant.FieldPackage, extensiongrant.FieldDomain).
+			Ignore().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func RevokeDomains(pkg string, domains []string) error {
+	client := ext.EntClient()
+	ctx := context.Background()
+	_, err := client.ExtensionGrant.Delete().
+		Where(extensiongrant.PackageEQ(pkg), extensiongrant.DomainIn(domains...)).
+		Exec(ctx)
+	return err
+}
+
+func GetGrantedDomains(pkg string) ([]string, error) {
+	client := ext.EntClient()
+	ctx := context.Background()
+	return client.ExtensionGrant.Query().
+		Where(extensiongrant.PackageEQ(pkg)).
+		Select(extensiongrant.FieldDomain).
+		Strings(ctx)
+}
@@ -6,24 +6,74 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/miru-project/miru-core/ent"
 	"github.com/miru-project/miru-core/ent/extensionsetting"
 	"github.com/miru-project/miru-core/ext"
 )
 
+// SetSetting changes the value of a registered setting of an extension, the
+// value has to be valid for the type and options of the setting
 func SetSetting(pkg string, key string, value string) error {
 	setting, e := GetSetting(pkg, key)
+	if ent.IsNotFound(e) {
+		return fmt.Errorf("setting %s of %s is not registered", key, pkg)
+	}
 	if e != nil {
 		return e
 	}
-	if setting != nil {
-		client := ext.EntClient()
-		ctx := context.Background()
-		_, err := client.ExtensionSetting.Update().Where(extensionsetting.PackageEQ(pkg), extensionsetting.KeyEQ(key)).SetValue(value).Save(ctx)
-		return err
+	value, e = NormalizeSettingValue(setting.DbType, setting.Options, value)
+	if e != nil {
+		return fmt.Errorf("invalid value for setting %s: %v", key, e)
+	}
+	value, e = storedSettingValue(setting.DbType, value)
+	if e != nil {
+		return e
+	}
+	return ext.EntClient().ExtensionSetting.UpdateOne(setting).SetValue(value).Exec(context.Background())
+}
+
+// GetSettingValue returns the value of a setting of an extension with secrets
+// decrypted, nil when the setting is not registered. Secrets that can't be
+// decrypted, like ones restored from a backup of another device, read as the
+// default value
+func GetSettingValue(pkg string, key string) (*string, error) {
+	setting, e := GetSetting(pkg, key)
+	if ent.IsNotFound(e) {
+		return nil, nil
+	}
+	if e != nil {
+		return nil, e
+	}
+	value, e := readSettingValue(setting)
+	if e != nil {
+		log.Printf("[Settings] Can't read %s of %s: %v", key, pkg, e)
+		return setting.DefaultValue, nil
+	}
+	return &value, nil
+}
+
+// storedSettingValue is how value is kept in the database, secrets are encrypted
+func storedSettingValue(dbType extensionsetting.DbType, value string) (string, error) {
+	if dbType != extensionsetting.DbTypeSecret {
+		return value, nil
 	}
-	return errors.New("setting keys not found")
+	return encryptSecret(value)
+}
+
+// readSettingValue returns the plain value of a setting
+func readSettingValue(setting *ent.ExtensionSetting) (string, error) {
+	if setting.Value == nil {
+		return "", nil
+	}
+	if setting.DbType != extensionsetting.DbTypeSecret {
+		return *setting.Value, nil
+	}
+	return decryptSecret(*setting.Value)
 }
 
 func RemoveSetting(pkg string, key string) error {
@@ -45,6 +95,10 @@ func GetSettingsByPackage(pkg string) ([]*ent.ExtensionSetting, error) {
 	return client.ExtensionSetting.Query().Where(extensionsetting.PackageEQ(pkg)).All(ctx)
 }
 
+// RegisterSetting creates the setting an extension registers or updates its
+// definition when it was registered before. The stored value is kept unless
+// it is no longer valid for the new type or options, it is reset to the
+// default value then
 func RegisterSetting(setting map[string]any, pkg string) error {
 	log.Printf("[RegisterSetting] pkg: %s, setting: %+v", pkg, setting)
 
@@ -53,36 +107,258 @@ func RegisterSetting(setting map[string]any, pkg string) error {
 	if pkg == "" || key == nil || title == nil {
 		return errors.New("package name or key cannot be empty")
 	}
-	set, _ := GetSetting(pkg, *key)
-	dbType := nilableObj[extensionsetting.DbType](setting["type"])
-	if set != nil && extensionsetting.DbType(set.DbType.String()) != setting["type"] {
-		return nil
+	dbType, e := ParseSettingType(safeString(setting["type"]))
+	if e != nil {
+		return e
+	}
+	options := jsonString(setting["options"])
+	defaultValue := settingValue(setting["defaultValue"])
+	if defaultValue != nil {
+		v, e := NormalizeSettingValue(dbType, options, *defaultValue)
+		if e != nil {
+			return fmt.Errorf("invalid default value for setting %s: %v", *key, e)
+		}
+		defaultValue = &v
+	}
+	value := settingValue(setting["value"])
+	if value != nil {
+		v, e := NormalizeSettingValue(dbType, options, *value)
+		if e != nil {
+			return fmt.Errorf("invalid value for setting %s: %v", *key, e)
+		}
+		value = &v
 	}
 	client := ext.EntClient()
 	ctx := context.Background()
 
-	if dbType == nil {
-		if t := safeString(setting["type"]); t != nil {
-			val := extensionsetting.DbType(*t)
-			dbType = &val
+	set, e := GetSetting(pkg, *key)
+	if e != nil && !ent.IsNotFound(e) {
+		return e
+	}
+	if set != nil && value == nil && set.Value != nil {
+		old, e := readSettingValue(set)
+		if e == nil {
+			old, e = NormalizeSettingValue(dbType, options, old)
+		}
+		if e == nil {
+			value = &old
+		} else {
+			log.Printf("[RegisterSetting] Resetting %s of %s, %v", *key, pkg, e)
+		}
+	}
+	if value == nil {
+		value = defaultValue
+	}
+	stored, e := storedSettingValue(dbType, "")
+	if value != nil {
+		stored, e = storedSettingValue(dbType, *value)
+	}
+	if e != nil {
+		return e
+	}
+	if set != nil {
+		update := client.ExtensionSetting.UpdateOne(set).
+			SetTitle(*title).
+			SetDbType(dbType).
+			SetValue(stored).
+			SetNillableDescription(safeString(setting["description"])).
+			SetNillableOptions(options)
+		if defaultValue != nil {
+			update.SetDefaultValue(*defaultValue)
+		} else {
+			update.SetDefaultValue("")
+		}
+		if setting["description"] == nil {
+			update.ClearDescription()
 		}
+		if options == nil {
+			update.ClearOptions()
+		}
+		return update.Exec(ctx)
 	}
 
 	_, err := client.ExtensionSetting.Create().
 		SetPackage(pkg).
 		SetTitle(*title).
 		SetKey(*key).
-		SetNillableDbType(dbType).
-		SetNillableValue(safeString(setting["value"])).
-		SetNillableDefaultValue(safeString(setting["defaultValue"])).
+		SetDbType(dbType).
+		SetValue(stored).
+		SetNillableDefaultValue(defaultValue).
 		SetNillableDescription(safeString(setting["description"])).
-		SetNillableOptions(jsonString(setting["options"])).
+		SetNillableOptions(options).
 		Save(ctx)
 	if err != nil {
 		log.Printf("[RegisterSetting] Error saving setting: %v", err)
 	}
 	return err
 }
+
+// settingValue is the string a registered value or default value is stored
+// as, lists of a multi select are stored as JSON
+func settingValue(obj any) *string {
+	if list, ok := obj.([]any); ok {
+		return jsonString(list)
+	}
+	return safeString(obj)
+}
+
+// ParseSettingType returns the stored type of the type name an extension
+// registers a setting with, settings without a type are inputs
+func ParseSettingType(t *string) (extensionsetting.DbType, error) {
+	if t == nil || *t == "" {
+		return extensionsetting.DbTypeInput, nil
+	}
+	name := strings.ReplaceAll(strings.ToLower(*t), "-", "_")
+	if name == "multiselect" {
+		name = "multi_select"
+	}
+	dbType := extensionsetting.DbType(name)
+	if e := extensionsetting.DbTypeValidator(dbType); e != nil {
+		return "", fmt.Errorf("unknown setting type %q", *t)
+	}
+	return dbType, nil
+}
+
+// settingChoices returns what may be picked from the options of a radio or
+// multi select. Options are a JSON list of values, a JSON object mapping
+// labels to values where either is accepted, or a comma-separated list
+func settingChoices(options *string) []string {
+	if options == nil || strings.TrimSpace(*options) == "" {
+		return nil
+	}
+	var list []any
+	if json.Unmarshal([]byte(*options), &list) == nil {
+		choices := make([]string, 0, len(list))
+		for _, v := range list {
+			if s := safeString(v); s != nil {
+				choices = append(choices, *s)
+			}
+		}
+		return choices
+	}
+	var labels map[string]any
+	if json.Unmarshal([]byte(*options), &labels) == nil {
+		choices := make([]string, 0, len(labels)*2)
+		for label, v := range labels {
+			choices = append(choices, label)
+			if s := safeString(v); s != nil {
+				choices = append(choices, *s)
+			}
+		}
+		return choices
+	}
+	var choices []string
+	for _, v := range strings.Split(*options, ",") {
+		choices = append(choices, strings.TrimSpace(v))
+	}
+	return choices
+}
+
+// NormalizeSettingValue checks value against the type and options of a
+// setting and returns it the way it is stored: toggles as true or false and
+// multi selects as a JSON list
+func NormalizeSettingValue(dbType extensionsetting.DbType, options *string, value string) (string, error) {
+	switch dbType {
+	case extensionsetting.DbTypeToggle:
+		b, e := strconv.ParseBool(strings.TrimSpace(value))
+		if e != nil {
+			return "", fmt.Errorf("%q is not a boolean", value)
+		}
+		return strconv.FormatBool(b), nil
+	case extensionsetting.DbTypeNumber:
+		value = strings.TrimSpace(value)
+		f, e := strconv.ParseFloat(value, 64)
+		if e != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", fmt.Errorf("%q is not a number", value)
+		}
+		return value, nil
+	case extensionsetting.DbTypeRadio:
+		if choices := settingChoices(options); choices != nil && !slices.Contains(choices, value) {
+			return "", fmt.Errorf("%q is not one of the options", value)
+		}
+		return value, nil
+	case extensionsetting.DbTypeMultiSelect:
+		selected := []string{}
+		if strings.TrimSpace(value) != "" {
+			if e := json.Unmarshal([]byte(value), &selected); e != nil {
+				return "", fmt.Errorf("%q is not a JSON list of strings", value)
+			}
+		}
+		choices := settingChoices(options)
+		for _, v := range selected {
+			if choices != nil && !slices.Contains(choices, v) {
+				return "", fmt.Errorf("%q is not one of the options", v)
+			}
+		}
+		b, _ := json.Marshal(selected)
+		return string(b), nil
+	}
+	return value, nil
+}
+
+// extensionSettingsVersionKey is the app setting holding the version the
+// extension settings were migrated to
+const (
+	extensionSettingsVersionKey = "extension_settings_version"
+	extensionSettingsVersion    = "1"
+)
+
+// migrateExtensionSettingsOnce runs migrateExtensionSettings unless the stored
+// settings were already migrated
+func migrateExtensionSettingsOnce() error {
+	if version, _ := GetAPPSetting(extensionSettingsVersionKey); version == extensionSettingsVersion {
+		return nil
+	}
+	if err := migrateExtensionSettings(); err != nil {
+		return err
+	}
+	return SetAppSetting(extensionSettingsVersionKey, extensionSettingsVersion)
+}
+
+// migrateExtensionSettings brings the values stored before settings were
+// validated in line with their types, values that can't be read as their
+// type are reset to the default value. Secrets stored in plain text are
+// encrypted
+func migrateExtensionSettings() error {
+	client := ext.EntClient()
+	ctx := context.Background()
+	settings, err := client.ExtensionSetting.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range settings {
+		secret := s.DbType == extensionsetting.DbTypeSecret
+		// Secrets of another key are left for when the key is restored
+		if secret && s.Value != nil && isEncrypted(*s.Value) {
+			continue
+		}
+		value := ""
+		if s.Value != nil {
+			value = *s.Value
+		} else if s.DefaultValue != nil {
+			value = *s.DefaultValue
+		}
+		value, e := NormalizeSettingValue(s.DbType, s.Options, value)
+		if e != nil {
+			log.Printf("[Settings] Resetting %s of %s: %v", s.Key, s.Package, e)
+			value = ""
+			if s.DefaultValue != nil {
+				value = *s.DefaultValue
+			}
+		} else if s.Value != nil && *s.Value == value && (!secret || value == "") {
+			continue
+		}
+		stored, err := storedSettingValue(s.DbType, value)
+		if err != nil {
+			return err
+		}
+		if err := client.ExtensionSetting.UpdateOne(s).SetValue(stored).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func jsonString(obj any) *string {
 	if obj == nil {
 		return nil
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/miru-project/miru-core/ent/extensionsetting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSettingType(t *testing.T) {
+	str := func(s string) *string { return &s }
+	for name, want := range map[string]extensionsetting.DbType{
+		"":             extensionsetting.DbTypeInput,
+		"toggle":       extensionsetting.DbTypeToggle,
+		"Number":       extensionsetting.DbTypeNumber,
+		"multi-select": extensionsetting.DbTypeMultiSelect,
+		"multiselect":  extensionsetting.DbTypeMultiSelect,
+		"secret":       extensionsetting.DbTypeSecret,
+	} {
+		got, err := ParseSettingType(str(name))
+		assert.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+	got, err := ParseSettingType(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, extensionsetting.DbTypeInput, got)
+	_, err = ParseSettingType(str("slider"))
+	assert.ErrorContains(t, err, "unknown setting type")
+}
+
+func TestNormalizeSettingValue(t *testing.T) {
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		dbType  extensionsetting.DbType
+		options *string
+		value   string
+		want    string
+		err     bool
+	}{
+		{extensionsetting.DbTypeInput, nil, "anything", "anything", false},
+		{extensionsetting.DbTypeSecret, nil, "token", "token", false},
+		{extensionsetting.DbTypeToggle, nil, "1", "true", false},
+		{extensionsetting.DbTypeToggle, nil, "False", "false", false},
+		{extensionsetting.DbTypeToggle, nil, "yes", "", true},
+		{extensionsetting.DbTypeNumber, nil, " 1.5 ", "1.5", false},
+		{extensionsetting.DbTypeNumber, nil, "NaN", "", true},
+		{extensionsetting.DbTypeNumber, nil, "ten", "", true},
+		{extensionsetting.DbTypeRadio, str(`["a","b"]`), "b", "b", false},
+		{extensionsetting.DbTypeRadio, str(`{"Label":"value"}`), "value", "value", false},
+		{extensionsetting.DbTypeRadio, str(`{"Label":"value"}`), "Label", "Label", false},
+		{extensionsetting.DbTypeRadio, str("a, b"), "b", "b", false},
+		{extensionsetting.DbTypeRadio, str(`["a","b"]`), "c", "", true},
+		{extensionsetting.DbTypeMultiSelect, str(`["a","b","c"]`), `["c","a"]`, `["c","a"]`, false},
+		{extensionsetting.DbTypeMultiSelect, str(`["a","b","c"]`), "", `[]`, false},
+		{extensionsetting.DbTypeMultiSelect, str(`["a","b","c"]`), `["d"]`, "", true},
+		{extensionsetting.DbTypeMultiSelect, str(`["a","b","c"]`), "a", "", true},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeSettingValue(tt.dbType, tt.options, tt.value)
+		if tt.err {
+			assert.Error(t, err, "%s %q", tt.dbType, tt.value)
+			continue
+		}
+		assert.NoError(t, err, "%s %q", tt.dbType, tt.value)
+		assert.Equal(t, tt.want, got, "%s %q", tt.dbType, tt.value)
+	}
+}
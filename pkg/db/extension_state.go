@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/ent/extensionstate"
+	"github.com/miru-project/miru-core/ext"
+)
+
+// SetExtensionEnabled enables or disables an extension
+func SetExtensionEnabled(pkg string, enabled bool) error {
+	return ext.EntClient().ExtensionState.Create().
+		SetPackage(pkg).
+		SetEnabled(enabled).
+		OnConflictColumns(extensionstate.FieldPackage).
+		UpdateEnabled().
+		UpdateUpdatedAt().
+		Exec(context.Background())
+}
+
+// SetExtensionPinnedVersion holds an extension at version, an empty version unpins it
+func SetExtensionPinnedVersion(pkg string, version string) error {
+	return ext.EntClient().ExtensionState.Create().
+		SetPackage(pkg).
+		SetPinnedVersion(version).
+		OnConflictColumns(extensionstate.FieldPackage).
+		UpdatePinnedVersion().
+		UpdateUpdatedAt().
+		Exec(context.Background())
+}
+
+// GetExtensionState returns the state of an extension, nil when the user
+// never changed it
+func GetExtensionState(pkg string) (*ent.ExtensionState, error) {
+	s, err := ext.EntClient().ExtensionState.Query().
+		Where(extensionstate.PackageEQ(pkg)).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return s, err
+}
+
+func GetAllExtensionStates() ([]*ent.ExtensionState, error) {
+	return ext.EntClient().ExtensionState.Query().All(context.Background())
+}
+
+func DeleteExtensionState(pkg string) error {
+	_, err := ext.EntClient().ExtensionState.Delete().
+		Where(extensionstate.PackageEQ(pkg)).
+		Exec(context.Background())
+	return err
+}
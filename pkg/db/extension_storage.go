@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/ent/extensionstorage"
+	"github.com/miru-project/miru-core/ent/predicate"
+	"github.com/miru-project/miru-core/ext"
+)
+
+// ErrStorageQuota is returned when a value would take a package over its quota
+var ErrStorageQuota = errors.New("storage quota exceeded")
+
+// storageLive matches the entries of pkg that did not expire
+func storageLive(pkg string) predicate.ExtensionStorage {
+	return extensionstorage.And(
+		extensionstorage.PackageEQ(pkg),
+		extensionstorage.Or(
+			extensionstorage.ExpiresAtIsNil(),
+			extensionstorage.ExpiresAtGT(time.Now()),
+		),
+	)
+}
+
+// GetStorage returns the entry of an extension under key, nil when there is
+// none or it expired
+func GetStorage(pkg string, key string) (*ent.ExtensionStorage, error) {
+	s, err := ext.EntClient().ExtensionStorage.Query().
+		Where(storageLive(pkg), extensionstorage.KeyEQ(key)).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return s, err
+}
+
+// SetStorage stores value under key for an extension. The entry expires at
+// expiresAt unless it is nil, and quota bounds the bytes all live entries of
+// the package may take, 0 leaves them unbounded
+func SetStorage(pkg string, key string, value string, expiresAt *time.Time, quota int) error {
+	ctx := context.Background()
+	tx, err := ext.EntClient().Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := setStorage(ctx, tx, pkg, key, value, expiresAt, quota); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func setStorage(ctx context.Context, tx *ent.Tx, pkg string, key string, value string, expiresAt *time.Time, quota int) error {
+	_, err := tx.ExtensionStorage.Delete().
+		Where(extensionstorage.PackageEQ(pkg), extensionstorage.ExpiresAtLTE(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	size := len(key) + len(value)
+	if quota > 0 {
+		sizes, err := tx.ExtensionStorage.Query().
+			Where(extensionstorage.PackageEQ(pkg), extensionstorage.KeyNEQ(key)).
+			Select(extensionstorage.FieldSize).
+			Ints(ctx)
+		if err != nil {
+			return err
+		}
+		used := size
+		for _, s := range sizes {
+			used += s
+		}
+		if used > quota {
+			return fmt.Errorf("%w: %s would use %d of %d bytes", ErrStorageQuota, pkg, used, quota)
+		}
+	}
+	return tx.ExtensionStorage.Create().
+		SetPackage(pkg).
+		SetKey(key).
+		SetValue(value).
+		SetSize(size).
+		SetNillableExpiresAt(expiresAt).
+		OnConflictColumns(extensionstorage.FieldPackage, extensionstorage.FieldKey).
+		UpdateValue().
+		UpdateSize().
+		Update(func(u *ent.ExtensionStorageUpsert) {
+			if expiresAt == nil {
+				u.ClearExpiresAt()
+			} else {
+				u.UpdateExpiresAt()
+			}
+		}).
+		UpdateUpdatedAt().
+		Exec(ctx)
+}
+
+// DeleteStorage removes the entry of an extension under key
+func DeleteStorage(pkg string, key string) error {
+	_, err := ext.EntClient().ExtensionStorage.Delete().
+		Where(extensionstorage.PackageEQ(pkg), extensionstorage.KeyEQ(key)).
+		Exec(context.Background())
+	return err
+}
+
+// GetStorageKeys returns the sorted keys of the live entries of an extension
+func GetStorageKeys(pkg string) ([]string, error) {
+	return ext.EntClient().ExtensionStorage.Query().
+		Where(storageLive(pkg)).
+		Order(ent.Asc(extensionstorage.FieldKey)).
+		Select(extensionstorage.FieldKey).
+		Strings(context.Background())
+}
+
+// ClearStorage removes every entry of an extension
+func ClearStorage(pkg string) error {
+	_, err := ext.EntClient().ExtensionStorage.Delete().
+		Where(extensionstorage.PackageEQ(pkg)).
+		Exec(context.Background())
+	return err
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
+	"github.com/miru-project/miru-core/ext"
+)
+
+// SaveVerification records the outcome of verifying the script of an extension
+func SaveVerification(pkg string, repo string, status extensionverification.Status, hash string, key string, reason string) error {
+	return ext.EntClient().ExtensionVerification.Create().
+		SetPackage(pkg).
+		SetRepo(repo).
+		SetStatus(status).
+		SetHash(hash).
+		SetKey(key).
+		SetReason(reason).
+		OnConflictColumns(extensionverification.FieldPackage).
+		UpdateNewValues().
+		Exec(context.Background())
+}
+
+// GetVerification returns the last verification of an extension, nil when it
+// was never verified
+func GetVerification(pkg string) (*ent.ExtensionVerification, error) {
+	v, err := ext.EntClient().ExtensionVerification.Query().
+		Where(extensionverification.PackageEQ(pkg)).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return v, err
+}
+
+func DeleteVerification(pkg string) error {
+	_, err := ext.EntClient().ExtensionVerification.Delete().
+		Where(extensionverification.PackageEQ(pkg)).
+		Exec(context.Background())
+	return err
+}
+
+// SaveVerificationFailure records a downloaded script of an extension that
+// failed verification, the verification of the installed script is kept
+func SaveVerificationFailure(pkg string, repo string, status extensionverificationfailure.Status, hash string, reason string) error {
+	return ext.EntClient().ExtensionVerificationFailure.Create().
+		SetPackage(pkg).
+		SetRepo(repo).
+		SetStatus(status).
+		SetHash(hash).
+		SetReason(reason).
+		OnConflictColumns(extensionverificationfailure.FieldPackage).
+		UpdateNewValues().
+		Exec(context.Background())
+}
+
+// GetVerificationFailure returns the last script of an extension that failed
+// verification, nil when none did since one was installed
+func GetVerificationFailure(pkg string) (*ent.ExtensionVerificationFailure, error) {
+	v, err := ext.EntClient().ExtensionVerificationFailure.Query().
+		Where(extensionverificationfailure.PackageEQ(pkg)).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return v, err
+}
+
+func DeleteVerificationFailure(pkg string) error {
+	_, err := ext.EntClient().ExtensionVerificationFailure.Delete().
+		Where(extensionverificationfailure.PackageEQ(pkg)).
+		Exec(context.Background())
+	return err
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	sql "entgo.io/ent/dialect/sql"
@@ -158,3 +159,38 @@ func GetFavoriteGroupsByFavorite(pkg, url string) ([]*ent.FavoriteGroup, error)
 		)).
 		All(ctx)
 }
+
+// GetFavoritesWithGroups returns all favorites with the groups they are in.
+func GetFavoritesWithGroups() ([]*ent.Favorite, error) {
+	client := ext.EntClient()
+	ctx := context.Background()
+	return client.Favorite.Query().WithGroup().All(ctx)
+}
+
+// SetFavoriteChecked records when a favorite was last checked for new episodes.
+func SetFavoriteChecked(id int, checkedAt time.Time) error {
+	client := ext.EntClient()
+	ctx := context.Background()
+	return client.Favorite.UpdateOneID(id).SetCheckedAt(checkedAt).Exec(ctx)
+}
+
+// SetFavoriteGroupCheckInterval sets the minutes between checks of the favorites
+// in a group, nil uses the default interval.
+func SetFavoriteGroupCheckInterval(name string, minutes *int) error {
+	client := ext.EntClient()
+	ctx := context.Background()
+	update := client.FavoriteGroup.Update().Where(favoritegroup.NameEQ(name))
+	if minutes == nil {
+		update.ClearCheckInterval()
+	} else {
+		update.SetCheckInterval(*minutes)
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("favorite group %s not found", name)
+	}
+	return nil
+}
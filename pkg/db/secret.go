@@ -0,0 +1,122 @@
+package db
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/miru-project/miru-core/config"
+)
+
+// SecretMask replaces the values of secret settings outside the extension
+// owning them
+const SecretMask = "********"
+
+// secretPrefix marks values encrypted with the secret key
+const secretPrefix = "enc:v1:"
+
+var secretKey struct {
+	sync.Mutex
+	path string
+	aead cipher.AEAD
+}
+
+// secretCipher returns the cipher of the key at config.Global.SecretKeyPath,
+// a new key is written there when the file does not exist
+func secretCipher() (cipher.AEAD, error) {
+	secretKey.Lock()
+	defer secretKey.Unlock()
+	path := config.Global.SecretKeyPath
+	if path == "" {
+		return nil, errors.New("no secret key path configured")
+	}
+	if secretKey.aead != nil && secretKey.path == path {
+		return secretKey.aead, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		data = make([]byte, 32)
+		if _, err := rand.Read(data); err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, err
+		}
+		encoded := base64.StdEncoding.EncodeToString(data)
+		if err := os.WriteFile(path, []byte(encoded), 0600); err != nil {
+			return nil, fmt.Errorf("failed to write secret key: %v", err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read secret key: %v", err)
+	} else {
+		data, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil || len(data) != 32 {
+			return nil, fmt.Errorf("secret key %s is not 32 base64 encoded bytes", path)
+		}
+	}
+
+	block, err := aes.NewCipher(data)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	secretKey.path = path
+	secretKey.aead = aead
+	return aead, nil
+}
+
+// isEncrypted reports whether value was stored by encryptSecret
+func isEncrypted(value string) bool {
+	return strings.HasPrefix(value, secretPrefix)
+}
+
+// encryptSecret encrypts value with the secret key, empty values are stored
+// as they are
+func encryptSecret(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	aead, err := secretCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(value), nil)
+	return secretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecret reverses encryptSecret, values that are not encrypted are
+// returned as they are
+func decryptSecret(value string) (string, error) {
+	if !isEncrypted(value) {
+		return value, nil
+	}
+	aead, err := secretCipher()
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, secretPrefix))
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("malformed secret")
+	}
+	nonce, sealed := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", errors.New("secret was encrypted with another key")
+	}
+	return string(plain), nil
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func useSecretKey(t *testing.T, path string) {
+	t.Helper()
+	previous := config.Global.SecretKeyPath
+	config.Global.SecretKeyPath = path
+	t.Cleanup(func() { config.Global.SecretKeyPath = previous })
+}
+
+func TestSecrets(t *testing.T) {
+	dir := t.TempDir()
+	useSecretKey(t, filepath.Join(dir, "keys", "secret.key"))
+
+	encrypted, err := encryptSecret("token")
+	assert.NoError(t, err)
+	assert.True(t, isEncrypted(encrypted))
+	assert.NotContains(t, encrypted, "token")
+	again, err := encryptSecret("token")
+	assert.NoError(t, err)
+	assert.NotEqual(t, encrypted, again)
+
+	decrypted, err := decryptSecret(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, "token", decrypted)
+	plain, err := decryptSecret("plain")
+	assert.NoError(t, err)
+	assert.Equal(t, "plain", plain)
+	empty, err := encryptSecret("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", empty)
+
+	info, err := os.Stat(filepath.Join(dir, "keys", "secret.key"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// Another key can't read the secret
+	useSecretKey(t, filepath.Join(dir, "other.key"))
+	_, err = decryptSecret(encrypted)
+	assert.ErrorContains(t, err, "another key")
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "bad.key"), []byte("short"), 0600))
+	useSecretKey(t, filepath.Join(dir, "bad.key"))
+	_, err = encryptSecret("token")
+	assert.ErrorContains(t, err, "is not 32 base64 encoded bytes")
+}
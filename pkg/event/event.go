@@ -10,6 +10,10 @@ const (
 	DownloadStatusUpdate EventType = "download_status_update"
 	ExtensionUpdate      EventType = "extension_update"
 	HistoryUpdate        EventType = "history_update"
+	// Data is the list of outdated extensions
+	ExtensionUpdatesAvailable EventType = "extension_updates_available"
+	// Data is the list of episodes found by the favorite checker
+	NewEpisodes EventType = "new_episodes"
 )
 
 type Event struct {
@@ -66,3 +70,17 @@ func SendExtensionUpdate(data any) {
 		Data: data,
 	})
 }
+
+func SendExtensionUpdatesAvailable(data any) {
+	GlobalBus.Publish(Event{
+		Type: ExtensionUpdatesAvailable,
+		Data: data,
+	})
+}
+
+func SendNewEpisodes(data any) {
+	GlobalBus.Publish(Event{
+		Type: NewEpisodes,
+		Data: data,
+	})
+}
@@ -0,0 +1,139 @@
+package extharness
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/miru-project/miru-core/pkg/network"
+	"github.com/valyala/fasthttp"
+)
+
+// Fixture holds the calls to run against an extension and the http exchanges
+// recorded for them
+type Fixture struct {
+	Cases     []Case     `json:"cases"`
+	Exchanges []Exchange `json:"exchanges"`
+	lock      sync.Mutex
+}
+
+// Case is one call of an extension entry point
+type Case struct {
+	// latest, search, detail, watch, popular, tags or checkUpdate
+	Method string `json:"method"`
+	Page   int    `json:"page,omitempty"`
+	Kw     string `json:"kw,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+// Exchange is a recorded request and the response sent back for it
+type Exchange struct {
+	Method   string            `json:"method"`
+	URL      string            `json:"url"`
+	Body     string            `json:"body,omitempty"`
+	Status   int               `json:"status"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	Response string            `json:"response"`
+}
+
+func LoadFixture(path string) (*Fixture, error) {
+	data, e := os.ReadFile(path)
+	if e != nil {
+		return nil, e
+	}
+	var f Fixture
+	if e := json.Unmarshal(data, &f); e != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, e)
+	}
+	return &f, nil
+}
+
+func (f *Fixture) Save(path string) error {
+	f.lock.Lock()
+	data, e := json.MarshalIndent(f, "", "  ")
+	f.lock.Unlock()
+	if e != nil {
+		return e
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func (f *Fixture) find(method string, url string, body string) *Exchange {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for i := range f.Exchanges {
+		ex := &f.Exchanges[i]
+		if ex.Method == method && ex.URL == url && ex.Body == body {
+			return ex
+		}
+	}
+	return nil
+}
+
+// replay answers requests with the recorded exchanges, requests without one fail
+func (f *Fixture) replay(req *fasthttp.Request, res *fasthttp.Response) error {
+	method, uri, body := string(req.Header.Method()), req.URI().String(), string(req.Body())
+	ex := f.find(method, uri, body)
+	if ex == nil {
+		return fmt.Errorf("no fixture for %s %s", method, uri)
+	}
+	res.SetStatusCode(ex.Status)
+	for k, v := range ex.Headers {
+		res.Header.Set(k, v)
+	}
+	res.SetBodyString(ex.Response)
+	return nil
+}
+
+// recorder sends requests to the stand-in server at standIn instead of the
+// real host and records what it answers under the original url
+func (f *Fixture) recorder(standIn string) (network.Transport, error) {
+	base, e := url.Parse(standIn)
+	if e != nil || base.Host == "" {
+		return nil, fmt.Errorf("invalid stand-in server %q", standIn)
+	}
+	client := &fasthttp.Client{}
+	return func(req *fasthttp.Request, res *fasthttp.Response) error {
+		out := fasthttp.AcquireRequest()
+		defer fasthttp.ReleaseRequest(out)
+		req.CopyTo(out)
+		out.URI().SetScheme(base.Scheme)
+		out.URI().SetHost(base.Host)
+		if e := client.Do(out, res); e != nil {
+			return e
+		}
+
+		// Fixtures are stored as text, so keep the decoded body
+		body, e := network.ReadAll(res)
+		if e != nil {
+			return e
+		}
+		res.Header.Del("Content-Encoding")
+		res.SetBody(body)
+
+		ex := Exchange{
+			Method:   string(req.Header.Method()),
+			URL:      req.URI().String(),
+			Body:     string(req.Body()),
+			Status:   res.StatusCode(),
+			Headers:  map[string]string{},
+			Response: string(body),
+		}
+		for k, v := range res.Header.All() {
+			switch string(k) {
+			case fasthttp.HeaderContentLength, fasthttp.HeaderDate:
+				// Set again or meaningless when replaying
+			default:
+				ex.Headers[string(k)] = string(v)
+			}
+		}
+		if f.find(ex.Method, ex.URL, ex.Body) == nil {
+			f.lock.Lock()
+			f.Exchanges = append(f.Exchanges, ex)
+			f.lock.Unlock()
+		}
+		return nil
+	}, nil
+}
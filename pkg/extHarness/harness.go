@@ -0,0 +1,99 @@
+// Package extharness runs an extension file against recorded http fixtures and
+// checks its output against the proto schemas, without touching live sites
+package extharness
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/pkg/jsExtension"
+	"github.com/miru-project/miru-core/pkg/network"
+)
+
+// Options of a harness run
+type Options struct {
+	// StandIn is the base url of a local server that answers in place of the
+	// real sites, the exchanges are recorded into the fixture. Fixtures are
+	// replayed when it is empty
+	StandIn string
+}
+
+// Result of running one case
+type Result struct {
+	Case   Case
+	Output any
+	Err    error
+	// Problems found when checking the output against the proto models
+	Problems []string
+}
+
+func (r *Result) Passed() bool {
+	return r.Err == nil && len(r.Problems) == 0
+}
+
+// Setup prepares a process that only runs the harness: the base runtimes are
+// compiled from assets, and the database and cookies live in a temporary
+// directory so the ones of the user are not touched
+func Setup(assets fs.ReadFileFS) (cleanup func(), err error) {
+	dir, e := os.MkdirTemp("", "miru-harness")
+	if e != nil {
+		return nil, e
+	}
+	config.Global.Database.Driver = "sqlite3"
+	config.Global.Database.DBName = filepath.Join(dir, "harness.db")
+	network.UseCookieJar(dir)
+	jsExtension.InitBaseRuntime(assets)
+	return func() {
+		os.RemoveAll(dir)
+	}, nil
+}
+
+// Run loads extFile and runs the cases of fixture against it
+func Run(ctx context.Context, extFile string, fixture *Fixture, opt Options) ([]Result, error) {
+	if opt.StandIn != "" {
+		record, e := fixture.recorder(opt.StandIn)
+		if e != nil {
+			return nil, e
+		}
+		network.SetTransport(record)
+	} else {
+		network.SetTransport(fixture.replay)
+	}
+	defer network.SetTransport(nil)
+
+	api, e := jsExtension.LoadFile(extFile)
+	if e != nil {
+		return nil, e
+	}
+
+	results := make([]Result, len(fixture.Cases))
+	for i, c := range fixture.Cases {
+		results[i] = runCase(ctx, api.Ext, c)
+	}
+	return results, nil
+}
+
+func runCase(ctx context.Context, ext *jsExtension.Ext, c Case) Result {
+	res := Result{Case: c}
+	var args []any
+	switch c.Method {
+	case "latest", "popular":
+		args = []any{c.Page}
+	case "search":
+		args = []any{c.Kw, c.Page, nil}
+	case "detail", "watch", "tags", "checkUpdate":
+		args = []any{c.URL}
+	default:
+		res.Err = fmt.Errorf("unknown method %q", c.Method)
+		return res
+	}
+	res.Output, res.Err = jsExtension.Invoke(ctx, ext.Pkg, c.Method, args...)
+	if res.Err == nil {
+		res.Problems = jsExtension.ValidateResult(ext, c.Method, res.Output)
+	}
+	return res
+}
@@ -0,0 +1,97 @@
+package extharness
+
+import (
+	"context"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testExtension = `// @name Harness
+// @version 1.0.0
+// @package test.harness.site
+// @webSite http://site.test
+// @apiVersion 2
+// @type bangumi
+
+async function latest(page) {
+  const res = await Miru.request("/latest?page=" + page, {});
+  return res.items.map((item) => ({ title: item.name, url: "/detail/" + item.id }));
+}
+
+async function detail(url) {
+  const res = await Miru.request(url, {});
+  return { title: res.name, episodes: [{ title: "main", urls: res.episodes }], rating: 5 };
+}
+`
+
+func setupHarness(t *testing.T) {
+	t.Helper()
+	cleanup, err := Setup(os.DirFS("../../binary").(fs.ReadFileFS))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	setupHarness(t)
+	extFile := filepath.Join(t.TempDir(), "test.harness.site.js")
+	assert.NoError(t, os.WriteFile(extFile, []byte(testExtension), 0644))
+
+	standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/latest":
+			w.Write([]byte(`{"items": [{"id": 1, "name": "First"}, {"id": 2, "name": "Second"}]}`))
+		case "/detail/1":
+			w.Write([]byte(`{"name": "First", "episodes": [{"name": "Ep 1", "url": "/watch/1"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	fixture := &Fixture{Cases: []Case{
+		{Method: "latest", Page: 1},
+		{Method: "detail", URL: "/detail/1"},
+	}}
+	recorded, err := Run(context.Background(), extFile, fixture, Options{StandIn: standIn.URL})
+	standIn.Close()
+	assert.NoError(t, err)
+	if assert.Len(t, recorded, 2) {
+		assert.True(t, recorded[0].Passed(), recorded[0])
+		assert.Len(t, recorded[0].Output, 2)
+		// rating is not part of ExtensionDetail
+		assert.False(t, recorded[1].Passed())
+		assert.Contains(t, recorded[1].Problems[0], "rating")
+	}
+	if assert.Len(t, fixture.Exchanges, 2) {
+		assert.Equal(t, "http://site.test/latest?page=1", fixture.Exchanges[0].URL)
+		assert.Equal(t, "application/json", fixture.Exchanges[0].Headers["Content-Type"])
+	}
+
+	fixturePath := filepath.Join(t.TempDir(), "test.harness.site.fixture.json")
+	assert.NoError(t, fixture.Save(fixturePath))
+	loaded, err := LoadFixture(fixturePath)
+	assert.NoError(t, err)
+
+	// The stand-in server is gone, everything comes from the fixture now
+	replayed, err := Run(context.Background(), extFile, loaded, Options{})
+	assert.NoError(t, err)
+	if assert.Len(t, replayed, 2) {
+		assert.Equal(t, recorded[0].Output, replayed[0].Output)
+		assert.Equal(t, recorded[1].Output, replayed[1].Output)
+	}
+
+	loaded.Cases = []Case{{Method: "latest", Page: 2}}
+	missing, err := Run(context.Background(), extFile, loaded, Options{})
+	assert.NoError(t, err)
+	if assert.Len(t, missing, 1) {
+		assert.ErrorContains(t, missing[0].Err, "no fixture for GET http://site.test/latest?page=2")
+	}
+}
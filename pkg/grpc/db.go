@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/miru-project/miru-core/ent"
@@ -175,6 +176,36 @@ func (s *MiruCoreServer) GetFavoriteGroupsByFavorite(ctx context.Context, req *p
 	return &proto.GetFavoriteGroupsByFavoriteResponse{Groups: protoGroups}, nil
 }
 
+func (s *MiruCoreServer) SetFavoriteGroupCheckInterval(ctx context.Context, req *proto.SetFavoriteGroupCheckIntervalRequest) (*proto.SetFavoriteGroupCheckIntervalResponse, error) {
+	var minutes *int
+	if req.Minutes != nil {
+		if *req.Minutes < 0 {
+			return nil, errors.New("check interval must not be negative")
+		}
+		m := int(*req.Minutes)
+		minutes = &m
+	}
+	if err := db.SetFavoriteGroupCheckInterval(req.Name, minutes); err != nil {
+		return nil, err
+	}
+	return &proto.SetFavoriteGroupCheckIntervalResponse{Message: "Success"}, nil
+}
+
+func (s *MiruCoreServer) GetEpisodeUpdates(ctx context.Context, req *proto.GetEpisodeUpdatesRequest) (*proto.GetEpisodeUpdatesResponse, error) {
+	updates, err := db.GetEpisodeUpdates(req.Package, req.DetailUrl)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetEpisodeUpdatesResponse{Episodes: toProtoEpisodeUpdates(updates)}, nil
+}
+
+func (s *MiruCoreServer) ClearEpisodeUpdates(ctx context.Context, req *proto.ClearEpisodeUpdatesRequest) (*proto.ClearEpisodeUpdatesResponse, error) {
+	if err := db.DeleteEpisodeUpdates(req.Package, req.DetailUrl); err != nil {
+		return nil, err
+	}
+	return &proto.ClearEpisodeUpdatesResponse{Message: "Success"}, nil
+}
+
 // DB - History
 func (s *MiruCoreServer) GetHistoriesByType(ctx context.Context, req *proto.GetHistoriesByTypeRequest) (*proto.GetHistoriesByTypeResponse, error) {
 	histories, err := db.GetHistoriesByType(&req.Type, int(req.Page), int(req.PageSize))
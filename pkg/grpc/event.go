@@ -38,19 +38,25 @@ func (s *MiruCoreServer) WatchEvents(req *proto.WatchEventsRequest, stream proto
 				for i, ea := range exts {
 					e := ea.Ext
 					protoExtMeta[i] = &proto.ExtensionMeta{
-						Name:        e.Name,
-						Version:     e.Version,
-						Author:      e.Author,
-						License:     e.License,
-						Lang:        e.Lang,
-						Icon:        e.Icon,
-						Package:     e.Pkg,
-						WebSite:     e.Website,
-						Description: e.Description,
-						Tags:        e.Tags,
-						Api:         e.ApiVersion,
-						Error:       e.Error,
-						Type:        e.WatchType,
+						Name:               e.Name,
+						Version:            e.Version,
+						Author:             e.Author,
+						License:            e.License,
+						Lang:               e.Lang,
+						Icon:               e.Icon,
+						Package:            e.Pkg,
+						WebSite:            e.Website,
+						Description:        e.Description,
+						Tags:               e.Tags,
+						Api:                e.ApiVersion,
+						Error:              e.Error,
+						Type:               e.WatchType,
+						Verification:       toProtoVerification(e.Verification),
+						FailedVerification: toProtoVerification(e.FailedVerification),
+						Disabled:           e.Disabled,
+						PinnedVersion:      e.Pinned,
+						Methods:            e.Methods,
+						BlockedDomains:     e.BlockedDomains,
 					}
 				}
 				resp = &proto.WatchEventsResponse{
@@ -60,6 +66,24 @@ func (s *MiruCoreServer) WatchEvents(req *proto.WatchEventsRequest, stream proto
 						},
 					},
 				}
+			case event.ExtensionUpdatesAvailable:
+				updates := e.Data.([]jsExtension.ExtensionUpdate)
+				resp = &proto.WatchEventsResponse{
+					Event: &proto.WatchEventsResponse_ExtensionUpdatesEvent{
+						ExtensionUpdatesEvent: &proto.ExtensionUpdatesEvent{
+							Updates: toProtoUpdates(updates),
+						},
+					},
+				}
+			case event.NewEpisodes:
+				updates := e.Data.([]*ent.EpisodeUpdate)
+				resp = &proto.WatchEventsResponse{
+					Event: &proto.WatchEventsResponse_NewEpisodesEvent{
+						NewEpisodesEvent: &proto.NewEpisodesEvent{
+							Episodes: toProtoEpisodeUpdates(updates),
+						},
+					},
+				}
 			case event.HistoryUpdate:
 				history := e.Data.([]*ent.History)
 				protoHistory := make([]*proto.History, len(history))
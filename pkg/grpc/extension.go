@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/miru-project/miru-core/ent/extensionsetting"
 	"github.com/miru-project/miru-core/pkg/db"
 	"github.com/miru-project/miru-core/pkg/jsExtension"
 	"github.com/miru-project/miru-core/proto/generate/proto"
@@ -13,7 +15,7 @@ import (
 )
 
 func (s *MiruCoreServer) Search(ctx context.Context, req *proto.SearchRequest) (*proto.SearchResponse, error) {
-	res := handler.Search(strconv.Itoa(int(req.Page)), req.Pkg, req.Kw, req.Filter)
+	res := handler.Search(ctx, strconv.Itoa(int(req.Page)), req.Pkg, req.Kw, req.Filter)
 	if res.Code != 200 {
 		return nil, fmt.Errorf("search failed with code %d: %s", res.Code, res.Message)
 	}
@@ -22,7 +24,7 @@ func (s *MiruCoreServer) Search(ctx context.Context, req *proto.SearchRequest) (
 }
 
 func (s *MiruCoreServer) CreateFilter(ctx context.Context, req *proto.CreateFilterRequest) (*proto.CreateFilterResponse, error) {
-	res := handler.CreateFilter(req.Pkg, req.Filter)
+	res := handler.CreateFilter(ctx, req.Pkg, req.Filter)
 	if res.Code != 200 {
 		return nil, fmt.Errorf("create filter failed with code %d: %s", res.Code, res.Message)
 	}
@@ -31,7 +33,7 @@ func (s *MiruCoreServer) CreateFilter(ctx context.Context, req *proto.CreateFilt
 }
 
 func (s *MiruCoreServer) Latest(ctx context.Context, req *proto.LatestRequest) (*proto.LatestResponse, error) {
-	res := handler.Latest(strconv.Itoa(int(req.Page)), req.Pkg)
+	res := handler.Latest(ctx, strconv.Itoa(int(req.Page)), req.Pkg)
 	if res.Code != 200 {
 		return nil, fmt.Errorf("latest failed with code %d: %s", res.Code, res.Message)
 	}
@@ -42,8 +44,42 @@ func (s *MiruCoreServer) Latest(ctx context.Context, req *proto.LatestRequest) (
 	return &proto.LatestResponse{Items: res.Data}, nil
 }
 
+func (s *MiruCoreServer) Popular(ctx context.Context, req *proto.PopularRequest) (*proto.PopularResponse, error) {
+	res := handler.Popular(ctx, strconv.Itoa(int(req.Page)), req.Pkg)
+	if res.Code != 200 {
+		return nil, fmt.Errorf("popular failed with code %d: %s", res.Code, res.Message)
+	}
+	if res.Data == nil {
+		return &proto.PopularResponse{}, nil
+	}
+
+	return &proto.PopularResponse{Items: res.Data}, nil
+}
+
+func (s *MiruCoreServer) Tags(ctx context.Context, req *proto.TagsRequest) (*proto.TagsResponse, error) {
+	res := handler.Tags(ctx, req.Pkg, req.Url)
+	if res.Code != 200 {
+		return nil, fmt.Errorf("tags failed with code %d: %s", res.Code, res.Message)
+	}
+
+	tags := make([]string, len(res.Data))
+	for i, tag := range res.Data {
+		tags[i] = *tag
+	}
+	return &proto.TagsResponse{Tags: tags}, nil
+}
+
+func (s *MiruCoreServer) CheckUpdate(ctx context.Context, req *proto.CheckUpdateRequest) (*proto.CheckUpdateResponse, error) {
+	res := handler.CheckUpdate(ctx, req.Pkg, req.Url)
+	if res.Code != 200 {
+		return nil, fmt.Errorf("check update failed with code %d: %s", res.Code, res.Message)
+	}
+
+	return &proto.CheckUpdateResponse{Update: *res.Data}, nil
+}
+
 func (s *MiruCoreServer) Detail(ctx context.Context, req *proto.DetailRequest) (*proto.DetailResponse, error) {
-	res := handler.Detail(req.Pkg, req.Url)
+	res := handler.Detail(ctx, req.Pkg, req.Url)
 	if res.Code != 200 {
 		return nil, fmt.Errorf("detail failed with code %d: %s", res.Code, res.Message)
 	}
@@ -52,7 +88,7 @@ func (s *MiruCoreServer) Detail(ctx context.Context, req *proto.DetailRequest) (
 }
 
 func (s *MiruCoreServer) Watch(ctx context.Context, req *proto.WatchRequest) (*proto.WatchResponse, error) {
-	res, api := handler.Watch(req.Pkg, req.Url)
+	res, api := handler.Watch(ctx, req.Pkg, req.Url)
 	if res.Code != 200 {
 		return nil, fmt.Errorf("watch failed with code %d: %s", res.Code, res.Message)
 	}
@@ -61,7 +97,7 @@ func (s *MiruCoreServer) Watch(ctx context.Context, req *proto.WatchRequest) (*p
 
 	// If it's V1, we return the specialized watch objects
 	switch api.Ext.ApiVersion {
-	case "2":
+	case "2", "3":
 		// V2 returns the generic ExtensionWatch which contains mirrors
 		data, err := jsExtension.Unmarshal[proto.ExtensionWatch](res.Data)
 		if err != nil {
@@ -98,7 +134,7 @@ func (s *MiruCoreServer) Watch(ctx context.Context, req *proto.WatchRequest) (*p
 }
 
 func (s *MiruCoreServer) Mirror(ctx context.Context, req *proto.MirrorRequest) (*proto.MirrorResponse, error) {
-	res, err := jsExtension.Mirror(req.Pkg, req.Url)
+	res, err := jsExtension.Mirror(ctx, req.Pkg, req.Url)
 	if err != nil {
 		return nil, err
 	}
@@ -165,12 +201,18 @@ func (s *MiruCoreServer) GetExtensionSettings(ctx context.Context, req *proto.Ge
 
 	protoSettings := make([]*proto.ExtensionSetting, len(settings))
 	for i, s := range settings {
+		value := s.Value
+		// Secrets are only readable by the extension, set ones are masked
+		if s.DbType == extensionsetting.DbTypeSecret && value != nil && *value != "" {
+			mask := db.SecretMask
+			value = &mask
+		}
 		protoSettings[i] = &proto.ExtensionSetting{
 			Id:           int32(s.ID),
 			Package:      s.Package,
 			Title:        s.Title,
 			Key:          s.Key,
-			Value:        s.Value,
+			Value:        value,
 			DefaultValue: safeSprint(s.DefaultValue),
 			Type:         proto.ExtensionSettingType(proto.ExtensionSettingType_value[string(s.DbType)]),
 			Description:  s.Description,
@@ -183,6 +225,10 @@ func (s *MiruCoreServer) GetExtensionSettings(ctx context.Context, req *proto.Ge
 
 func (s *MiruCoreServer) SaveExtensionSettings(ctx context.Context, req *proto.SaveExtensionSettingsRequest) (*proto.SaveExtensionSettingsResponse, error) {
 	for _, s := range req.Settings {
+		// Unchanged settings keep their value, masked secrets can't be sent back
+		if s.Unchanged {
+			continue
+		}
 		val := ""
 		if s.Value != nil {
 			val = *s.Value
@@ -194,3 +240,156 @@ func (s *MiruCoreServer) SaveExtensionSettings(ctx context.Context, req *proto.S
 	}
 	return &proto.SaveExtensionSettingsResponse{Message: "Success"}, nil
 }
+
+func (s *MiruCoreServer) GrantExtensionDomains(ctx context.Context, req *proto.GrantExtensionDomainsRequest) (*proto.GrantExtensionDomainsResponse, error) {
+	domains, err := jsExtension.GrantDomains(req.Pkg, req.Domains)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GrantExtensionDomainsResponse{Domains: domains}, nil
+}
+
+func (s *MiruCoreServer) RevokeExtensionDomains(ctx context.Context, req *proto.RevokeExtensionDomainsRequest) (*proto.RevokeExtensionDomainsResponse, error) {
+	domains, err := jsExtension.RevokeDomains(req.Pkg, req.Domains)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RevokeExtensionDomainsResponse{Domains: domains}, nil
+}
+
+func (s *MiruCoreServer) TailExtensionLogs(req *proto.TailExtensionLogsRequest, stream proto.ExtensionService_TailExtensionLogsServer) error {
+	backlog, ch, cancel := jsExtension.TailLogs(req.Pkg)
+	defer cancel()
+
+	for _, entry := range backlog {
+		if err := stream.Send(toProtoLogEntry(entry)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case entry := <-ch:
+			if err := stream.Send(toProtoLogEntry(entry)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *MiruCoreServer) GetExtensionLogs(ctx context.Context, req *proto.GetExtensionLogsRequest) (*proto.GetExtensionLogsResponse, error) {
+	logs := jsExtension.ExtensionLogs(req.Pkg)
+	entries := make([]*proto.ExtensionLogEntry, len(logs))
+	for i, entry := range logs {
+		entries[i] = toProtoLogEntry(entry)
+	}
+	return &proto.GetExtensionLogsResponse{Entries: entries}, nil
+}
+
+func toProtoLogEntry(entry jsExtension.LogEntry) *proto.ExtensionLogEntry {
+	return &proto.ExtensionLogEntry{
+		Pkg:     entry.Pkg,
+		Time:    entry.Time.Format(time.RFC3339Nano),
+		Level:   entry.Level,
+		Message: entry.Message,
+	}
+}
+
+func (s *MiruCoreServer) CheckExtensionUpdates(ctx context.Context, req *proto.CheckExtensionUpdatesRequest) (*proto.CheckExtensionUpdatesResponse, error) {
+	updates, repoErrs, err := jsExtension.CheckUpdates()
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.CheckExtensionUpdatesResponse{RepoErrors: toProtoErrors(repoErrs)}
+	for _, list := range updates {
+		resp.Updates = append(resp.Updates, toProtoUpdates(list)...)
+	}
+	return resp, nil
+}
+
+func (s *MiruCoreServer) CheckFavoriteUpdates(ctx context.Context, req *proto.CheckFavoriteUpdatesRequest) (*proto.CheckFavoriteUpdatesResponse, error) {
+	updates, err := jsExtension.CheckFavorites(ctx, req.Force)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CheckFavoriteUpdatesResponse{Episodes: toProtoEpisodeUpdates(updates)}, nil
+}
+
+func (s *MiruCoreServer) UpgradeExtension(ctx context.Context, req *proto.UpgradeExtensionRequest) (*proto.UpgradeExtensionResponse, error) {
+	update, err := jsExtension.UpgradeExtension(req.RepoUrl, req.Pkg)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.UpgradeExtensionResponse{Upgraded: toProtoUpdate(*update)}, nil
+}
+
+func (s *MiruCoreServer) UpgradeAllExtensions(ctx context.Context, req *proto.UpgradeAllExtensionsRequest) (*proto.UpgradeAllExtensionsResponse, error) {
+	applied, failed, err := jsExtension.UpgradeAll(req.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.UpgradeAllExtensionsResponse{Upgraded: toProtoUpdates(applied), Failed: toProtoErrors(failed)}, nil
+}
+
+func (s *MiruCoreServer) RollbackExtension(ctx context.Context, req *proto.RollbackExtensionRequest) (*proto.RollbackExtensionResponse, error) {
+	api, err := jsExtension.RollbackExtension(req.Pkg)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RollbackExtensionResponse{Version: api.Ext.Version}, nil
+}
+
+func (s *MiruCoreServer) SetExtensionEnabled(ctx context.Context, req *proto.SetExtensionEnabledRequest) (*proto.SetExtensionEnabledResponse, error) {
+	if _, err := jsExtension.SetExtensionEnabled(req.Pkg, req.Enabled); err != nil {
+		return nil, err
+	}
+	return &proto.SetExtensionEnabledResponse{Message: "Success"}, nil
+}
+
+func (s *MiruCoreServer) PinExtension(ctx context.Context, req *proto.PinExtensionRequest) (*proto.PinExtensionResponse, error) {
+	if _, err := jsExtension.PinExtension(req.Pkg, req.Version); err != nil {
+		return nil, err
+	}
+	return &proto.PinExtensionResponse{Message: "Success"}, nil
+}
+
+func toProtoUpdate(update jsExtension.ExtensionUpdate) *proto.ExtensionUpdateInfo {
+	return &proto.ExtensionUpdateInfo{
+		RepoUrl:          update.Repo,
+		Pkg:              update.Pkg,
+		Name:             update.Name,
+		InstalledVersion: update.Installed,
+		AvailableVersion: update.Available,
+	}
+}
+
+func toProtoUpdates(updates []jsExtension.ExtensionUpdate) []*proto.ExtensionUpdateInfo {
+	res := make([]*proto.ExtensionUpdateInfo, len(updates))
+	for i, update := range updates {
+		res[i] = toProtoUpdate(update)
+	}
+	return res
+}
+
+func toProtoErrors(errs map[string]error) map[string]string {
+	res := make(map[string]string, len(errs))
+	for key, err := range errs {
+		res[key] = err.Error()
+	}
+	return res
+}
+
+func toProtoVerification(v *jsExtension.Verification) *proto.ExtensionVerification {
+	if v == nil {
+		return nil
+	}
+	return &proto.ExtensionVerification{
+		Status:  v.Status,
+		RepoUrl: v.Repo,
+		Hash:    v.Hash,
+		Key:     v.Key,
+		Reason:  v.Reason,
+		Time:    v.Time.Format(time.RFC3339),
+	}
+}
@@ -45,19 +45,25 @@ func (s *MiruCoreServer) HelloMiru(ctx context.Context, req *proto.HelloMiruRequ
 	protoExtMeta := make([]*proto.ExtensionMeta, len(extMeta))
 	for i, e := range extMeta {
 		protoExtMeta[i] = &proto.ExtensionMeta{
-			Name:        e.Name,
-			Version:     e.Version,
-			Author:      e.Author,
-			License:     e.License,
-			Lang:        e.Lang,
-			Icon:        e.Icon,
-			Package:     e.Pkg,
-			WebSite:     e.Website,
-			Description: e.Description,
-			Tags:        e.Tags,
-			Api:         e.ApiVersion,
-			Error:       e.Error,
-			Type:        e.WatchType,
+			Name:               e.Name,
+			Version:            e.Version,
+			Author:             e.Author,
+			License:            e.License,
+			Lang:               e.Lang,
+			Icon:               e.Icon,
+			Package:            e.Pkg,
+			WebSite:            e.Website,
+			Description:        e.Description,
+			Tags:               e.Tags,
+			Api:                e.ApiVersion,
+			Error:              e.Error,
+			Type:               e.WatchType,
+			Verification:       toProtoVerification(e.Verification),
+			FailedVerification: toProtoVerification(e.FailedVerification),
+			Disabled:           e.Disabled,
+			PinnedVersion:      e.Pinned,
+			Methods:            e.Methods,
+			BlockedDomains:     e.BlockedDomains,
 		}
 	}
 
@@ -194,7 +200,38 @@ func toProtoFavoriteGroup(g *ent.FavoriteGroup) *proto.FavoriteGroup {
 		Name:      g.Name,
 		Date:      g.Date.Format(time.RFC3339),
 		Favorites: favs,
+		CheckInterval: func() *int32 {
+			if g.CheckInterval == nil {
+				return nil
+			}
+			v := int32(*g.CheckInterval)
+			return &v
+		}(),
+	}
+}
+
+func toProtoEpisodeUpdate(u *ent.EpisodeUpdate) *proto.EpisodeUpdate {
+	if u == nil {
+		return &proto.EpisodeUpdate{}
+	}
+	return &proto.EpisodeUpdate{
+		Id:         int32(u.ID),
+		Package:    u.Package,
+		DetailUrl:  u.DetailURL,
+		Title:      u.Title,
+		GroupTitle: u.GroupTitle,
+		Name:       u.Name,
+		Url:        u.URL,
+		Date:       u.Date.Format(time.RFC3339),
+	}
+}
+
+func toProtoEpisodeUpdates(updates []*ent.EpisodeUpdate) []*proto.EpisodeUpdate {
+	protoUpdates := make([]*proto.EpisodeUpdate, len(updates))
+	for i, u := range updates {
+		protoUpdates[i] = toProtoEpisodeUpdate(u)
 	}
+	return protoUpdates
 }
 
 func toProtoHistory(h *ent.History) *proto.History {
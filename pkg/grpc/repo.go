@@ -33,6 +33,14 @@ func (s *MiruCoreServer) DeleteRepo(ctx context.Context, req *proto.DeleteRepoRe
 	return &proto.DeleteRepoResponse{Message: "Success"}, nil
 }
 
+func (s *MiruCoreServer) SetRepoTrustedKeys(ctx context.Context, req *proto.SetRepoTrustedKeysRequest) (*proto.SetRepoTrustedKeysResponse, error) {
+	err := jsExtension.SetRepoTrustedKeys(req.RepoUrl, req.TrustedKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.SetRepoTrustedKeysResponse{Message: "Success"}, nil
+}
+
 func (s *MiruCoreServer) FetchRepoList(ctx context.Context, req *proto.FetchRepoListRequest) (*proto.FetchRepoListResponse, error) {
 	repoList, _, err := jsExtension.FetchExtensionRepo()
 	if err != nil {
@@ -1,89 +1,207 @@
 package jsExtension
 
 import (
-	"encoding/base64"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/dop251/goja"
-	"github.com/dop251/goja_nodejs/eventloop"
 	"github.com/miru-project/miru-core/pkg/db"
 	log "github.com/miru-project/miru-core/pkg/logger"
 	"github.com/miru-project/miru-core/pkg/network"
 )
 
-func AsyncCallBack(api *ExtApi, pkg string, evalStr string) (any, error) {
-	// Lock to prevent multiple calls to the same extension
-	api.lock.Lock()
-	defer api.lock.Unlock()
+func AsyncCallBack(ctx context.Context, api *ExtApi, pkg string, method string, args ...any) (any, error) {
+	if api.Ext.Disabled {
+		return nil, fmt.Errorf("extension %s is disabled by the user", pkg)
+	}
+	if api.pool == nil {
+		return nil, fmt.Errorf("extension %s is not loaded: %s", pkg, api.Ext.Error)
+	}
+	if api.disabled.Load() {
+		return nil, fmt.Errorf("extension %s is disabled: %s", pkg, api.Ext.Error)
+	}
+	if m := api.Ext.Manifest; m != nil && !m.declares(method) {
+		return nil, fmt.Errorf("extension %s does not declare %s in its manifest", pkg, method)
+	}
 
 	ApiPkgCache.Store(pkg, api)
 
-	var loop *eventloop.EventLoop
+	ctx, cancel := withCallTimeout(ctx)
+	defer cancel()
 
-	// check extension does  contain eventloop runtime
-	lop, eventLoopIsExist := extMemMap.Load(pkg)
-	if eventLoopIsExist {
-		loop = lop.(*eventloop.EventLoop)
-	} else {
-		api.initRuntimeV1(pkg)
-		lop, _ = extMemMap.Load(pkg)
-		loop = lop.(*eventloop.EventLoop)
+	// Take a free runtime so calls to the same extension don't wait on each other
+	rt, e := api.pool.acquire(ctx)
+	if e != nil {
+		return nil, e
 	}
+	o, e := rt.call(ctx, pkg, api.invoker(method, args...))
+	var timeout *ExtTimeoutError
+	var budget *BudgetError
+	switch {
+	case errors.As(e, &budget):
+		api.pool.discard(rt)
+		api.overBudget(budget)
+	case errors.As(e, &timeout):
+		api.pool.discard(rt)
+	default:
+		api.pool.release(rt)
+		api.strikes.Store(0)
+	}
+	return o, e
+}
+
+// call runs fn on the runtime and waits until the value it returns settles
+func (rt *extRuntime) call(ctx context.Context, pkg string, fn func(vm *goja.Runtime) (goja.Value, error)) (any, error) {
+	loop := rt.loop
 	loop.Stop()
-	res := make(chan PromiseResult)
-	defer close(res)
+	// Budget violations abort the call with the violation as the cause
+	ctx, abort := context.WithCancelCause(ctx)
+	defer abort(nil)
+	res := make(chan PromiseResult, 1)
 	loop.RunOnLoop(func(vm *goja.Runtime) {
-		o, e := vm.RunString(evalStr)
-		handlePromise(o, res, e)
+		// A previous call may have been interrupted after it stopped running js
+		vm.ClearInterrupt()
+		rt.job.ctx = ctx
+		rt.job.abort = abort
+		o, e := fn(vm)
+		handlePromise(vm, o, res, e)
 	})
 	loop.Start()
 	defer loop.StopNoWait()
-	result := <-res
-	// handle error from PromiseResult{err: e}
-	if result.err != nil {
-		var zero any
-		return zero, result.err
-	}
-	// handle result when Promise has established
-	o, e := await(result.promise)
+	stop := rt.watch(pkg, rt.vm, abort)
+	defer stop()
+
+	// The result is pushed from the event loop once the promise settles
+	o, e := await(ctx, res)
+	if e != nil && e == ctx.Err() {
+		var budget *BudgetError
+		if errors.As(context.Cause(ctx), &budget) {
+			rt.vm.Interrupt(budget)
+			e = budget
+		} else {
+			e = rt.interrupt(pkg, ctx)
+		}
+	}
+	if e != nil {
+		// Errors thrown out of the extension end up in its log
+		writeLog(pkg, LogLevelException, e.Error())
+	}
 	return o, e
 }
 
+// jsonArg is an argument holding JSON text, it is decoded with JSON.parse inside the runtime
+type jsonArg string
+
+// invoker looks up an extension entry point and calls it with args converted
+// by vm.ToValue, nothing passed in by the caller is evaluated as code
+func (api *ExtApi) invoker(method string, args ...any) func(vm *goja.Runtime) (goja.Value, error) {
+	return func(vm *goja.Runtime) (goja.Value, error) {
+		this := goja.Undefined()
+		scope := vm.GlobalObject()
+		// V1 entry points are methods of the ext instance
+		if api.receiver != "" {
+			obj := vm.Get(api.receiver)
+			if obj == nil || goja.IsUndefined(obj) || goja.IsNull(obj) {
+				return nil, fmt.Errorf("extension %s has no %s instance", api.Ext.Pkg, api.receiver)
+			}
+			scope = obj.ToObject(vm)
+			this = scope
+		}
+		fn, ok := goja.AssertFunction(scope.Get(method))
+		if !ok {
+			return nil, fmt.Errorf("extension %s does not implement %s", api.Ext.Pkg, method)
+		}
+		values := make([]goja.Value, len(args))
+		for i, arg := range args {
+			v, e := toJsValue(vm, arg)
+			if e != nil {
+				return nil, e
+			}
+			values[i] = v
+		}
+		return fn(this, values...)
+	}
+}
+
+func toJsValue(vm *goja.Runtime, arg any) (goja.Value, error) {
+	text, ok := arg.(jsonArg)
+	if !ok {
+		return vm.ToValue(arg), nil
+	}
+	parse, _ := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("parse"))
+	return parse(goja.Undefined(), vm.ToValue(string(text)))
+}
+
+// withCallTimeout applies DefaultCallTimeout to contexts that carry no deadline
+func withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, DefaultCallTimeout)
+}
+
+// requireCapability throws in the runtime when an API v3 extension uses a host
+// api its manifest does not ask for, older extensions are not restricted
+func (api *ExtApi) requireCapability(vm *goja.Runtime, capability string) {
+	m := api.Ext.Manifest
+	if m == nil {
+		return
+	}
+	granted := false
+	switch capability {
+	case "settings":
+		granted = m.Capabilities.Settings
+	case "cookies":
+		granted = m.Capabilities.Cookies
+	case "storage":
+		granted = m.Capabilities.Storage
+	}
+	if !granted {
+		panic(vm.NewGoError(fmt.Errorf("extension %s does not declare the %s capability", api.Ext.Pkg, capability)))
+	}
+}
+
 func (api *ExtApi) setFunction(vm *goja.Runtime, name string, fn any) {
 	if e := vm.Set(name, fn); e != nil {
 		log.Println("Error setting function:", api.Ext.Pkg, name, e)
 	}
 }
 
-func (api *ExtApi) initRuntimeV1(pkg string) {
-
-	ApiPkgCache.Store(pkg, api)
-	loop := eventloop.NewEventLoop(
-		eventloop.WithRegistry(sharedRegistry),
-	)
+func (api *ExtApi) initRuntimeV1(pkg string) (*extRuntime, error) {
 
-	if api == nil || api.service.program == nil {
+	if api == nil || api.service == nil || api.service.program == nil {
 		ApiPkgCache.SetError(pkg, fmt.Sprintf("extension %s not found", pkg))
+		return nil, fmt.Errorf("extension %s not found", pkg)
 	}
+	rt := newExtRuntime(pkg)
+	loop := rt.loop
+	var initErr error
+
 	loop.RunOnLoop(func(vm *goja.Runtime) {
 
 		defer func() {
 			if r := recover(); r != nil {
 				if err, ok := r.(error); ok {
-					ApiPkgCache.SetError(pkg, err.Error())
+					initErr = err
 					return
 				}
+				initErr = fmt.Errorf("unknown panic: %v", r)
 				log.Print("Unknown panic:", r)
 			}
 		}()
 
-		var job = Job{loop: loop}
+		rt.vm = vm
+		// Scripts that never finish their top level code are stopped like calls
+		defer rt.watch(pkg, vm, nil)()
 		// Run the program for the  first time
 		reg := sharedRegistry.Enable(vm)
-		api.service.addModule(reg, vm, &job)
+		api.service.addModule(reg, vm, rt.job)
 		// eval base runtime
 		if _, e := vm.RunProgram(baseV1); e != nil {
 			log.Println("Error running base script:", e)
@@ -95,29 +213,37 @@ func (api *ExtApi) initRuntimeV1(pkg string) {
 			panic(e)
 		}
 		// Initialize the Ext class
-		_, e := vm.RunString(fmt.Sprintf(`
-			ext = new globalThis.Ext("%s");
-			`, api.Ext.Website))
-
+		ctor, ok := goja.AssertConstructor(vm.Get("Ext"))
+		if !ok {
+			panic(errors.New("extension does not declare a class extending Extension"))
+		}
+		ext, e := ctor(nil, vm.ToValue(api.Ext.Website))
 		if e != nil {
 			panic(e)
 		}
+		vm.Set("ext", ext)
+		api.detectMethods(ext.ToObject(vm), entryPointStubs(vm.Get("Extension").ToObject(vm).Get("prototype").ToObject(vm)))
 
-		api.registerFunction(vm, job)
+		api.registerFunction(vm, rt.job)
 
 	})
 	loop.Start()
-	defer loop.Stop()
+	loop.Stop()
 
-	extMemMap.Store(pkg, loop)
+	if initErr != nil {
+		ApiPkgCache.SetError(pkg, initErr.Error())
+		return nil, initErr
+	}
+	return rt, nil
 }
 
-func (api *ExtApi) registerFunction(vm *goja.Runtime, job Job) {
+func (api *ExtApi) registerFunction(vm *goja.Runtime, job *Job) {
 	pkg := api.Ext.Pkg
 	// api.setFunction(vm, `println`, func(args ...any) {
 	// 	log.Println(args...)
 	// })
 	api.setFunction(vm, "registerSetting", func(call goja.FunctionCall) goja.Value {
+		api.requireCapability(vm, "settings")
 
 		val := call.Argument(0).ToObject(vm).Export()
 		value, ok := val.(map[string]any)
@@ -132,21 +258,41 @@ func (api *ExtApi) registerFunction(vm *goja.Runtime, job Job) {
 	})
 
 	api.setFunction(vm, "getSetting", func(call goja.FunctionCall) goja.Value {
+		api.requireCapability(vm, "settings")
 		key := call.Argument(0).ToString().String()
-		setting, e := db.GetSetting(pkg, key)
+		value, e := db.GetSettingValue(pkg, key)
 		if e != nil {
 			panic(vm.ToValue(errors.New("Error getting setting:" + e.Error())))
 		}
-		if setting == nil {
+		if value == nil {
 			return nil
 		}
-		return vm.ToValue(setting.Value)
+		return vm.ToValue(*value)
 	})
 
-	api.setFunction(vm, "setSetting", func(call goja.FunctionCall) any {
-		pkg := call.Argument(0).ToString().String()
-		key := call.Argument(1).ToString().String()
-		value := call.Argument(2).ToString().String()
+	// setSetting(key, value) changes a setting of the calling extension. Older
+	// scripts pass their package first, which has to be their own
+	api.setFunction(vm, "setSetting", func(call goja.FunctionCall) goja.Value {
+		api.requireCapability(vm, "settings")
+		args := call.Arguments
+		if len(args) > 2 {
+			if owner := args[0].String(); owner != pkg {
+				panic(vm.NewGoError(fmt.Errorf("extension %s can't change the settings of %s", pkg, owner)))
+			}
+			args = args[1:]
+		}
+		if len(args) < 2 {
+			panic(vm.NewTypeError("setSetting expects a key and a value"))
+		}
+		key := args[0].String()
+		value := args[1].String()
+		if obj, ok := args[1].(*goja.Object); ok && obj.ClassName() == "Array" {
+			b, e := json.Marshal(obj.Export())
+			if e != nil {
+				panic(vm.NewGoError(e))
+			}
+			value = string(b)
+		}
 		e := db.SetSetting(pkg, key, value)
 		if e != nil {
 			panic(vm.ToValue(errors.New("Error setting setting:" + e.Error())))
@@ -154,17 +300,25 @@ func (api *ExtApi) registerFunction(vm *goja.Runtime, job Job) {
 		return nil
 	})
 
-	api.setFunction(vm, "getCookies", func(call goja.FunctionCall) any {
+	api.setFunction(vm, "getCookies", func(call goja.FunctionCall) goja.Value {
+		api.requireCapability(vm, "cookies")
 		url := call.Argument(0).ToString().String()
+		if e := api.checkURL(url); e != nil {
+			panic(vm.NewGoError(e))
+		}
 		cookie, e := network.GetCookies(url)
 		if e != nil {
 			panic(vm.ToValue(errors.New("Error getting cookies:" + e.Error())))
 		}
-		return cookie
+		return vm.ToValue(cookie)
 	})
 
-	api.setFunction(vm, "setCookies", func(call goja.FunctionCall) any {
+	api.setFunction(vm, "setCookies", func(call goja.FunctionCall) goja.Value {
+		api.requireCapability(vm, "cookies")
 		url := call.Argument(0).ToString().String()
+		if e := api.checkURL(url); e != nil {
+			panic(vm.NewGoError(e))
+		}
 		cookiesInterface := call.Argument(1).ToObject(vm).Export()
 		cookies, ok := cookiesInterface.([]any)
 		if !ok {
@@ -181,18 +335,15 @@ func (api *ExtApi) registerFunction(vm *goja.Runtime, job Job) {
 		return nil
 	})
 
-	api.setFunction(vm, "btoa", func(call goja.FunctionCall) goja.Value {
-		return vm.ToValue(base64.RawStdEncoding.EncodeToString([]byte(call.Arguments[0].String())))
-	})
-	api.setFunction(vm, "atob", func(call goja.FunctionCall) goja.Value {
-		str, _ := base64.RawStdEncoding.DecodeString(call.Arguments[0].String())
-		return vm.ToValue(string(str))
-	})
+	api.registerStorage(vm)
 
-	api.service.createSingleChannel(vm, "jsRequest", &job, func(call goja.FunctionCall, resolve func(any) error) any {
+	api.service.createSingleChannel(vm, "jsRequest", job, func(call goja.FunctionCall) func(ctx context.Context) any {
 
 		url := call.Argument(0).ToString().String()
 		url = strings.ReplaceAll(url, "&amp;", "&")
+		if e := api.checkURL(url); e != nil {
+			panic(e)
+		}
 		opt := call.Argument(1).ToObject(vm).Export()
 		var requestOptions network.RequestOptions
 		jsonData, e := json.Marshal(opt)
@@ -204,10 +355,16 @@ func (api *ExtApi) registerFunction(vm *goja.Runtime, job Job) {
 			panic("Error unmarshalling JSON:" + err.Error())
 		}
 
-		res, err := network.Request[string](url, &requestOptions, network.ReadAll)
-		if err != nil {
-			panic(vm.ToValue(err))
+		// Redirects are not followed, hops that are would still be checked
+		requestOptions.CheckRedirect = api.checkURL
+
+		return func(ctx context.Context) any {
+			requestOptions.Context = ctx
+			res, err := network.Request[string](url, &requestOptions, network.ReadAll)
+			if err != nil {
+				panic(err)
+			}
+			return res.Body
 		}
-		return vm.ToValue(res.Body)
 	})
 }
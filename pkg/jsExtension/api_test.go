@@ -0,0 +1,93 @@
+package jsExtension
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/pkg/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettings(t *testing.T) {
+	useTestDatabase(t)
+	assert.NoError(t, db.RegisterSetting(map[string]any{"key": "quality", "title": "Quality", "type": "input", "defaultValue": "hd"}, "test.settings.other"))
+
+	loadTestExtension(t, "test.settings", `
+const thrown = (fn) => { try { fn(); return ""; } catch (e) { return String(e); } };
+async function latest(page) {
+  registerSetting({ key: "count", title: "Count", type: "number", defaultValue: "10" });
+  registerSetting({ key: "langs", title: "Languages", type: "multi-select", options: ["en", "ja", "zh"], defaultValue: ["en"] });
+  registerSetting({ key: "quality", title: "Quality", type: "radio", options: { High: "hd", Low: "sd" }, defaultValue: "hd" });
+  setSetting("count", 3);
+  setSetting("test.settings", "quality", "sd");
+  setSetting("langs", ["ja", "zh"]);
+  const out = {
+    count: getSetting("count"),
+    quality: getSetting("quality"),
+    langs: JSON.parse(getSetting("langs")),
+    notNumber: thrown(() => setSetting("count", "ten")),
+    notOption: thrown(() => setSetting("quality", "4k")),
+    unknown: thrown(() => setSetting("missing", "1")),
+    otherPackage: thrown(() => setSetting("test.settings.other", "quality", "sd")),
+  };
+  return [{ title: "settings", url: JSON.stringify(out) }];
+}`)
+	out := fetchResult(t, "test.settings")
+
+	assert.Equal(t, "3", out["count"])
+	assert.Equal(t, "sd", out["quality"])
+	assert.Equal(t, []any{"ja", "zh"}, out["langs"])
+	assert.Contains(t, out["notNumber"], "is not a number")
+	assert.Contains(t, out["notOption"], "is not one of the options")
+	assert.Contains(t, out["unknown"], "is not registered")
+	assert.Contains(t, out["otherPackage"], "can't change the settings of test.settings.other")
+
+	other, err := db.GetSetting("test.settings.other", "quality")
+	assert.NoError(t, err)
+	assert.Equal(t, "hd", *other.Value)
+
+	// Registering again updates the definition and resets values the new
+	// type can't hold
+	assert.NoError(t, db.RegisterSetting(map[string]any{"key": "count", "title": "Count", "type": "toggle", "defaultValue": false}, "test.settings"))
+	count, err := db.GetSetting("test.settings", "count")
+	assert.NoError(t, err)
+	assert.Equal(t, "toggle", string(count.DbType))
+	assert.Equal(t, "false", *count.Value)
+	assert.NoError(t, db.RegisterSetting(map[string]any{"key": "quality", "title": "Quality", "type": "radio", "options": []any{"sd", "hd"}, "defaultValue": "hd"}, "test.settings"))
+	quality, err := db.GetSetting("test.settings", "quality")
+	assert.NoError(t, err)
+	assert.Equal(t, "sd", *quality.Value)
+}
+
+func TestSecretSettings(t *testing.T) {
+	useTestDatabase(t)
+	previous := config.Global.SecretKeyPath
+	config.Global.SecretKeyPath = filepath.Join(t.TempDir(), "secret.key")
+	t.Cleanup(func() { config.Global.SecretKeyPath = previous })
+
+	loadTestExtension(t, "test.settings.secret", `
+async function latest(page) {
+  registerSetting({ key: "token", title: "Token", type: "secret" });
+  const unset = getSetting("token");
+  setSetting("token", "hunter2");
+  return [{ title: "secret", url: JSON.stringify({ unset, token: getSetting("token") }) }];
+}`)
+	out := fetchResult(t, "test.settings.secret")
+	assert.Equal(t, "", out["unset"])
+	assert.Equal(t, "hunter2", out["token"])
+
+	// The database only holds the encrypted value
+	setting, err := db.GetSetting("test.settings.secret", "token")
+	assert.NoError(t, err)
+	assert.NotContains(t, *setting.Value, "hunter2")
+	value, err := db.GetSettingValue("test.settings.secret", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", *value)
+
+	// Registering again keeps the secret
+	assert.NoError(t, db.RegisterSetting(map[string]any{"key": "token", "title": "API token", "type": "secret"}, "test.settings.secret"))
+	value, err = db.GetSettingValue("test.settings.secret", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", *value)
+}
@@ -1,6 +1,8 @@
 package jsExtension
 
 import (
+	"context"
+
 	log "github.com/miru-project/miru-core/pkg/logger"
 )
 
@@ -14,29 +16,31 @@ func LoadApiV1(ext *Ext) {
 		return
 	}
 
-	api := &ExtApi{Ext: ext, service: &ExtBaseService{program: compiledExt}}
+	api := newExtApi(ext, compiledExt)
 	ApiPkgCache.Store(ext.Pkg, api)
 	ApiPkgCache.SetError(ext.Pkg, "")
 
-	api.initEvalV1String()
-	api.initRuntimeV1(ext.Pkg)
+	api.initEntryPointsV1()
 	api.loadExtensionV1(ext.Pkg)
 	log.Println("Extension loaded (V1):", ext.Name, ext.Pkg)
 
 }
 
 func (api *ExtApi) loadExtensionV1(pkg string) {
-	if _, e := AsyncCallBack(api, pkg, "ext.load()"); e != nil {
+	api.pool = newRuntimePool(api)
+	extMemMap.Store(pkg, api.pool)
+	if e := api.pool.warm(context.Background()); e != nil {
 		ApiPkgCache.SetError(pkg, e.Error())
+		return
 	}
+	// Publish the methods detected by the first runtime
+	ApiPkgCache.Store(pkg, api)
 }
 
-func (api *ExtApi) initEvalV1String() {
+func (api *ExtApi) initEntryPointsV1() {
 	// Register  the async callback function for V1
 	api.asyncCallBack = AsyncCallBack
-	api.latestEval = "ext.latest(%d)"
-	api.searchEval = "ext.search('%s', %d, %s)"
-	api.detailEval = "ext.detail('%s')"
-	api.watchEval = "ext.watch('%s')"
-	api.createFilterEval = "ext.createFilter(%s)"
+	api.initRuntime = api.initRuntimeV1
+	// Entry points are methods of the ext instance
+	api.receiver = "ext"
 }
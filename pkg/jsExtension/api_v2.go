@@ -1,12 +1,12 @@
 package jsExtension
 
 import (
+	"context"
 	"fmt"
 
 	log "github.com/miru-project/miru-core/pkg/logger"
 
 	"github.com/dop251/goja"
-	"github.com/dop251/goja_nodejs/eventloop"
 )
 
 func LoadApiV2(ext *Ext) {
@@ -15,76 +15,92 @@ func LoadApiV2(ext *Ext) {
 	if e != nil {
 		return
 	}
-	api := &ExtApi{Ext: ext, service: &ExtBaseService{program: compiledExt}}
+	api := newExtApi(ext, compiledExt)
 	ApiPkgCache.Store(ext.Pkg, api)
 	ApiPkgCache.SetError(ext.Pkg, "")
 
-	api.initEvalV2String()
-	api.initRuntimeV2(ext.Pkg)
+	api.initEntryPointsV2()
 	api.loadExtensionV2(ext.Pkg)
 	log.Println("Extension loaded (V2):", ext.Name, ext.Pkg)
 }
 
-func (api *ExtApi) initRuntimeV2(pkg string) {
+func (api *ExtApi) initRuntimeV2(pkg string) (*extRuntime, error) {
 
-	ApiPkgCache.Store(pkg, api)
-	loop := eventloop.NewEventLoop(
-		eventloop.WithRegistry(sharedRegistry),
-	)
-
-	if api == nil || api.service.program == nil {
+	if api == nil || api.service == nil || api.service.program == nil {
 		ApiPkgCache.SetError(pkg, fmt.Sprintf("extension %s not found", pkg))
+		return nil, fmt.Errorf("extension %s not found", pkg)
 	}
+	rt := newExtRuntime(pkg)
+	loop := rt.loop
+	var initErr error
+
 	ser := api.service
 	loop.RunOnLoop(func(vm *goja.Runtime) {
 
 		defer func() {
 			if r := recover(); r != nil {
 				if err, ok := r.(error); ok {
-					ApiPkgCache.SetError(pkg, err.Error())
+					initErr = err
 					return
 				}
+				initErr = fmt.Errorf("unknown panic: %v", r)
 				log.Print("Unknown panic:", r)
 			}
 		}()
 
-		var job = Job{loop: loop}
+		rt.vm = vm
+		// Scripts that never finish their top level code are stopped like calls
+		defer rt.watch(pkg, vm, nil)()
 		// Run the program for the  first time
 		reg := sharedRegistry.Enable(vm)
-		ser.addModule(reg, vm, &job)
+		ser.addModule(reg, vm, rt.job)
+		// Metadata read by the header of the base runtime
+		vm.Set("extensionMeta", map[string]string{
+			"pkg":     api.Ext.Pkg,
+			"name":    api.Ext.Name,
+			"website": api.Ext.Website,
+		})
 		// eval base runtime
 		if _, e := vm.RunProgram(baseV2); e != nil {
 			log.Println("Error running base script:", e)
 			panic(e)
 		}
+		rt.stubs = entryPointStubs(vm.GlobalObject())
 		// eval extension program
 		if _, e := vm.RunProgram(api.service.program); e != nil {
 			log.Println("Error running extension script:", e)
 			panic(e)
 		}
+		api.detectMethods(vm.GlobalObject(), rt.stubs)
 
-		api.registerFunction(vm, job)
+		api.registerFunction(vm, rt.job)
 
 	})
 	loop.Start()
-	defer loop.Stop()
+	loop.Stop()
 
-	extMemMap.Store(pkg, loop)
+	if initErr != nil {
+		ApiPkgCache.SetError(pkg, initErr.Error())
+		return nil, initErr
+	}
+	return rt, nil
 }
 
 func (api *ExtApi) loadExtensionV2(pkg string) {
-	if _, e := AsyncCallBack(api, pkg, "load()"); e != nil {
+	api.pool = newRuntimePool(api)
+	extMemMap.Store(pkg, api.pool)
+	if e := api.pool.warm(context.Background()); e != nil {
 		ApiPkgCache.SetError(pkg, e.Error())
+		return
 	}
+	// Publish the methods detected by the first runtime
+	ApiPkgCache.Store(pkg, api)
 }
 
-func (api *ExtApi) initEvalV2String() {
+func (api *ExtApi) initEntryPointsV2() {
 	// Register  the async callback function for V2
 	api.asyncCallBack = AsyncCallBack
-	api.latestEval = "latest(%d)"
-	api.searchEval = "search('%s', %d, %s)"
-	api.detailEval = "detail('%s')"
-	api.watchEval = "watch('%s')"
-	api.mirrorEval = "mirror('%s')"
-	api.createFilterEval = "createFilter(%s)"
+	api.initRuntime = api.initRuntimeV2
+	// Entry points are global functions
+	api.receiver = ""
 }
@@ -0,0 +1,77 @@
+package jsExtension
+
+import (
+	"context"
+
+	log "github.com/miru-project/miru-core/pkg/logger"
+
+	"github.com/dop251/goja"
+)
+
+// API v3 runs on the V2 base runtime, entry points are global functions and
+// the metadata comes from a manifest
+func LoadApiV3(ext *Ext) {
+
+	*ext.Context = replaceExportDeclarations(*ext.Context)
+
+	compiledExt, e := compileExtension(ext)
+	if e != nil {
+		return
+	}
+	api := newExtApi(ext, compiledExt)
+	ApiPkgCache.Store(ext.Pkg, api)
+	ApiPkgCache.SetError(ext.Pkg, "")
+
+	api.initEntryPointsV3()
+	if e := api.inspectManifest(); e != nil {
+		ApiPkgCache.SetError(ext.Pkg, e.Error())
+		return
+	}
+	api.loadExtensionV3(ext.Pkg)
+	log.Println("Extension loaded (V3):", ext.Name, ext.Pkg)
+}
+
+// inspectManifest runs the script once to read an exported manifest and to
+// make sure the declared methods are defined
+func (api *ExtApi) inspectManifest() error {
+	pkg := api.Ext.Pkg
+	rt, e := api.initRuntime(pkg)
+	if e != nil {
+		return e
+	}
+	defer rt.terminate()
+
+	ctx, cancel := withCallTimeout(context.Background())
+	defer cancel()
+	_, e = rt.call(ctx, pkg, func(vm *goja.Runtime) (goja.Value, error) {
+		if api.Ext.Manifest == nil {
+			m, e := exportedManifest(vm, pkg+".js")
+			if e != nil {
+				return nil, e
+			}
+			api.Ext.applyManifest(m)
+		}
+		return goja.Undefined(), api.Ext.Manifest.checkEntryPoints(vm, rt.stubs)
+	})
+	if e != nil {
+		return e
+	}
+	// Publish the metadata read from an exported manifest
+	ApiPkgCache.Store(pkg, api)
+	return nil
+}
+
+func (api *ExtApi) loadExtensionV3(pkg string) {
+	api.pool = newRuntimePool(api)
+	extMemMap.Store(pkg, api.pool)
+	if e := api.pool.warm(context.Background()); e != nil {
+		ApiPkgCache.SetError(pkg, e.Error())
+	}
+}
+
+func (api *ExtApi) initEntryPointsV3() {
+	api.asyncCallBack = AsyncCallBack
+	api.initRuntime = api.initRuntimeV2
+	// Entry points are global functions like V2
+	api.receiver = ""
+}
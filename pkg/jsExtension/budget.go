@@ -0,0 +1,155 @@
+package jsExtension
+
+import (
+	"context"
+	"fmt"
+	"runtime/metrics"
+	"sync/atomic"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+	"github.com/miru-project/miru-core/config"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+const (
+	// the heap still reachable after the last collection, garbage waiting to
+	// be swept doesn't count
+	heapMetric = "/gc/heap/live:bytes"
+	// calls in a row that may go over a budget before the extension is disabled
+	maxStrikes = 3
+)
+
+// BudgetError is returned when a call goes over one of the budgets of its
+// extension, the call is aborted. Going over the budgets too often disables
+// the extension with the last violation as the reason
+type BudgetError struct {
+	Pkg    string
+	Reason string
+	// Disabled is set when the violation disabled the extension
+	Disabled bool
+}
+
+func (e *BudgetError) Error() string {
+	if e.Disabled {
+		return fmt.Sprintf("extension %s disabled: %s", e.Pkg, e.Reason)
+	}
+	return fmt.Sprintf("extension %s over budget: %s", e.Pkg, e.Reason)
+}
+
+// cpuBudget is how long js may run on the loop without yielding
+func cpuBudget() time.Duration {
+	if config.Global.ExtensionCPUBudget > 0 {
+		return time.Duration(config.Global.ExtensionCPUBudget) * time.Millisecond
+	}
+	return config.DefaultExtensionCPUBudget * time.Millisecond
+}
+
+// maxJobs is the number of async jobs a runtime may have in flight
+func maxJobs() uint64 {
+	if config.Global.ExtensionMaxJobs > 0 {
+		return uint64(config.Global.ExtensionMaxJobs)
+	}
+	return config.DefaultExtensionMaxJobs
+}
+
+// heapLimit is how much the heap may grow while a call runs. goja does not
+// account memory per runtime so the growth is measured on the whole process:
+// allocations of other extensions and of the core running at the same time
+// count against the call too. It is only an approximation guarding against
+// runaway allocations, which is why a single violation doesn't disable the
+// extension
+func heapLimit() uint64 {
+	if config.Global.ExtensionHeapLimit > 0 {
+		return uint64(config.Global.ExtensionHeapLimit) << 20
+	}
+	return config.DefaultExtensionHeapLimit << 20
+}
+
+func heapInUse() uint64 {
+	sample := []metrics.Sample{{Name: heapMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+func newExtRuntime(pkg string) *extRuntime {
+	loop := eventloop.NewEventLoop(
+		eventloop.WithRegistry(sharedRegistry),
+	)
+	return &extRuntime{loop: loop, job: &Job{pkg: pkg, loop: loop, limit: maxJobs()}}
+}
+
+// watch interrupts the runtime when js keeps the loop busy for longer than the
+// cpu budget or the heap of the process grows past the limit. abort is called
+// with the violation so the waiting call returns. The returned func stops watching
+func (rt *extRuntime) watch(pkg string, vm *goja.Runtime, abort context.CancelCauseFunc) func() {
+	budget := cpuBudget()
+	limit := heapLimit()
+	start := heapInUse()
+	done := make(chan struct{})
+
+	var seen atomic.Int64
+	seen.Store(time.Now().UnixNano())
+	go func() {
+		ticker := time.NewTicker(budget / 10)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				var violation *BudgetError
+				if busy := now.Sub(time.Unix(0, seen.Load())); busy > budget {
+					violation = &BudgetError{Pkg: pkg, Reason: fmt.Sprintf("js ran for %s without yielding, the budget is %s", busy.Round(time.Millisecond), budget)}
+				} else if heap := heapInUse(); heap > start && heap-start > limit {
+					violation = &BudgetError{Pkg: pkg, Reason: fmt.Sprintf("heap grew by %d MB, the limit is %d MB", (heap-start)>>20, limit>>20)}
+				}
+				if violation != nil {
+					log.Println("Extension over budget:", pkg, violation.Reason)
+					if abort != nil {
+						abort(violation)
+					}
+					vm.Interrupt(violation)
+					return
+				}
+				// The callback only runs once the loop is free again
+				rt.loop.RunOnLoop(func(*goja.Runtime) {
+					seen.Store(time.Now().UnixNano())
+				})
+			}
+		}
+	}()
+	return func() {
+		close(done)
+	}
+}
+
+// overBudget counts budget violations and disables the extension once
+// maxStrikes calls in a row went over any of the budgets. A single call says
+// little about the extension, the heap is measured on the whole process
+func (api *ExtApi) overBudget(budget *BudgetError) {
+	if api.strikes.Add(1) < maxStrikes {
+		return
+	}
+	budget.Disabled = true
+	api.disable(budget)
+}
+
+// disable stops an extension that kept going over its budgets, the reason
+// is set as its error so it is published with the extension update event.
+// Enabling the extension again reloads it with a new pool
+func (api *ExtApi) disable(reason error) {
+	if !api.disabled.CompareAndSwap(false, true) {
+		return
+	}
+	pkg := api.Ext.Pkg
+	log.Println("Disabling extension:", pkg, reason)
+	if pool, ok := extMemMap.LoadAndDelete(pkg); ok {
+		pool.(*runtimePool).close()
+	}
+	ApiPkgCache.SetError(pkg, reason.Error())
+}
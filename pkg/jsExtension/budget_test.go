@@ -0,0 +1,142 @@
+package jsExtension
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+// withBudgets overrides the extension budgets for the duration of a test
+func withBudgets(t *testing.T, cpuMillis int, jobs int, heapMB int) {
+	t.Helper()
+	saved := config.Global
+	config.Global.ExtensionCPUBudget = cpuMillis
+	config.Global.ExtensionMaxJobs = jobs
+	config.Global.ExtensionHeapLimit = heapMB
+	t.Cleanup(func() {
+		config.Global.ExtensionCPUBudget = saved.ExtensionCPUBudget
+		config.Global.ExtensionMaxJobs = saved.ExtensionMaxJobs
+		config.Global.ExtensionHeapLimit = saved.ExtensionHeapLimit
+	})
+}
+
+func TestBudgetDisablesBusyExtension(t *testing.T) {
+	withBudgets(t, 200, 0, 0)
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved := ExtPath
+	ExtPath = t.TempDir()
+	t.Cleanup(func() {
+		ExtPath = saved
+	})
+	pkg := "test.budget.cpu"
+	installTestScript(t, pkg, `// @name `+pkg+`
+// @version 1.0.0
+// @package `+pkg+`
+// @apiVersion 2
+
+async function latest(page) {
+  await new Promise((resolve) => setTimeout(resolve, 400));
+  return [{ title: "slow but yielding", url: "/" }];
+}
+async function search(kw, page, filter) {
+  while (true) {}
+}`)
+	t.Cleanup(func() {
+		RemoveExtension(pkg)
+	})
+
+	res, err := Latest[map[string]any](context.Background(), pkg, 1)
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+
+	// Only calls going over the budget again and again disable the extension
+	var budget *BudgetError
+	for i := 1; i <= maxStrikes; i++ {
+		_, err = Search[proto.ExtensionListItem](context.Background(), pkg, 1, "kw", "")
+		if assert.True(t, errors.As(err, &budget)) {
+			assert.Contains(t, budget.Reason, "without yielding")
+			assert.Equal(t, i == maxStrikes, budget.Disabled)
+		}
+		if i < maxStrikes {
+			assert.Empty(t, ApiPkgCache.Load(pkg).Ext.Error)
+		}
+	}
+	assert.Contains(t, ApiPkgCache.Load(pkg).Ext.Error, "disabled")
+
+	_, err = Latest[map[string]any](context.Background(), pkg, 1)
+	assert.ErrorContains(t, err, "is disabled")
+
+	// Enabling it again starts over
+	api, err := SetExtensionEnabled(pkg, true)
+	assert.NoError(t, err)
+	assert.Empty(t, api.Ext.Error)
+	_, err = Latest[map[string]any](context.Background(), pkg, 1)
+	assert.NoError(t, err)
+}
+
+func TestBudgetStopsEndlessTopLevelCode(t *testing.T) {
+	withBudgets(t, 200, 0, 0)
+	compileTestRuntimes(t)
+	code := `while (true) {}`
+	LoadApiV2(&Ext{Name: "test.budget.init", Pkg: "test.budget.init", ApiVersion: "2", Context: &code})
+	assert.Contains(t, ApiPkgCache.Load("test.budget.init").Ext.Error, "without yielding")
+}
+
+func TestBudgetLimitsJobsInFlight(t *testing.T) {
+	withBudgets(t, 0, 3, 0)
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	code := `
+async function latest(page) {
+  const requests = [];
+  for (let i = 0; i < 10; i++) {
+    requests.push(jsRequest("http://127.0.0.1:1/" + i, { method: "get" }).catch(() => null));
+  }
+  await Promise.all(requests);
+  return [];
+}`
+	LoadApiV2(&Ext{Name: "test.budget.jobs", Pkg: "test.budget.jobs", ApiVersion: "2", Website: "http://127.0.0.1:1", Context: &code})
+
+	var budget *BudgetError
+	for i := 1; i <= maxStrikes; i++ {
+		_, err := Latest[map[string]any](context.Background(), "test.budget.jobs", 1)
+		if assert.True(t, errors.As(err, &budget)) {
+			assert.Contains(t, budget.Reason, "more than 3 async operations")
+			assert.Equal(t, i == maxStrikes, budget.Disabled)
+		}
+	}
+	// The extension is disabled once every call goes over
+	assert.Contains(t, ApiPkgCache.Load("test.budget.jobs").Ext.Error, "more than 3 async operations")
+	assert.True(t, ApiPkgCache.Load("test.budget.jobs").disabled.Load())
+}
+
+func TestBudgetLimitsHeapGrowth(t *testing.T) {
+	withBudgets(t, 5000, 0, 16)
+	loadTestExtension(t, "test.budget.heap", `
+async function latest(page) {
+  const chunks = [];
+  while (true) {
+    chunks.push(new Array(100000).fill(page));
+  }
+}`)
+
+	var budget *BudgetError
+	for i := 1; i <= maxStrikes; i++ {
+		_, err := Latest[map[string]any](context.Background(), "test.budget.heap", 1)
+		if assert.True(t, errors.As(err, &budget)) {
+			assert.Contains(t, budget.Reason, "heap grew")
+			assert.Equal(t, i == maxStrikes, budget.Disabled)
+		}
+		if i < maxStrikes {
+			assert.Empty(t, ApiPkgCache.Load("test.budget.heap").Ext.Error)
+		}
+	}
+	// Runaway allocations disable the extension and publish the reason
+	assert.Contains(t, ApiPkgCache.Load("test.budget.heap").Ext.Error, "heap grew")
+	assert.True(t, ApiPkgCache.Load("test.budget.heap").disabled.Load())
+}
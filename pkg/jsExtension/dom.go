@@ -0,0 +1,218 @@
+package jsExtension
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/antchfx/htmlquery"
+	"github.com/dop251/goja"
+	"golang.org/x/net/html"
+)
+
+// domModule is the name extensions require the native DOM under. Its
+// parseHTML(content) returns { document } like linkedom, but the document is
+// parsed once in Go and queried with compiled CSS selectors. Nodes support the
+// part of the DOM the V1Element helpers use: querySelector, querySelectorAll,
+// getAttribute, hasAttribute, removeChild, remove, textContent, innerHTML,
+// outerHTML, tagName, children, parentNode and documentElement
+const domModule = "miru/dom"
+
+// selectors caches compiled selectors, extensions query the same ones on
+// every page they parse
+var selectors sync.Map
+
+func compileSelector(selector string) (cascadia.Selector, error) {
+	if sel, ok := selectors.Load(selector); ok {
+		return sel.(cascadia.Selector), nil
+	}
+	sel, e := cascadia.Compile(selector)
+	if e != nil {
+		return nil, fmt.Errorf("invalid selector %q: %v", selector, e)
+	}
+	selectors.Store(selector, sel)
+	return sel, nil
+}
+
+// domBridge wraps the html nodes of one runtime in js objects sharing a
+// prototype, the node itself is kept under a symbol the scripts can't see
+type domBridge struct {
+	vm    *goja.Runtime
+	proto *goja.Object
+	key   *goja.Symbol
+}
+
+func newDOMBridge(vm *goja.Runtime) *domBridge {
+	b := &domBridge{vm: vm, proto: vm.NewObject(), key: goja.NewSymbol("node")}
+	methods := map[string]func(goja.FunctionCall) goja.Value{
+		"querySelector":    b.querySelector,
+		"querySelectorAll": b.querySelectorAll,
+		"getAttribute":     b.getAttribute,
+		"hasAttribute":     b.hasAttribute,
+		"removeChild":      b.removeChild,
+		"remove":           b.remove,
+	}
+	for name, fn := range methods {
+		b.proto.Set(name, fn)
+	}
+	getters := map[string]func(*html.Node) goja.Value{
+		"textContent":     func(n *html.Node) goja.Value { return vm.ToValue(htmlquery.InnerText(n)) },
+		"innerHTML":       func(n *html.Node) goja.Value { return vm.ToValue(htmlquery.OutputHTML(n, false)) },
+		"outerHTML":       func(n *html.Node) goja.Value { return vm.ToValue(outerHTML(n)) },
+		"tagName":         b.tagName,
+		"children":        b.children,
+		"parentNode":      func(n *html.Node) goja.Value { return b.wrap(n.Parent) },
+		"documentElement": func(n *html.Node) goja.Value { return b.wrap(documentElement(n)) },
+	}
+	for name, get := range getters {
+		b.proto.DefineAccessorProperty(name, vm.ToValue(func(call goja.FunctionCall) goja.Value {
+			return get(b.this(call))
+		}), nil, goja.FLAG_FALSE, goja.FLAG_TRUE)
+	}
+	return b
+}
+
+// wrap returns the js object of n, null for nil
+func (b *domBridge) wrap(n *html.Node) goja.Value {
+	if n == nil {
+		return goja.Null()
+	}
+	obj := b.vm.NewObject()
+	obj.SetPrototype(b.proto)
+	obj.DefineDataPropertySymbol(b.key, b.vm.ToValue(n), goja.FLAG_FALSE, goja.FLAG_FALSE, goja.FLAG_FALSE)
+	return obj
+}
+
+// node unwraps v, throwing a TypeError for anything that is not a node
+func (b *domBridge) node(v goja.Value) *html.Node {
+	if obj, ok := v.(*goja.Object); ok {
+		if v := obj.GetSymbol(b.key); v != nil {
+			if n, ok := v.Export().(*html.Node); ok {
+				return n
+			}
+		}
+	}
+	panic(b.vm.NewTypeError("not a DOM node"))
+}
+
+func (b *domBridge) this(call goja.FunctionCall) *html.Node {
+	return b.node(call.This)
+}
+
+func (b *domBridge) selector(call goja.FunctionCall) cascadia.Selector {
+	sel, e := compileSelector(call.Argument(0).String())
+	if e != nil {
+		panic(b.vm.NewGoError(e))
+	}
+	return sel
+}
+
+func (b *domBridge) querySelector(call goja.FunctionCall) goja.Value {
+	n := b.this(call)
+	return b.wrap(cascadia.Query(n, b.selector(call)))
+}
+
+func (b *domBridge) querySelectorAll(call goja.FunctionCall) goja.Value {
+	n := b.this(call)
+	nodes := cascadia.QueryAll(n, b.selector(call))
+	values := make([]any, len(nodes))
+	for i, node := range nodes {
+		values[i] = b.wrap(node)
+	}
+	return b.vm.NewArray(values...)
+}
+
+func (b *domBridge) getAttribute(call goja.FunctionCall) goja.Value {
+	n := b.this(call)
+	name := strings.ToLower(call.Argument(0).String())
+	for _, attr := range n.Attr {
+		if attr.Namespace == "" && attr.Key == name {
+			return b.vm.ToValue(attr.Val)
+		}
+	}
+	return goja.Null()
+}
+
+func (b *domBridge) hasAttribute(call goja.FunctionCall) goja.Value {
+	return b.vm.ToValue(!goja.IsNull(b.getAttribute(call)))
+}
+
+func (b *domBridge) removeChild(call goja.FunctionCall) goja.Value {
+	n := b.this(call)
+	child := b.node(call.Argument(0))
+	if child.Parent != n {
+		panic(b.vm.NewTypeError("the node to be removed is not a child of this node"))
+	}
+	n.RemoveChild(child)
+	return call.Argument(0)
+}
+
+func (b *domBridge) remove(call goja.FunctionCall) goja.Value {
+	n := b.this(call)
+	if n.Parent != nil {
+		n.Parent.RemoveChild(n)
+	}
+	return goja.Undefined()
+}
+
+func (b *domBridge) tagName(n *html.Node) goja.Value {
+	if n.Type != html.ElementNode {
+		return goja.Null()
+	}
+	return b.vm.ToValue(strings.ToUpper(n.Data))
+}
+
+func (b *domBridge) children(n *html.Node) goja.Value {
+	var values []any
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode {
+			values = append(values, b.wrap(c))
+		}
+	}
+	return b.vm.NewArray(values...)
+}
+
+// outerHTML renders n, the document renders as its root element
+func outerHTML(n *html.Node) string {
+	if n.Type == html.DocumentNode {
+		if root := documentElement(n); root != nil {
+			n = root
+		}
+	}
+	return htmlquery.OutputHTML(n, true)
+}
+
+// documentElement returns the root element of the tree n belongs to
+func documentElement(n *html.Node) *html.Node {
+	for n.Parent != nil {
+		n = n.Parent
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode {
+			return c
+		}
+	}
+	if n.Type == html.ElementNode {
+		return n
+	}
+	return nil
+}
+
+// parseHTML parses content into a document, which is what parseHTML of
+// linkedom returns as well
+func (b *domBridge) parseHTML(content string) (goja.Value, error) {
+	doc, e := html.Parse(strings.NewReader(content))
+	if e != nil {
+		return nil, fmt.Errorf("failed to parse html: %v", e)
+	}
+	obj := b.vm.NewObject()
+	obj.Set("document", b.wrap(doc))
+	return obj, nil
+}
+
+// requireDOM loads the native DOM module into a runtime
+func requireDOM(vm *goja.Runtime, module *goja.Object) {
+	b := newDOMBridge(vm)
+	module.Get("exports").(*goja.Object).Set("parseHTML", b.parseHTML)
+}
@@ -0,0 +1,163 @@
+package jsExtension
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/require"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDOMTestRuntime returns a runtime that can require both linkedom and the
+// native DOM
+func newDOMTestRuntime(tb testing.TB) *goja.Runtime {
+	tb.Helper()
+	source, err := os.ReadFile("../../binary/assets/linkedom/worker.js")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	program, err := compileJSModule("linkedom", string(source))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	registry := require.NewRegistry()
+	registry.RegisterNativeModule("linkedom", func(vm *goja.Runtime, module *goja.Object) {
+		LoadModule(vm, module, program, "linkedom")
+	})
+	registry.RegisterNativeModule(domModule, requireDOM)
+	vm := goja.New()
+	registry.Enable(vm)
+	return vm
+}
+
+func testPage(items int) string {
+	var sb strings.Builder
+	sb.WriteString(`<!DOCTYPE html><html><head><title>Page</title></head><body><ul id="list">`)
+	for i := 0; i < items; i++ {
+		fmt.Fprintf(&sb, `<li class="item"><a href="/watch/%d" data-id="%d"><img src="/cover/%d.jpg"><span class="title">Title %d</span></a><p>Episode <b>%d</b></p></li>`, i, i, i, i, i)
+	}
+	sb.WriteString(`</ul><div class="ad">ad</div></body></html>`)
+	return sb.String()
+}
+
+const domTestScript = `
+(function (module, content) {
+  const { document } = require(module).parseHTML(content);
+  const first = document.querySelector("li.item");
+  const items = document.querySelectorAll("#list > li");
+  const ad = document.querySelector(".ad");
+  ad.parentNode.removeChild(ad);
+  return JSON.stringify({
+    title: document.querySelector("head title").textContent,
+    link: first.querySelector("a").getAttribute("href"),
+    id: first.querySelector("a").getAttribute("data-id"),
+    missing: first.getAttribute("href"),
+    none: document.querySelector(".none"),
+    tag: first.tagName,
+    count: items.length,
+    children: first.children.length,
+    texts: items.map((e) => e.textContent),
+    covers: document.querySelectorAll("li a img").map((e) => e.getAttribute("src")),
+    inner: first.querySelector("p").innerHTML,
+    outer: first.querySelector("span").outerHTML,
+    hasAd: document.querySelector(".ad") !== null,
+    parent: first.parentNode.getAttribute("id"),
+  });
+})`
+
+func TestDOMMatchesLinkedom(t *testing.T) {
+	vm := newDOMTestRuntime(t)
+	fn, err := vm.RunString(domTestScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query, _ := goja.AssertFunction(fn)
+	page := vm.ToValue(testPage(3))
+
+	want, err := query(nil, vm.ToValue("linkedom"), page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := query(nil, vm.ToValue(domModule), page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, want.String(), got.String())
+}
+
+func TestDOMErrors(t *testing.T) {
+	vm := newDOMTestRuntime(t)
+	_, err := vm.RunString(`require("miru/dom").parseHTML("<p></p>").document.querySelector("p[")`)
+	assert.ErrorContains(t, err, "invalid selector")
+	_, err = vm.RunString(`const { document } = require("miru/dom").parseHTML("<p></p>"); document.removeChild(document.querySelector("p"))`)
+	assert.ErrorContains(t, err, "not a child")
+	_, err = vm.RunString(`document.querySelector.call({}, "p")`)
+	assert.ErrorContains(t, err, "not a DOM node")
+}
+
+func TestQuerySelectorInV1Runtime(t *testing.T) {
+	loadTestExtensionV1(t, "test.dom.v1", "https://example.com", `
+class Test extends Extension {
+  async latest(page) {
+    const html = '<div><a class="x" href="/a">A</a><a href="/b">B<i>ad</i></a></div>';
+    const links = this.querySelectorAll(html, "a");
+    const second = this.querySelector(html, "a:nth-of-type(2)").removeSelector("i");
+    return links.map((e) => ({
+      title: e.text,
+      url: e.getAttributeText("href"),
+      cover: e.content,
+    })).concat([{
+      title: second.text,
+      url: await this.getAttributeText(html, "a.x", "href"),
+      cover: this.querySelector(html, "div").innerHTML,
+    }]);
+  }
+}`)
+	res, err := Latest[map[string]any](context.Background(), "test.dom.v1", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, res, 3) {
+		assert.Equal(t, "A", (*res[0])["title"])
+		assert.Equal(t, "/a", (*res[0])["url"])
+		assert.Equal(t, `<a class="x" href="/a">A</a>`, (*res[0])["cover"])
+		assert.Equal(t, "Bad", (*res[1])["title"])
+		assert.Equal(t, "B", (*res[2])["title"])
+		assert.Equal(t, "/a", (*res[2])["url"])
+		assert.Equal(t, `<a class="x" href="/a">A</a><a href="/b">B<i>ad</i></a>`, (*res[2])["cover"])
+	}
+}
+
+// benchmarkQuerySelector parses a page of 200 items with module and reads the
+// title and link of every item, like the latest of a V1 extension does
+func benchmarkQuerySelector(b *testing.B, module string) {
+	vm := newDOMTestRuntime(b)
+	fn, err := vm.RunString(`(function (module, content) {
+  const { document } = require(module).parseHTML(content);
+  return document.querySelectorAll("li.item").map((e) => ({
+    title: e.querySelector(".title").textContent,
+    url: e.querySelector("a").getAttribute("href"),
+  }));
+})`)
+	if err != nil {
+		b.Fatal(err)
+	}
+	query, _ := goja.AssertFunction(fn)
+	name, page := vm.ToValue(module), vm.ToValue(testPage(200))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := query(nil, name, page); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkQuerySelectorLinkedom(b *testing.B) {
+	benchmarkQuerySelector(b, "linkedom")
+}
+
+func BenchmarkQuerySelectorNative(b *testing.B) {
+	benchmarkQuerySelector(b, domModule)
+}
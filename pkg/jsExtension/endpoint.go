@@ -1,7 +1,9 @@
 package jsExtension
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -61,29 +63,93 @@ func UnmarshalList[T any](input any) ([]*T, error) {
 	return result, nil
 }
 
+// filterArg validates the JSON filter sent by the client, an empty filter is null
+func filterArg(filter string) (jsonArg, error) {
+	if filter == "" {
+		return "null", nil
+	}
+	if !json.Valid([]byte(filter)) {
+		return "", fmt.Errorf("invalid filter, expected JSON: %s", filter)
+	}
+	return jsonArg(filter), nil
+}
+
 // Extension latest should contain V1 and V2 api
-func Latest[T any](pkg string, page int) ([]*T, error) {
+func Latest[T any](ctx context.Context, pkg string, page int) ([]*T, error) {
+	api, e := getPkgFromCache(pkg)
+	if e != nil {
+		return nil, e
+	}
+	res, err := api.asyncCallBack(ctx, api, pkg, "latest", page)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.checkResult("latest", res); err != nil {
+		return nil, err
+	}
+	return UnmarshalList[T](res)
+}
+
+// Popular returns the most popular items of an extension
+func Popular[T any](ctx context.Context, pkg string, page int) ([]*T, error) {
+	api, e := getPkgFromCache(pkg)
+	if e != nil {
+		return nil, e
+	}
+	res, err := api.asyncCallBack(ctx, api, pkg, "popular", page)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.checkResult("popular", res); err != nil {
+		return nil, err
+	}
+	return UnmarshalList[T](res)
+}
+
+// Tags returns the tags of the item at url
+func Tags[T any](ctx context.Context, pkg string, url string) ([]*T, error) {
 	api, e := getPkgFromCache(pkg)
 	if e != nil {
 		return nil, e
 	}
-	res, err := api.asyncCallBack(api, pkg, fmt.Sprintf(api.latestEval, page))
+	res, err := api.asyncCallBack(ctx, api, pkg, "tags", url)
 	if err != nil {
 		return nil, err
 	}
 	return UnmarshalList[T](res)
 }
 
+// CheckUpdate returns what is new about the item at url, e.g. its latest
+// episode, favorites compare it to the last value to show updates
+func CheckUpdate[T any](ctx context.Context, pkg string, url string) (*T, error) {
+	api, e := getPkgFromCache(pkg)
+	if e != nil {
+		return nil, e
+	}
+	res, err := api.asyncCallBack(ctx, api, pkg, "checkUpdate", url)
+	if err != nil {
+		return nil, err
+	}
+	return Unmarshal[T](res)
+}
+
 // Extension search should contain V1 and V2 api
-func Search[T proto.ExtensionListItem](pkg string, page int, kw string, filter string) ([]*T, error) {
+func Search[T proto.ExtensionListItem](ctx context.Context, pkg string, page int, kw string, filter string) ([]*T, error) {
 	api, e := getPkgFromCache(pkg)
 	if e != nil {
 		return nil, e
 	}
-	res, err := api.asyncCallBack(api, pkg, fmt.Sprintf(api.searchEval, kw, page, filter))
+	filterArg, err := filterArg(filter)
 	if err != nil {
 		return nil, err
 	}
+	res, err := api.asyncCallBack(ctx, api, pkg, "search", kw, page, filterArg)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.checkResult("search", res); err != nil {
+		return nil, err
+	}
 	return UnmarshalList[T](res)
 }
 
@@ -145,19 +211,22 @@ func handleMediaType(api *ExtApi, pkg string, o any) (any, error) {
 }
 
 // Extension watch should contain V1 and V2 api
-func Watch(pkg string, watchLink string) (any, error, *ExtApi) {
+func Watch(ctx context.Context, pkg string, watchLink string) (any, error, *ExtApi) {
 	api, e := getPkgFromCache(pkg)
 	if e != nil {
 		return nil, e, nil
 	}
 
-	o, e := api.asyncCallBack(api, pkg, fmt.Sprintf(api.watchEval, watchLink))
+	o, e := api.asyncCallBack(ctx, api, pkg, "watch", watchLink)
 	if e != nil {
 		return nil, e, api
 	}
+	if e := api.checkResult("watch", o); e != nil {
+		return nil, e, api
+	}
 
 	switch api.Ext.ApiVersion {
-	case "2":
+	case "2", "3":
 		return o, nil, api
 	default:
 		resolved, err := handleMediaType(api, pkg, o)
@@ -165,39 +234,43 @@ func Watch(pkg string, watchLink string) (any, error, *ExtApi) {
 	}
 }
 
-func Detail[T proto.ExtensionDetail](pkg string, url string) (*T, error) {
+func Detail[T proto.ExtensionDetail](ctx context.Context, pkg string, url string) (*T, error) {
 	api, e := getPkgFromCache(pkg)
 	if e != nil {
 		return nil, e
 	}
-	res, err := api.asyncCallBack(api, pkg, fmt.Sprintf(api.detailEval, url))
+	res, err := api.asyncCallBack(ctx, api, pkg, "detail", url)
 	if err != nil {
 		return nil, err
 	}
+	if err := api.checkResult("detail", res); err != nil {
+		return nil, err
+	}
 	return Unmarshal[T](res)
 }
 
-func Mirror(pkg string, watchUrl string) (any, error) {
+func Mirror(ctx context.Context, pkg string, watchUrl string) (any, error) {
 	api, e := getPkgFromCache(pkg)
 	if e != nil {
 		return "", e
 	}
-	res, err := api.asyncCallBack(api, pkg, fmt.Sprintf(api.mirrorEval, watchUrl))
+	res, err := api.asyncCallBack(ctx, api, pkg, "mirror", watchUrl)
 	if err != nil {
 		return "", err
 	}
 	return handleMediaType(api, pkg, res)
 }
 
-func CreateFilter(pkg string, filter string) (map[string]*proto.ExtensionFilter, error) {
+func CreateFilter(ctx context.Context, pkg string, filter string) (map[string]*proto.ExtensionFilter, error) {
 	api, e := getPkgFromCache(pkg)
 	if e != nil {
 		return nil, e
 	}
-	if filter == "" {
-		filter = "null"
+	filterArg, err := filterArg(filter)
+	if err != nil {
+		return nil, err
 	}
-	res, err := api.asyncCallBack(api, pkg, fmt.Sprintf(api.createFilterEval, filter))
+	res, err := api.asyncCallBack(ctx, api, pkg, "createFilter", filterArg)
 	if err != nil {
 		return nil, err
 	}
@@ -207,3 +280,12 @@ func CreateFilter(pkg string, filter string) (map[string]*proto.ExtensionFilter,
 	}
 	return *decoded, nil
 }
+
+// Invoke calls an entry point of a loaded extension and returns the raw result
+func Invoke(ctx context.Context, pkg string, method string, args ...any) (any, error) {
+	api, e := getPkgFromCache(pkg)
+	if e != nil {
+		return nil, e
+	}
+	return api.asyncCallBack(ctx, api, pkg, method, args...)
+}
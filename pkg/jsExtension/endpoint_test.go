@@ -0,0 +1,110 @@
+package jsExtension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchArgumentsAreNotEvaluated(t *testing.T) {
+	loadTestExtension(t, "test.endpoint.args", `
+async function search(kw, page, filter) {
+  return [{ title: kw, url: Miru.pkg, update: JSON.stringify({ page: page, filter: filter }) }];
+}`)
+
+	kw := `'); throw new Error("injected"); ('`
+	res, err := Search[proto.ExtensionListItem](context.Background(), "test.endpoint.args", 2, kw, `{"sort":{"value":"new"}}`)
+	if assert.NoError(t, err) && assert.Len(t, res, 1) {
+		assert.Equal(t, kw, res[0].Title)
+		assert.Equal(t, "test.endpoint.args", res[0].Url)
+		assert.JSONEq(t, `{"page":2,"filter":{"sort":{"value":"new"}}}`, res[0].Update)
+	}
+
+	_, err = Search[proto.ExtensionListItem](context.Background(), "test.endpoint.args", 1, "kw", `{"sort":`)
+	assert.ErrorContains(t, err, "invalid filter")
+}
+
+func TestV1EntryPointsAreMethods(t *testing.T) {
+	loadTestExtensionV1(t, "test.endpoint.v1", "https://example.com/'\"", `
+export default class Source extends Extension {
+  async detail(url) {
+    return { title: this.webSite + url };
+  }
+}`)
+
+	res, err := Detail[proto.ExtensionDetail](context.Background(), "test.endpoint.v1", `/a'b"c`)
+	assert.NoError(t, err)
+	assert.Equal(t, `https://example.com/'"/a'b"c`, res.GetTitle())
+
+	_, err = Mirror(context.Background(), "test.endpoint.v1", "/a")
+	assert.ErrorContains(t, err, "does not implement mirror")
+}
+
+func TestPopularTagsCheckUpdate(t *testing.T) {
+	loadTestExtensionV1(t, "test.endpoint.hooks.v1", "https://example.com", `
+export default class Source extends Extension {
+  async popular(page) {
+    return [{ title: "popular " + page, url: "/p" }];
+  }
+  async tags(url) {
+    return ["action", url];
+  }
+  async checkUpdate(url) {
+    return "Episode 12";
+  }
+  async detail(url) {
+    return { title: url };
+  }
+}`)
+	popular, err := Popular[proto.ExtensionListItem](context.Background(), "test.endpoint.hooks.v1", 2)
+	if assert.NoError(t, err) && assert.Len(t, popular, 1) {
+		assert.Equal(t, "popular 2", popular[0].Title)
+	}
+	tags, err := Tags[string](context.Background(), "test.endpoint.hooks.v1", "/a")
+	if assert.NoError(t, err) && assert.Len(t, tags, 2) {
+		assert.Equal(t, "action", *tags[0])
+		assert.Equal(t, "/a", *tags[1])
+	}
+	update, err := CheckUpdate[string](context.Background(), "test.endpoint.hooks.v1", "/a")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Episode 12", *update)
+	}
+	assert.Equal(t, []string{"checkUpdate", "detail", "popular", "tags"}, ApiPkgCache.Load("test.endpoint.hooks.v1").Ext.Methods)
+
+	loadTestExtension(t, "test.endpoint.hooks.v2", `
+async function latest(page) {
+  return [];
+}
+async function checkUpdate(url) {
+  return "Chapter " + url;
+}`)
+	update, err = CheckUpdate[string](context.Background(), "test.endpoint.hooks.v2", "3")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Chapter 3", *update)
+	}
+	_, err = Popular[proto.ExtensionListItem](context.Background(), "test.endpoint.hooks.v2", 1)
+	assert.ErrorContains(t, err, "not implement popular")
+	assert.Equal(t, []string{"checkUpdate", "latest"}, ApiPkgCache.Load("test.endpoint.hooks.v2").Ext.Methods)
+
+	ext := loadTestExtensionV3(t, "test.endpoint.hooks.v3", `
+/* @manifest {"name": "Hooks", "version": "1.0.0", "package": "test.endpoint.hooks.v3", "methods": ["tags", "popular"]} */
+async function popular(page) {
+  return [{ title: "p", url: "/p" }];
+}
+async function tags(url) {
+  return ["drama"];
+}
+async function checkUpdate(url) {
+  return "undeclared";
+}`)
+	assert.Empty(t, ext.Error)
+	assert.Equal(t, []string{"popular", "tags"}, ext.Methods)
+	tags, err = Tags[string](context.Background(), "test.endpoint.hooks.v3", "/a")
+	if assert.NoError(t, err) && assert.Len(t, tags, 1) {
+		assert.Equal(t, "drama", *tags[0])
+	}
+	_, err = CheckUpdate[string](context.Background(), "test.endpoint.hooks.v3", "/a")
+	assert.ErrorContains(t, err, "does not declare checkUpdate in its manifest")
+}
@@ -6,6 +6,7 @@ import (
 	log "github.com/miru-project/miru-core/pkg/logger"
 )
 
+// extMemMap holds the runtime pool of every loaded package
 var extMemMap = sync.Map{}
 
 type ExtMapCache struct {
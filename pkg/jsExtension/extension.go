@@ -1,21 +1,25 @@
 package jsExtension
 
 import (
+	"context"
 	"embed"
 	"errors"
+	"fmt"
+	iofs "io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
+	"unicode"
 
 	log "github.com/miru-project/miru-core/pkg/logger"
 
 	"github.com/dop251/goja"
 	"github.com/dop251/goja_nodejs/eventloop"
 	"github.com/dop251/goja_nodejs/require"
-	"github.com/fsnotify/fsnotify"
 	errorhandle "github.com/miru-project/miru-core/pkg/errorHandle"
 )
 
@@ -23,13 +27,17 @@ import (
 type ExtBaseService struct {
 	// Extension program compiles into goja program
 	program *goja.Program
+	// checkURL rejects urls outside the domains the extension may access
+	checkURL func(rawURL string) error
+	// versions of the shared libraries required by the extension
+	libraries map[string]string
 }
 
 // Base runtime (v1 or v2) compiles into goja program
 var baseV1 *goja.Program
 var baseV2 *goja.Program
 var sharedRegistry *require.Registry
-var fs embed.FS
+var fs iofs.ReadFileFS
 
 // jsRoot is the root directory for JavaScript files copy from the embedded filesystem
 var jsRoot string
@@ -37,30 +45,117 @@ var jsRoot string
 var ExtPath string
 
 type ExtApi struct {
-	Ext              *Ext
-	service          *ExtBaseService
-	asyncCallBack    func(api *ExtApi, pkg string, evalStr string) (any, error)
-	latestEval       string
-	searchEval       string
-	detailEval       string
-	watchEval        string
-	mirrorEval       string
-	createFilterEval string
-	lock             sync.Mutex
+	Ext           *Ext
+	service       *ExtBaseService
+	asyncCallBack func(ctx context.Context, api *ExtApi, pkg string, method string, args ...any) (any, error)
+	// name of the global object holding the entry points, empty for global functions
+	receiver    string
+	initRuntime func(pkg string) (*extRuntime, error)
+	pool        *runtimePool
+	// set once the extension kept going over its budgets
+	disabled atomic.Bool
+	// calls in a row that went over a budget
+	strikes atomic.Int32
+	// the implemented methods are detected by the first runtime
+	methods sync.Once
+}
+
+// detectMethods records the methods the extension implements for clients to
+// know which entry points they can call. API v3 extensions declare them in
+// their manifest instead
+func (api *ExtApi) detectMethods(scope *goja.Object, stubs map[string]goja.Value) {
+	if api.Ext.ApiVersion == "3" {
+		return
+	}
+	api.methods.Do(func() {
+		api.Ext.Methods = implementedMethods(scope, stubs)
+	})
+}
+
+func newExtApi(ext *Ext, program *goja.Program) *ExtApi {
+	api := &ExtApi{Ext: ext, service: &ExtBaseService{program: program, libraries: ext.Libraries}}
+	api.service.checkURL = api.checkURL
+	return api
+}
+
+// extRuntime is an initialised event loop together with the goja runtime
+// and the async job counter that belong to it
+type extRuntime struct {
+	loop     *eventloop.EventLoop
+	vm       *goja.Runtime
+	job      *Job
+	lastUsed time.Time
+	// entry points defined by the base runtime before the extension script ran
+	stubs map[string]goja.Value
+}
+
+// DefaultCallTimeout bounds extension calls whose context has no deadline
+var DefaultCallTimeout = 60 * time.Second
+
+// ExtTimeoutError is returned when an extension call is aborted because its
+// context was cancelled or its deadline passed
+type ExtTimeoutError struct {
+	Pkg string
+	Err error
+}
+
+func (e *ExtTimeoutError) Error() string {
+	return fmt.Sprintf("extension %s call aborted: %v", e.Pkg, e.Err)
+}
+
+func (e *ExtTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// interrupt stops any js still running on the runtime and returns the timeout error
+func (rt *extRuntime) interrupt(pkg string, ctx context.Context) error {
+	err := &ExtTimeoutError{Pkg: pkg, Err: ctx.Err()}
+	log.Println("Interrupting extension:", pkg, ctx.Err())
+	rt.vm.Interrupt(err)
+	return err
+}
+
+// terminate stops the loop for good, pending async jobs are dropped
+func (rt *extRuntime) terminate() {
+	rt.loop.Terminate()
 }
 
 type Job struct {
+	pkg   string
 	loop  *eventloop.EventLoop
 	flag  *eventloop.Interval
 	count uint64
+	// limit of jobs in flight, 0 is unlimited
+	limit uint64
+	// ctx of the call currently running on the loop and the func aborting it,
+	// only touched on the loop goroutine
+	ctx   context.Context
+	abort context.CancelCauseFunc
 }
 
-func (j *Job) Add() {
+// Context returns the context of the running call so async work can be aborted with it
+func (j *Job) Context() context.Context {
+	if j.ctx == nil {
+		return context.Background()
+	}
+	return j.ctx
+}
+
+// Add registers an async job, going over the limit aborts the running call
+func (j *Job) Add() error {
+	if j.limit > 0 && j.count >= j.limit {
+		err := &BudgetError{Pkg: j.pkg, Reason: fmt.Sprintf("more than %d async operations in flight", j.limit)}
+		if j.abort != nil {
+			j.abort(err)
+		}
+		return err
+	}
 	j.count++
 
 	if j.count == 1 {
 		j.flag = j.loop.SetInterval(func(r *goja.Runtime) {}, time.Hour*24*365*100)
 	}
+	return nil
 }
 func (j *Job) Done() {
 	j.count--
@@ -75,7 +170,6 @@ func (j *Job) Done() {
 func InitRuntime(extPath string, f embed.FS) {
 
 	exts := filterExts(extPath)
-	fs = f
 	ExtPath = extPath
 
 	jsRoot = filepath.Join(extPath, "root")
@@ -92,13 +186,7 @@ func InitRuntime(extPath string, f embed.FS) {
 	// goja can require them as node js module
 	// readEmbedFileToDisk("assets", jsRoot)
 	WatchDir(extPath)
-	ScriptV1 := string(errorhandle.HandleFatal(fs.ReadFile("assets/runtime_v1.js")))
-	ScriptV2 := string(errorhandle.HandleFatal(fs.ReadFile("assets/runtime_v2.js")))
-	baseV1 = errorhandle.HandleFatal(goja.Compile("runtime_v1.js", ScriptV1, true))
-	baseV2 = errorhandle.HandleFatal(goja.Compile("runtime_v2.js", ScriptV2, true))
-
-	sharedRegistry = require.NewRegistry()
-	initModule()
+	InitBaseRuntime(f)
 	defer func() {
 		if r := recover(); r != nil {
 			if err, ok := r.(map[string]string); ok {
@@ -116,15 +204,62 @@ func InitRuntime(extPath string, f embed.FS) {
 	}
 }
 
+// InitBaseRuntime compiles the base runtimes and registers the js modules
+// from the assets of f, it has to run before any extension is loaded
+func InitBaseRuntime(f iofs.ReadFileFS) {
+	fs = f
+	ScriptV1 := string(errorhandle.HandleFatal(fs.ReadFile("assets/runtime_v1.js")))
+	ScriptV2 := string(errorhandle.HandleFatal(fs.ReadFile("assets/runtime_v2.js")))
+	baseV1 = errorhandle.HandleFatal(goja.Compile("runtime_v1.js", ScriptV1, true))
+	baseV2 = errorhandle.HandleFatal(goja.Compile("runtime_v2.js", ScriptV2, true))
+
+	sharedRegistry = require.NewRegistry()
+	registeredLibraries.Clear()
+	initModule()
+}
+
 func loadExtApi(ext *Ext) {
+	go loadExtension(ext)
+}
+
+func loadExtension(ext *Ext) {
+	ext.loadVerification()
+	if ext.Disabled {
+		showDisabled(ext)
+		return
+	}
+	if e := ext.resolveLibraries(); e != nil {
+		log.Println("Failed to load extension", ext.Pkg, ":", e)
+		ext.Error = e.Error()
+		ApiPkgCache.Store(ext.Pkg, &ExtApi{Ext: ext, asyncCallBack: AsyncCallBack})
+		return
+	}
 	switch ext.ApiVersion {
+	case "3":
+		LoadApiV3(ext)
 	case "2":
-		go LoadApiV2(ext)
+		LoadApiV2(ext)
 	default:
-		go LoadApiV1(ext)
+		LoadApiV1(ext)
 	}
 }
 
+// LoadFile loads a single extension file and waits until it is ready, used
+// to run an extension outside of the extension directory
+func LoadFile(fileLoc string) (*ExtApi, error) {
+	ext := &Ext{Name: filepath.Base(fileLoc)}
+	if e := ext.filterExt(fileLoc); e != nil {
+		return nil, e
+	}
+	ext.loadState()
+	loadExtension(ext)
+	api := ApiPkgCache.Load(ext.Pkg)
+	if api.Ext.Error != "" {
+		return api, errors.New(api.Ext.Error)
+	}
+	return api, nil
+}
+
 // Compile the js before evaluating it
 func compileExtension(ext *Ext) (*goja.Program, error) {
 	compile, e := goja.Compile(ext.Pkg+".js", *ext.Context, true)
@@ -137,57 +272,6 @@ func compileExtension(ext *Ext) (*goja.Program, error) {
 	return compile, e
 }
 
-// Watch the extension directory for changes
-func WatchDir(dir string) {
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		log.Fatal("Failed to start fsnotiy: ", err)
-	}
-
-	go func() {
-		locked := false
-		for {
-			select {
-			case event := <-watcher.Events:
-
-				// Write or Modify event
-				if event.Has(fsnotify.Write|fsnotify.Create) && !locked {
-					log.Println("Modified file:", event.Name)
-					locked = true
-					ext := &Ext{Name: filepath.Base(event.Name)}
-					err := ext.filterExt(event.Name)
-					if err != nil {
-						log.Println("File is not a valid extension:", event.Name)
-						locked = false
-						continue
-					}
-
-					loadExtApi(ext)
-					locked = false
-				}
-
-				// Call when file is missing
-				if event.Has(fsnotify.Rename | fsnotify.Remove) {
-					log.Println("Removed file:", event.Name)
-					name := filepath.Base(event.Name)
-					pkg := strings.TrimSuffix(name, ".js")
-					ApiPkgCache.Delete(pkg)
-				}
-			case err := <-watcher.Errors:
-				if err != nil {
-					log.Println("Error:", err)
-				}
-			}
-		}
-	}()
-
-	err = watcher.Add(dir)
-	log.Println("Watching directory:", dir)
-	if err != nil {
-		log.Fatal(err)
-	}
-}
-
 // replaceClassExtendsDeclaration replaces `class X extends Extension` with `X = class extends Extension {`
 func replaceClassExtendsDeclaration(jsCode string) string {
 	re := regexp.MustCompile(`(?m)^.*class.+extends\s+Extension\s*{.*$`)
@@ -203,6 +287,7 @@ func filterExts(dir string) []*Ext {
 		}
 	}
 	files := errorhandle.HandleFatal(os.ReadDir(dir))
+	states := extensionStates()
 	var exts []*Ext
 	for _, file := range files {
 
@@ -212,9 +297,10 @@ func filterExts(dir string) []*Ext {
 		name := file.Name()
 		ext := &Ext{Name: name}
 		if err := ext.filterExt(dir + "/" + name); err == nil {
+			ext.applyState(states[ext.Pkg])
 			exts = append(exts, ext)
 		} else {
-			ApiPkgCache.Store(name, &ExtApi{Ext: &Ext{Name: name}, service: nil})
+			ApiPkgCache.Store(name, &ExtApi{Ext: &Ext{Name: name, Error: err.Error()}, service: nil})
 		}
 	}
 	return exts
@@ -241,6 +327,12 @@ func (ext *Ext) filterExt(fileLoc string) error {
 func (ext *Ext) ParseExtMetadata(content string, fileName string) error {
 	err := error(nil)
 
+	// API v3 scripts ship a manifest instead of @key comments
+	if ok, e := ext.parseManifestMetadata(content, fileName); ok {
+		ext.Context = &content
+		return e
+	}
+
 	// Regex to match @key value pattern
 
 	re := regexp.MustCompile(`@(\w+)\s+(.*)`)
@@ -278,6 +370,23 @@ func (ext *Ext) ParseExtMetadata(content string, fileName string) error {
 			}
 		case "type":
 			ext.WatchType = value
+		case "require":
+			name, constraint, _ := strings.Cut(value, " ")
+			if ext.Requires == nil {
+				ext.Requires = map[string]string{}
+			}
+			ext.Requires[name] = strings.TrimSpace(constraint)
+		case "domains":
+			// Hosts besides the website the extension requests, `*.example.com` allows subdomains
+			for _, domain := range strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+				return r == ',' || unicode.IsSpace(r)
+			}) {
+				if !domainPattern.MatchString(domain) {
+					err = fmt.Errorf("@domains: %q is not a host name", domain)
+					continue
+				}
+				ext.Domains = append(ext.Domains, domain)
+			}
 		case "tags":
 			// Split tags by comma and trim whitespace
 			tagList := strings.Split(value, ",")
@@ -290,7 +399,7 @@ func (ext *Ext) ParseExtMetadata(content string, fileName string) error {
 
 	// make sure package name + .js equals file name
 
-	if ext.Pkg+".js" != fileName {
+	if err == nil && ext.Pkg+".js" != fileName {
 		err = errors.New("package name does not match the file name \r\n file name:" + fileName + "\r\n package name:" + ext.Pkg)
 	}
 
@@ -26,6 +26,12 @@ type GithubExtension struct {
 	Website     string  `json:"webSite"`
 	IsNsfw      string  `json:"nsfw,omitempty"`
 	Package     string  `json:"package"`
+	// Hash of the script as sha256:<hex>
+	Hash string `json:"hash,omitempty"`
+	// Base64 ed25519 signature of the script by a key trusted by the repository
+	Signature string `json:"signature,omitempty"`
+	// Library entries are shared modules extensions can require, not extensions
+	Library bool `json:"library,omitempty"`
 }
 
 var fetchedExtensionRepo map[string][]GithubExtension
@@ -76,34 +82,67 @@ func FetchExtensionRepo() (map[string][]GithubExtension, map[string]error, error
 }
 
 func DownloadExtension(repoUrl string, pkg string) error {
+	content, v, e := downloadPackage(repoUrl, pkg)
+	if e != nil {
+		return e
+	}
+	upgradeLock.Lock()
+	defer upgradeLock.Unlock()
+	inst, e := installPackage(pkg, content, v)
+	if e != nil && inst != nil && inst.previous != nil {
+		return fmt.Errorf("%v, roll it back to restore the previous version", e)
+	}
+	return e
+}
+
+// findInRepo returns the entry of pkg in the fetched index of repoUrl
+func findInRepo(repoUrl string, pkg string) (*GithubExtension, error) {
 	if len(fetchedExtensionRepo) == 0 {
 		FetchExtensionRepo()
 	}
 	repo, ok := fetchedExtensionRepo[repoUrl]
 	if !ok {
-		return fmt.Errorf("package %s not found in %s", pkg, repoUrl)
+		return nil, fmt.Errorf("package %s not found in %s", pkg, repoUrl)
 	}
-	link, e := url.Parse(repoUrl)
+	for i := range repo {
+		if repo[i].Package == pkg && !repo[i].Library {
+			return &repo[i], nil
+		}
+	}
+	return nil, fmt.Errorf("package %s not found in repository %s", pkg, repoUrl)
+}
+
+// downloadPackage downloads the script of pkg from the repository at repoUrl
+// and verifies it against the index
+func downloadPackage(repoUrl string, pkg string) ([]byte, *Verification, error) {
+	ext, e := findInRepo(repoUrl, pkg)
 	if e != nil {
-		return fmt.Errorf("invalid repository URL: %s", repoUrl)
+		return nil, nil, e
+	}
+	content, e := fetchScript(repoUrl, ext.Package)
+	if e != nil {
+		return nil, nil, e
+	}
+	v, e := verifyDownload(repoUrl, ext, content)
+	if e != nil {
+		return nil, nil, e
 	}
-	for _, ext := range repo {
+	return content, v, nil
+}
 
-		if ext.Package == pkg {
-			link.Path = path.Join(path.Dir(link.Path), "repo", ext.Package+".js")
-			fileName := path.Base(link.Path)
-			res, e := network.Request[[]byte](link.String(), &network.RequestOptions{Method: "GET"}, network.ReadAll)
-			if e != nil {
-				return fmt.Errorf("failed to download package %s from %s: %v", pkg, link.String(), e)
-			}
-			if e := network.SaveFile(filepath.Join(ExtPath, fileName), &res.Body); e != nil {
-				return fmt.Errorf("failed to save js extension %s to %s: %v", pkg, ExtPath, e)
-			}
-			log.Println("Downloaded package:", ext.Package, "from", link.String())
-			return nil
-		}
+// fetchScript downloads repo/<pkg>.js next to the index at repoUrl
+func fetchScript(repoUrl string, pkg string) ([]byte, error) {
+	link, e := url.Parse(repoUrl)
+	if e != nil {
+		return nil, fmt.Errorf("invalid repository URL: %s", repoUrl)
+	}
+	link.Path = path.Join(path.Dir(link.Path), "repo", pkg+".js")
+	res, e := network.Request[[]byte](link.String(), &network.RequestOptions{Method: "GET"}, network.ReadAll)
+	if e != nil {
+		return nil, fmt.Errorf("failed to download package %s from %s: %v", pkg, link.String(), e)
 	}
-	return fmt.Errorf("package %s not found in repository %s", pkg, repoUrl)
+	log.Println("Downloaded package:", pkg, "from", link.String())
+	return res.Body, nil
 }
 
 func RemoveExtensionRepo(id string) error {
@@ -117,6 +156,20 @@ func RemoveExtension(pkg string) error {
 		return fmt.Errorf("failed to delete extension file %s: %v", loc, e)
 	}
 	log.Println("Deleted extension file:", loc)
+	discardPrevious(pkg)
+	if e := db.DeleteVerification(pkg); e != nil {
+		log.Println("Failed to delete the verification of", pkg, ":", e)
+	}
+	if e := db.DeleteVerificationFailure(pkg); e != nil {
+		log.Println("Failed to delete the failed verification of", pkg, ":", e)
+	}
+	if e := db.DeleteExtensionState(pkg); e != nil {
+		log.Println("Failed to delete the state of", pkg, ":", e)
+	}
+	if e := db.ClearStorage(pkg); e != nil {
+		log.Println("Failed to clear the storage of", pkg, ":", e)
+	}
+	ClearLogs(pkg)
 	ApiPkgCache.Remove(pkg)
 	return nil
 }
@@ -0,0 +1,247 @@
+package jsExtension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/pkg/db"
+	"github.com/miru-project/miru-core/pkg/event"
+	log "github.com/miru-project/miru-core/pkg/logger"
+	"github.com/miru-project/miru-core/proto/generate/proto"
+)
+
+// favoriteCheckLock keeps checks from overlapping when one outlasts the ticker
+var favoriteCheckLock sync.Mutex
+
+func favoriteCheckConcurrency() int {
+	if config.Global.FavoriteCheckConcurrency > 0 {
+		return config.Global.FavoriteCheckConcurrency
+	}
+	return config.DefaultFavoriteCheckConcurrency
+}
+
+// favoriteCheckInterval is how often f is checked, 0 never. The shortest
+// interval of its groups wins, groups without one and favorites without a
+// group use config.Global.FavoriteCheckInterval
+func favoriteCheckInterval(f *ent.Favorite) time.Duration {
+	def := config.Global.FavoriteCheckInterval
+	if len(f.Edges.Group) == 0 {
+		return time.Duration(max(def, 0)) * time.Minute
+	}
+	shortest := 0
+	for _, g := range f.Edges.Group {
+		minutes := def
+		if g.CheckInterval != nil {
+			minutes = *g.CheckInterval
+		}
+		if minutes > 0 && (shortest == 0 || minutes < shortest) {
+			shortest = minutes
+		}
+	}
+	return time.Duration(shortest) * time.Minute
+}
+
+// favoriteDue reports whether the interval of f passed since it was checked
+func favoriteDue(f *ent.Favorite, now time.Time) bool {
+	interval := favoriteCheckInterval(f)
+	if interval <= 0 {
+		return false
+	}
+	return f.CheckedAt == nil || !now.Before(f.CheckedAt.Add(interval))
+}
+
+// CheckFavorites fetches the detail of every favorite that is due, or of all
+// of them when force is set, and returns the episodes that were not in the
+// stored detail. A new episodes event is sent when something was found.
+// Favorites of extensions that aren't installed are left for later
+func CheckFavorites(ctx context.Context, force bool) ([]*ent.EpisodeUpdate, error) {
+	if !favoriteCheckLock.TryLock() {
+		return nil, errors.New("favorites are already being checked")
+	}
+	defer favoriteCheckLock.Unlock()
+
+	favorites, e := db.GetFavoritesWithGroups()
+	if e != nil {
+		return nil, e
+	}
+	now := time.Now()
+	byPkg := map[string][]*ent.Favorite{}
+	for _, f := range favorites {
+		if force || favoriteDue(f, now) {
+			byPkg[f.Package] = append(byPkg[f.Package], f)
+		}
+	}
+
+	var (
+		lock  sync.Mutex
+		found []*ent.EpisodeUpdate
+		wg    sync.WaitGroup
+	)
+	limit := favoriteCheckConcurrency()
+	for pkg, list := range byPkg {
+		if _, e := getPkgFromCache(pkg); e != nil {
+			log.Println("Skipping favorites of", pkg, ":", e)
+			continue
+		}
+		sem := make(chan struct{}, limit)
+		for _, f := range list {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				updates, e := checkFavorite(ctx, f, now)
+				if e != nil {
+					log.Println("Failed to check favorite", f.Package, f.URL, ":", e)
+					return
+				}
+				lock.Lock()
+				found = append(found, updates...)
+				lock.Unlock()
+			}()
+		}
+	}
+	wg.Wait()
+
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].Package != found[j].Package {
+			return found[i].Package < found[j].Package
+		}
+		return found[i].DetailURL < found[j].DetailURL
+	})
+	if len(found) > 0 {
+		event.SendNewEpisodes(found)
+	}
+	return found, nil
+}
+
+// checkFavorite diffs the episodes the extension returns for f against the
+// stored detail and returns the new ones as they were recorded. The first
+// check only stores the episodes as the baseline. f is marked as checked even
+// when it fails so a broken source isn't retried every tick, unless the check
+// was cancelled
+func checkFavorite(ctx context.Context, f *ent.Favorite, now time.Time) ([]*ent.EpisodeUpdate, error) {
+	defer func() {
+		if ctx.Err() != nil {
+			return
+		}
+		if e := db.SetFavoriteChecked(f.ID, now); e != nil {
+			log.Println("Failed to mark favorite checked", f.Package, f.URL, ":", e)
+		}
+	}()
+
+	detail, e := Detail[proto.ExtensionDetail](ctx, f.Package, f.URL)
+	if e != nil {
+		return nil, e
+	}
+	data, e := json.Marshal(detail.Episodes)
+	if e != nil {
+		return nil, e
+	}
+	episodes := string(data)
+
+	stored, e := db.GetDetailByPackageAndUrl(f.Package, f.URL)
+	if e != nil && !ent.IsNotFound(e) {
+		return nil, e
+	}
+	if stored == nil {
+		title, cover := detail.Title, detail.Cover
+		if title == nil || *title == "" {
+			title = &f.Title
+		}
+		if cover == nil {
+			cover = f.Cover
+		}
+		_, e := db.UpsertDetail(&ent.Detail{
+			Package:   f.Package,
+			DetailUrl: f.URL,
+			Title:     title,
+			Cover:     cover,
+			Desc:      detail.Desc,
+			Episodes:  &episodes,
+		})
+		return nil, e
+	}
+
+	var known []*proto.ExtensionEpisodeGroup
+	baseline := stored.Episodes == nil
+	if !baseline {
+		if e := json.Unmarshal([]byte(*stored.Episodes), &known); e != nil {
+			log.Println("Replacing unreadable episodes of", f.Package, f.URL, ":", e)
+			baseline = true
+		}
+	}
+	var updates []*ent.EpisodeUpdate
+	if !baseline {
+		updates = newEpisodes(f, known, detail.Episodes, now)
+	}
+	// Updates are recorded first so a failed write finds them again next time
+	if len(updates) > 0 {
+		if updates, e = db.AddEpisodeUpdates(updates); e != nil {
+			return nil, e
+		}
+	}
+	if stored.Episodes == nil || *stored.Episodes != episodes {
+		if e := db.SetDetailEpisodes(f.Package, f.URL, episodes); e != nil {
+			return nil, e
+		}
+	}
+	return updates, nil
+}
+
+// newEpisodes returns the episodes of current whose url is not in known
+func newEpisodes(f *ent.Favorite, known, current []*proto.ExtensionEpisodeGroup, now time.Time) []*ent.EpisodeUpdate {
+	seen := map[string]bool{}
+	for _, g := range known {
+		if g == nil {
+			continue
+		}
+		for _, ep := range g.Urls {
+			if ep != nil {
+				seen[ep.Url] = true
+			}
+		}
+	}
+	var updates []*ent.EpisodeUpdate
+	for _, g := range current {
+		if g == nil {
+			continue
+		}
+		for _, ep := range g.Urls {
+			if ep == nil || ep.Url == "" || seen[ep.Url] {
+				continue
+			}
+			seen[ep.Url] = true
+			updates = append(updates, &ent.EpisodeUpdate{
+				Package:    f.Package,
+				DetailURL:  f.URL,
+				Title:      f.Title,
+				GroupTitle: g.Title,
+				Name:       ep.Name,
+				URL:        ep.Url,
+				Date:       now,
+			})
+		}
+	}
+	return updates
+}
+
+// StartFavoriteChecker looks for favorites that are due every minute, the
+// intervals themselves come from the config and the favorite groups
+func StartFavoriteChecker() {
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for range ticker.C {
+			if _, e := CheckFavorites(context.Background(), false); e != nil {
+				log.Println("Failed to check favorites:", e)
+			}
+		}
+	}()
+}
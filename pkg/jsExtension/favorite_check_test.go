@@ -0,0 +1,139 @@
+package jsExtension
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/ent/detail"
+	"github.com/miru-project/miru-core/ext"
+	"github.com/miru-project/miru-core/pkg/db"
+	"github.com/miru-project/miru-core/pkg/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckFavorites(t *testing.T) {
+	useTestDatabase(t)
+	interval := config.Global.FavoriteCheckInterval
+	config.Global.FavoriteCheckInterval = 60
+	t.Cleanup(func() { config.Global.FavoriteCheckInterval = interval })
+
+	// The episodes come from storage so every runtime of the pool agrees on them
+	loadTestExtension(t, "test.favorite", `
+async function detail(url) {
+  const count = (await Miru.storage.get("count")) ?? 1;
+  const urls = [];
+  for (let i = 1; i <= count; i++) urls.push({ name: "Episode " + i, url: url + "/" + i });
+  return { title: "Show", episodes: [{ title: "Season 1", urls }] };
+}`)
+	fav, err := db.PutFavorite("https://example.com/show", nil, "test.favorite", "video", "Show")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.DeleteFavorite(fav.URL, fav.Package)
+		db.DeleteEpisodeUpdates(fav.Package, "")
+		db.ClearStorage(fav.Package)
+		ext.EntClient().Detail.Delete().Where(detail.Package(fav.Package)).ExecX(context.Background())
+	})
+
+	ch := event.GlobalBus.Subscribe()
+	defer event.GlobalBus.Unsubscribe(ch)
+
+	// The first check only stores the baseline
+	found, err := CheckFavorites(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+	stored, err := db.GetDetailByPackageAndUrl("test.favorite", fav.URL)
+	assert.NoError(t, err)
+	if assert.NotNil(t, stored) && assert.NotNil(t, stored.Episodes) {
+		assert.Contains(t, *stored.Episodes, "https://example.com/show/1")
+	}
+
+	// Nothing is due until the interval passed
+	assert.NoError(t, db.SetStorage("test.favorite", "count", "3", nil, storageQuota()))
+	found, err = CheckFavorites(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+
+	found, err = CheckFavorites(context.Background(), true)
+	assert.NoError(t, err)
+	if assert.Len(t, found, 2) {
+		assert.Equal(t, "Episode 2", found[0].Name)
+		assert.Equal(t, "https://example.com/show/3", found[1].URL)
+		assert.Equal(t, "Season 1", found[1].GroupTitle)
+		assert.Equal(t, "Show", found[1].Title)
+		// The recorded rows are returned
+		assert.NotZero(t, found[0].ID)
+		assert.NotZero(t, found[1].ID)
+	}
+	select {
+	case e := <-ch:
+		assert.Equal(t, event.NewEpisodes, e.Type)
+		assert.Equal(t, found, e.Data)
+	case <-time.After(time.Second):
+		t.Fatal("no new episodes event")
+	}
+
+	// Episodes are only reported once
+	found, err = CheckFavorites(context.Background(), true)
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+	updates, err := db.GetEpisodeUpdates("test.favorite", fav.URL)
+	assert.NoError(t, err)
+	assert.Len(t, updates, 2)
+
+	// Episodes recorded before are not reported again when the stored detail
+	// lost them, e.g. after the client saved an older detail
+	assert.NoError(t, db.SetDetailEpisodes("test.favorite", fav.URL, `[{"title":"Season 1","urls":[{"name":"Episode 1","url":"https://example.com/show/1"}]}]`))
+	found, err = CheckFavorites(context.Background(), true)
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+
+	// Cancelled checks leave the favorite due
+	f, err := db.GetFavoriteByPackageAndUrl("test.favorite", fav.URL)
+	assert.NoError(t, err)
+	checkedAt := f.CheckedAt
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = checkFavorite(ctx, f, time.Now().Add(time.Hour))
+	assert.Error(t, err)
+	f, err = db.GetFavoriteByPackageAndUrl("test.favorite", fav.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, checkedAt.Unix(), f.CheckedAt.Unix())
+
+	assert.NoError(t, db.DeleteEpisodeUpdates("test.favorite", fav.URL))
+	updates, err = db.GetEpisodeUpdates("test.favorite", "")
+	assert.NoError(t, err)
+	assert.Empty(t, updates)
+}
+
+func TestFavoriteCheckInterval(t *testing.T) {
+	interval := config.Global.FavoriteCheckInterval
+	t.Cleanup(func() { config.Global.FavoriteCheckInterval = interval })
+	minutes := func(m int) *int { return &m }
+	group := func(m *int) *ent.FavoriteGroup { return &ent.FavoriteGroup{CheckInterval: m} }
+	favorite := func(groups ...*ent.FavoriteGroup) *ent.Favorite {
+		return &ent.Favorite{Edges: ent.FavoriteEdges{Group: groups}}
+	}
+
+	config.Global.FavoriteCheckInterval = 0
+	assert.Zero(t, favoriteCheckInterval(favorite()))
+	assert.Zero(t, favoriteCheckInterval(favorite(group(nil))))
+	assert.Equal(t, 30*time.Minute, favoriteCheckInterval(favorite(group(nil), group(minutes(30)))))
+
+	config.Global.FavoriteCheckInterval = 60
+	assert.Equal(t, time.Hour, favoriteCheckInterval(favorite()))
+	assert.Equal(t, time.Hour, favoriteCheckInterval(favorite(group(minutes(0)), group(nil))))
+	assert.Zero(t, favoriteCheckInterval(favorite(group(minutes(0)))))
+	assert.Equal(t, 10*time.Minute, favoriteCheckInterval(favorite(group(minutes(10)), group(minutes(120)))))
+
+	now := time.Now()
+	f := favorite()
+	assert.True(t, favoriteDue(f, now))
+	checked := now.Add(-30 * time.Minute)
+	f.CheckedAt = &checked
+	assert.False(t, favoriteDue(f, now))
+	checked = now.Add(-time.Hour)
+	assert.True(t, favoriteDue(f, now))
+}
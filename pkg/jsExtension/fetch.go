@@ -1,243 +1,585 @@
 package jsExtension
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"mime/multipart"
 	"net/http"
-	"reflect"
+	"strings"
+	"time"
 
 	"github.com/dop251/goja"
 	"github.com/miru-project/miru-core/pkg/network"
 )
 
-func createRequestCtor(vm *goja.Runtime) func(call goja.ConstructorCall) *goja.Object {
-	return func(call goja.ConstructorCall) *goja.Object {
-		self := call.This
-		url := ""
-		method := "GET"
-		headers := map[string]string{}
-		body := ""
-
-		if len(call.Arguments) > 0 {
-			arg0 := call.Argument(0)
-			if arg0.ExportType().Kind() == reflect.String {
-				url = arg0.String()
-			} else if obj, ok := arg0.Export().(map[string]interface{}); ok {
-				if u, ok := obj["url"].(string); ok {
-					url = u
-				}
-			}
+// maxRedirects is how many redirects fetch follows, the limit of the fetch spec
+const maxRedirects = 20
+
+// fetchBridge implements fetch and the classes around it for one runtime.
+// Request, AbortSignal and FormData objects keep their go state under a
+// symbol the scripts can't see
+type fetchBridge struct {
+	vm  *goja.Runtime
+	key *goja.Symbol
+}
+
+// fetchRequest is a request read from the fetch arguments on the loop, the
+// network call runs without touching the runtime
+type fetchRequest struct {
+	url      string
+	method   string
+	headers  map[string]string
+	body     []byte
+	redirect string
+	timeout  int
+	signal   *abortSignal
+}
+
+func (f *fetchBridge) state(v goja.Value) any {
+	obj, ok := v.(*goja.Object)
+	if !ok {
+		return nil
+	}
+	if s := obj.GetSymbol(f.key); s != nil {
+		return s.Export()
+	}
+	return nil
+}
+
+func (f *fetchBridge) setState(obj *goja.Object, state any) {
+	obj.DefineDataPropertySymbol(f.key, f.vm.ToValue(state), goja.FLAG_FALSE, goja.FLAG_FALSE, goja.FLAG_FALSE)
+}
+
+func isSet(v goja.Value) bool {
+	return v != nil && !goja.IsUndefined(v) && !goja.IsNull(v)
+}
+
+// bytesOf copies the content of an ArrayBuffer or of a view on one such as
+// Uint8Array, DataView or Buffer
+func (f *fetchBridge) bytesOf(v goja.Value) ([]byte, bool) {
+	data, ok := viewBytes(f.vm, v)
+	if !ok {
+		return nil, false
+	}
+	return bytes.Clone(data), true
+}
+
+// body encodes a request or response body and returns the content type that
+// goes with it
+func (f *fetchBridge) body(v goja.Value) ([]byte, string) {
+	if !isSet(v) {
+		return nil, ""
+	}
+	if data, ok := f.bytesOf(v); ok {
+		return data, ""
+	}
+	if form, ok := f.state(v).(*formData); ok {
+		return form.encode()
+	}
+	if params, ok := f.vm.Get("URLSearchParams").(*goja.Object); ok {
+		if obj, ok := v.(*goja.Object); ok && obj.ClassName() != "String" && f.vm.InstanceOf(obj, params) {
+			return []byte(v.String()), "application/x-www-form-urlencoded;charset=UTF-8"
 		}
-		if len(call.Arguments) > 1 {
-			opts := call.Argument(1).ToObject(vm)
-			if v := opts.Get("method"); v != nil && !goja.IsUndefined(v) {
-				method = v.String()
-			}
-			if v := opts.Get("headers"); v != nil && !goja.IsUndefined(v) {
-				if m, ok := v.Export().(map[string]interface{}); ok {
-					for k, val := range m {
-						headers[k] = fmt.Sprint(val)
-					}
-				}
-			}
-			if v := opts.Get("body"); v != nil && !goja.IsUndefined(v) {
-				body = v.String()
+	}
+	return []byte(v.String()), "text/plain;charset=UTF-8"
+}
+
+// headers reads a headers init, an object or a list of name and value pairs
+func headers(v goja.Value, into map[string]string) {
+	if !isSet(v) {
+		return
+	}
+	switch h := v.Export().(type) {
+	case []any:
+		for _, pair := range h {
+			if kv, ok := pair.([]any); ok && len(kv) == 2 {
+				into[fmt.Sprint(kv[0])] = fmt.Sprint(kv[1])
 			}
 		}
+	case map[string]any:
+		for k, val := range h {
+			into[k] = fmt.Sprint(val)
+		}
+	}
+}
 
-		self.Set("url", url)
-		self.Set("method", method)
-		self.Set("headers", headers)
-		self.Set("body", body)
-		return self
+func hasHeader(headers map[string]string, name string) bool {
+	for k := range headers {
+		if strings.EqualFold(k, name) {
+			return true
+		}
 	}
+	return false
 }
 
-// Helper to create a JS constructor for Response
-func createResponseCtor(vm *goja.Runtime) func(call goja.ConstructorCall) *goja.Object {
-	return func(call goja.ConstructorCall) *goja.Object {
-		self := call.This
-		body := ""
-		status := 200
-		statusText := "OK"
-		headers := map[string]string{}
+// readInit applies the options of a fetch or Request init to req
+func (f *fetchBridge) readInit(req *fetchRequest, init *goja.Object) {
+	if v := init.Get("method"); isSet(v) {
+		req.method = strings.ToUpper(v.String())
+	}
+	if v := init.Get("headers"); isSet(v) {
+		req.headers = map[string]string{}
+		headers(v, req.headers)
+	}
+	if v := init.Get("body"); isSet(v) {
+		body, contentType := f.body(v)
+		req.body = body
+		if contentType != "" && !hasHeader(req.headers, "Content-Type") {
+			req.headers["Content-Type"] = contentType
+		}
+	}
+	if v := init.Get("redirect"); isSet(v) {
+		req.redirect = v.String()
+	}
+	if v := init.Get("signal"); isSet(v) {
+		req.signal, _ = f.state(v).(*abortSignal)
+	}
+	if v := init.Get("timeout"); isSet(v) {
+		req.timeout = int(v.ToInteger())
+	}
+}
 
-		if len(call.Arguments) > 0 {
-			body = call.Argument(0).String()
+// request reads the arguments of fetch and of the Request constructor
+func (f *fetchBridge) request(resource goja.Value, init goja.Value) (*fetchRequest, error) {
+	req := &fetchRequest{method: "GET", headers: map[string]string{}, redirect: "follow"}
+	if from, ok := f.state(resource).(*fetchRequest); ok {
+		*req = *from
+		req.headers = map[string]string{}
+		for k, v := range from.headers {
+			req.headers[k] = v
 		}
-		if len(call.Arguments) > 1 {
-			opts := call.Argument(1).ToObject(vm)
-			if v := opts.Get("status"); v != nil && !goja.IsUndefined(v) {
-				status = int(v.ToInteger())
-			}
-			if v := opts.Get("statusText"); v != nil && !goja.IsUndefined(v) {
-				statusText = v.String()
-			}
-			if v := opts.Get("headers"); v != nil && !goja.IsUndefined(v) {
-				if m, ok := v.Export().(map[string]interface{}); ok {
-					for k, val := range m {
-						headers[k] = fmt.Sprint(val)
-					}
-				}
+	} else if obj, ok := resource.(*goja.Object); ok && isSet(obj.Get("url")) {
+		// Options objects carrying the url were accepted before Request existed
+		req.url = obj.Get("url").String()
+		f.readInit(req, obj)
+	} else if isSet(resource) {
+		req.url = resource.String()
+	} else {
+		return nil, errors.New("fetch needs a url or a Request")
+	}
+	if obj, ok := init.(*goja.Object); ok {
+		f.readInit(req, obj)
+	}
+	switch req.redirect {
+	case "follow", "manual", "error":
+	default:
+		return nil, fmt.Errorf("invalid redirect mode %q", req.redirect)
+	}
+	if len(req.body) > 0 && (req.method == "GET" || req.method == "HEAD") {
+		return nil, fmt.Errorf("a %s request can't have a body", req.method)
+	}
+	return req, nil
+}
+
+func (f *fetchBridge) requestCtor(call goja.ConstructorCall) *goja.Object {
+	req, e := f.request(call.Argument(0), call.Argument(1))
+	if e != nil {
+		panic(f.vm.NewTypeError("%v", e))
+	}
+	self := call.This
+	f.setState(self, req)
+	self.Set("url", req.url)
+	self.Set("method", req.method)
+	self.Set("headers", req.headers)
+	self.Set("redirect", req.redirect)
+	if req.signal != nil {
+		self.Set("signal", call.Argument(1).ToObject(f.vm).Get("signal"))
+	}
+	if req.body != nil {
+		self.Set("body", string(req.body))
+	}
+	return self
+}
+
+// fetchResponse is what a Response object is built from
+type fetchResponse struct {
+	status     int
+	statusText string
+	headers    map[string]string
+	body       []byte
+	url        string
+	redirected bool
+}
+
+// initResponse sets the fields and the body methods of a Response on obj
+func (f *fetchBridge) initResponse(obj *goja.Object, res *fetchResponse) {
+	vm := f.vm
+	resolved := func(v func() (goja.Value, error)) func() *goja.Promise {
+		return func() *goja.Promise {
+			p, resolve, reject := vm.NewPromise()
+			if val, e := v(); e != nil {
+				reject(vm.NewGoError(e))
+			} else {
+				resolve(val)
 			}
+			return p
 		}
+	}
 
-		self.Set("body", body)
-		self.Set("status", status)
-		self.Set("statusText", statusText)
-		self.Set("headers", headers)
-		self.Set("json", func(call goja.FunctionCall) goja.Value {
-			var v interface{}
-			err := json.Unmarshal([]byte(body), &v)
-			if err != nil {
-				panic(vm.ToValue(err.Error()))
-			}
-			return vm.ToValue(v)
-		})
-		self.Set("text", func(call goja.FunctionCall) goja.Value {
-			return vm.ToValue(body)
-		})
-		return self
-	}
-}
-
-func createAbortSignalCtor() func(call goja.ConstructorCall) *goja.Object {
-	return func(call goja.ConstructorCall) *goja.Object {
-		self := call.This
-		self.Set("aborted", false)
-		self.Set("onabort", nil)
-		self.Set("addEventListener", func(call goja.FunctionCall) goja.Value {
-			// No-op for now
-			return goja.Undefined()
-		})
-		return self
-	}
-}
-
-func createAbortControllerCtor(vm *goja.Runtime) func(call goja.ConstructorCall) *goja.Object {
-	return func(call goja.ConstructorCall) *goja.Object {
-		self := call.This
-		// Create signal object
-		signalObj := vm.NewObject()
-		signalObj.Set("aborted", false)
-		signalObj.Set("onabort", nil)
-		signalObj.Set("addEventListener", func(call goja.FunctionCall) goja.Value {
-			// No-op for now
-			return goja.Undefined()
-		})
-		self.Set("signal", signalObj)
-		self.Set("abort", func(call goja.FunctionCall) goja.Value {
-			signalObj.Set("aborted", true)
-			// Call onabort if set
-			onabort := signalObj.Get("onabort")
-			if onabort != nil && !goja.IsUndefined(onabort) && onabort != goja.Null() {
-				if fn, ok := goja.AssertFunction(onabort); ok {
-					_, _ = fn(goja.Undefined(), nil)
-				}
+	headers := vm.NewObject()
+	for k, v := range res.headers {
+		headers.Set(k, v)
+	}
+	// get is not enumerable so the headers still read like a plain object
+	headers.DefineDataProperty("get", vm.ToValue(func(name string) goja.Value {
+		for k, v := range res.headers {
+			if strings.EqualFold(k, name) {
+				return vm.ToValue(v)
 			}
-			return goja.Undefined()
-		})
-		return self
+		}
+		return goja.Null()
+	}), goja.FLAG_TRUE, goja.FLAG_FALSE, goja.FLAG_TRUE)
+
+	obj.Set("status", res.status)
+	obj.Set("statusText", res.statusText)
+	obj.Set("ok", res.status >= 200 && res.status < 300)
+	obj.Set("url", res.url)
+	obj.Set("redirected", res.redirected)
+	obj.Set("headers", headers)
+	// data is the body as a string, extensions read it before text() existed
+	obj.Set("data", string(res.body))
+	obj.Set("text", resolved(func() (goja.Value, error) {
+		return vm.ToValue(string(res.body)), nil
+	}))
+	obj.Set("json", resolved(func() (goja.Value, error) {
+		var v any
+		if e := json.Unmarshal(res.body, &v); e != nil {
+			return nil, e
+		}
+		return vm.ToValue(v), nil
+	}))
+	obj.Set("arrayBuffer", resolved(func() (goja.Value, error) {
+		return vm.ToValue(vm.NewArrayBuffer(bytes.Clone(res.body))), nil
+	}))
+	obj.Set("bytes", resolved(func() (goja.Value, error) {
+		return vm.New(vm.Get("Uint8Array"), vm.ToValue(vm.NewArrayBuffer(bytes.Clone(res.body))))
+	}))
+}
+
+func (f *fetchBridge) responseCtor(call goja.ConstructorCall) *goja.Object {
+	res := &fetchResponse{status: 200, statusText: "OK", headers: map[string]string{}}
+	body, contentType := f.body(call.Argument(0))
+	res.body = body
+	if opts, ok := call.Argument(1).(*goja.Object); ok {
+		if v := opts.Get("status"); isSet(v) {
+			res.status = int(v.ToInteger())
+			res.statusText = http.StatusText(res.status)
+		}
+		if v := opts.Get("statusText"); isSet(v) {
+			res.statusText = v.String()
+		}
+		headers(opts.Get("headers"), res.headers)
 	}
+	if contentType != "" && !hasHeader(res.headers, "Content-Type") {
+		res.headers["Content-Type"] = contentType
+	}
+	f.initResponse(call.This, res)
+	return call.This
 }
 
-func (ser *ExtBaseService) initFetch(vm *goja.Runtime, job *Job) {
-	vm.Set("Request", createRequestCtor(vm))
-	vm.Set("Response", createResponseCtor(vm))
-	vm.Set("AbortSignal", createAbortSignalCtor())
-	vm.Set("AbortController", createAbortControllerCtor(vm))
+// abortSignal is the state of an AbortSignal, only touched on the loop
+type abortSignal struct {
+	obj     *goja.Object
+	aborted bool
+	reason  goja.Value
+	// cancels of the requests using the signal
+	cancels   map[int]context.CancelFunc
+	next      int
+	listeners []goja.Value
+}
 
-	// fetch(resource, options)
-	ser.createSingleChannel(vm, "fetch", job, func(call goja.FunctionCall, resolve func(any) error) any {
-		var fetchUrl string
-		requestOptions := network.RequestOptions{
-			Headers: make(map[string]string),
-			Method:  "GET",
+// newSignal makes obj an AbortSignal
+func (f *fetchBridge) newSignal(obj *goja.Object) *abortSignal {
+	vm := f.vm
+	s := &abortSignal{obj: obj, reason: goja.Undefined(), cancels: map[int]context.CancelFunc{}}
+	f.setState(obj, s)
+	obj.DefineAccessorProperty("aborted", vm.ToValue(func() bool { return s.aborted }), nil, goja.FLAG_FALSE, goja.FLAG_TRUE)
+	obj.DefineAccessorProperty("reason", vm.ToValue(func() goja.Value { return s.reason }), nil, goja.FLAG_FALSE, goja.FLAG_TRUE)
+	obj.Set("onabort", goja.Null())
+	obj.Set("addEventListener", func(event string, listener goja.Value) {
+		if event == "abort" {
+			s.listeners = append(s.listeners, listener)
+		}
+	})
+	obj.Set("removeEventListener", func(event string, listener goja.Value) {
+		for i, l := range s.listeners {
+			if l.SameAs(listener) {
+				s.listeners = append(s.listeners[:i], s.listeners[i+1:]...)
+				return
+			}
+		}
+	})
+	obj.Set("throwIfAborted", func() {
+		if s.aborted {
+			panic(s.reason)
+		}
+	})
+	return s
+}
+
+// abort cancels the requests using the signal and runs its listeners
+func (f *fetchBridge) abort(s *abortSignal, reason goja.Value) {
+	if s.aborted {
+		return
+	}
+	if !isSet(reason) {
+		reason = newDOMException(f.vm, "AbortError", "This operation was aborted")
+	}
+	s.aborted, s.reason = true, reason
+	for _, cancel := range s.cancels {
+		cancel()
+	}
+	event := f.vm.NewObject()
+	event.Set("type", "abort")
+	event.Set("target", s.obj)
+	listeners := append([]goja.Value{s.obj.Get("onabort")}, s.listeners...)
+	for _, l := range listeners {
+		if fn, ok := goja.AssertFunction(l); ok {
+			fn(s.obj, event)
 		}
+	}
+}
+
+// onAbort registers cancel until the returned func is called
+func (s *abortSignal) onAbort(cancel context.CancelFunc) func() {
+	id := s.next
+	s.next++
+	s.cancels[id] = cancel
+	return func() {
+		delete(s.cancels, id)
+	}
+}
 
-		arg0 := call.Argument(0)
-		var optsVal *goja.Object
+func (f *fetchBridge) abortControllerCtor(call goja.ConstructorCall) *goja.Object {
+	signal := f.vm.NewObject()
+	s := f.newSignal(signal)
+	call.This.Set("signal", signal)
+	call.This.Set("abort", func(reason goja.Value) {
+		f.abort(s, reason)
+	})
+	return call.This
+}
+
+// formEntry is a field of a FormData, files carry data and a filename
+type formEntry struct {
+	name     string
+	value    string
+	data     []byte
+	filename string
+	file     bool
+}
+
+// formData is the state of a FormData, encoded as multipart/form-data
+type formData struct {
+	entries []formEntry
+}
+
+func (form *formData) encode() ([]byte, string) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, entry := range form.entries {
+		if !entry.file {
+			w.WriteField(entry.name, entry.value)
+			continue
+		}
+		part, _ := w.CreateFormFile(entry.name, entry.filename)
+		part.Write(entry.data)
+	}
+	w.Close()
+	return buf.Bytes(), w.FormDataContentType()
+}
 
-		if arg0.ExportType().Kind() == reflect.String {
-			fetchUrl = arg0.String()
-			if len(call.Arguments) > 1 && !goja.IsUndefined(call.Argument(1)) {
-				optsVal = call.Argument(1).ToObject(vm)
+func (f *fetchBridge) formDataCtor(call goja.ConstructorCall) *goja.Object {
+	vm := f.vm
+	form := &formData{}
+	f.setState(call.This, form)
+	entry := func(name string, value goja.Value, filename goja.Value) formEntry {
+		if data, ok := f.bytesOf(value); ok {
+			e := formEntry{name: name, data: data, filename: "blob", file: true}
+			if isSet(filename) {
+				e.filename = filename.String()
 			}
-		} else if obj, ok := arg0.Export().(map[string]any); ok {
-			if u, ok := obj["url"].(string); ok {
-				fetchUrl = u
+			return e
+		}
+		return formEntry{name: name, value: value.String()}
+	}
+	// files read back as ArrayBuffers
+	value := func(e formEntry) goja.Value {
+		if e.file {
+			return vm.ToValue(vm.NewArrayBuffer(bytes.Clone(e.data)))
+		}
+		return vm.ToValue(e.value)
+	}
+	without := func(name string) []formEntry {
+		var kept []formEntry
+		for _, e := range form.entries {
+			if e.name != name {
+				kept = append(kept, e)
 			}
-			optsVal = arg0.ToObject(vm)
-		} else {
-			panic("Miru_core(fetch): resource is not String or Object")
 		}
+		return kept
+	}
 
-		if optsVal != nil {
-			if v := optsVal.Get("method"); v != nil && !goja.IsUndefined(v) {
-				requestOptions.Method = v.String()
-			}
-			if v := optsVal.Get("headers"); v != nil && !goja.IsUndefined(v) {
-				if m, ok := v.Export().(map[string]interface{}); ok {
-					for k, val := range m {
-						requestOptions.Headers[k] = fmt.Sprint(val)
-					}
-				}
+	self := call.This
+	self.Set("append", func(name string, v goja.Value, filename goja.Value) {
+		form.entries = append(form.entries, entry(name, v, filename))
+	})
+	self.Set("set", func(name string, v goja.Value, filename goja.Value) {
+		// the first entry named name is replaced, the others are removed
+		var entries []formEntry
+		replaced := false
+		for _, e := range form.entries {
+			if e.name != name {
+				entries = append(entries, e)
+			} else if !replaced {
+				entries = append(entries, entry(name, v, filename))
+				replaced = true
 			}
-			if v := optsVal.Get("body"); v != nil && !goja.IsUndefined(v) {
-				requestOptions.RequestBody = v.String()
+		}
+		if !replaced {
+			entries = append(entries, entry(name, v, filename))
+		}
+		form.entries = entries
+	})
+	self.Set("get", func(name string) goja.Value {
+		for _, e := range form.entries {
+			if e.name == name {
+				return value(e)
 			}
-			if v := optsVal.Get("timeout"); v != nil && !goja.IsUndefined(v) {
-				requestOptions.Timeout = int(v.ToInteger())
+		}
+		return goja.Null()
+	})
+	self.Set("getAll", func(name string) []any {
+		all := []any{}
+		for _, e := range form.entries {
+			if e.name == name {
+				all = append(all, value(e))
 			}
 		}
+		return all
+	})
+	self.Set("has", func(name string) bool {
+		return len(without(name)) != len(form.entries)
+	})
+	self.Set("delete", func(name string) {
+		form.entries = without(name)
+	})
+	self.Set("entries", func() []any {
+		all := []any{}
+		for _, e := range form.entries {
+			all = append(all, []any{e.name, value(e)})
+		}
+		return all
+	})
+	self.Set("forEach", func(callback goja.Callable) {
+		for _, e := range append([]formEntry(nil), form.entries...) {
+			callback(goja.Undefined(), value(e), vm.ToValue(e.name), self)
+		}
+	})
+	return self
+}
+
+func (ser *ExtBaseService) initFetch(vm *goja.Runtime, job *Job) {
+	f := &fetchBridge{vm: vm, key: goja.NewSymbol("fetch")}
+	vm.Set("Request", f.requestCtor)
+	vm.Set("Response", f.responseCtor)
+	vm.Set("FormData", f.formDataCtor)
+	vm.Set("AbortController", f.abortControllerCtor)
+	vm.Set("AbortSignal", func(call goja.ConstructorCall) *goja.Object {
+		f.newSignal(call.This)
+		return call.This
+	})
+	signal := vm.Get("AbortSignal").ToObject(vm)
+	signal.Set("abort", func(reason goja.Value) *goja.Object {
+		obj := vm.NewObject()
+		f.abort(f.newSignal(obj), reason)
+		return obj
+	})
+	signal.Set("timeout", func(ms int64) *goja.Object {
+		obj := vm.NewObject()
+		s := f.newSignal(obj)
+		job.loop.SetTimeout(func(*goja.Runtime) {
+			f.abort(s, newDOMException(f.vm, "TimeoutError", "The operation timed out"))
+		}, time.Duration(ms)*time.Millisecond)
+		return obj
+	})
 
-		res, err := network.Request[string](fetchUrl, &requestOptions, network.ReadAll)
-		if err != nil {
-			panic(vm.ToValue(err))
+	// fetch(resource, options)
+	vm.Set("fetch", func(call goja.FunctionCall) goja.Value {
+		promise, resolve, reject := vm.NewPromise()
+		req, e := f.request(call.Argument(0), call.Argument(1))
+		if e != nil {
+			reject(vm.NewTypeError("%v", e))
+			return vm.ToValue(promise)
+		}
+		if e := ser.checkURL(req.url); e != nil {
+			reject(vm.NewGoError(e))
+			return vm.ToValue(promise)
+		}
+		if req.signal != nil && req.signal.aborted {
+			reject(req.signal.reason)
+			return vm.ToValue(promise)
+		}
+		if e := job.Add(); e != nil {
+			panic(vm.NewGoError(e))
 		}
 
-		// Create a Response object similar to browser's Response
-		responseObj := vm.NewObject()
-		responseObj.Set("status", res.Res.StatusCode())
-		responseObj.Set("statusText", http.StatusText(res.Res.StatusCode()))
-		responseObj.Set("ok", res.Res.StatusCode() >= 200 && res.Res.StatusCode() < 300)
-		responseObj.Set("data", res.Body)
+		// Capture the running call so the request is aborted together with it
+		ctx, cancel := context.WithCancel(job.Context())
+		forget := func() {}
+		if req.signal != nil {
+			forget = req.signal.onAbort(cancel)
+		}
+		options := network.RequestOptions{
+			Headers:        req.headers,
+			Method:         req.method,
+			RequestBodyRaw: req.body,
+			Timeout:        req.timeout,
+			Context:        ctx,
+			CheckRedirect:  ser.checkURL,
+		}
+		if req.redirect == "follow" {
+			options.MaxRedirects = maxRedirects
+		}
 
-		// Add JSON method
-		responseObj.Set("json", func() *goja.Promise {
-			p, resolve, reject := vm.NewPromise()
-			go func() {
-				var jsonData interface{}
-				err := json.Unmarshal([]byte(res.Body), &jsonData)
-				if err != nil {
-					job.loop.RunOnLoop(func(vm *goja.Runtime) {
-						reject(vm.ToValue(err.Error()))
+		go func() {
+			res, err := network.Request[[]byte](req.url, &options, network.ReadAll)
+			job.loop.RunOnLoop(func(vm *goja.Runtime) {
+				job.Done()
+				forget()
+				cancel()
+				switch {
+				case req.signal != nil && req.signal.aborted:
+					reject(req.signal.reason)
+				case err != nil:
+					reject(vm.NewGoError(err))
+				case req.redirect == "error" && network.IsRedirect(res.Res.StatusCode()):
+					reject(vm.NewTypeError("fetch of %s was redirected with the redirect mode error", req.url))
+				default:
+					fr := &fetchResponse{
+						status:     res.Res.StatusCode(),
+						statusText: http.StatusText(res.Res.StatusCode()),
+						headers:    map[string]string{},
+						body:       res.Body,
+						url:        res.URL,
+						redirected: res.URL != req.url,
+					}
+					res.Res.Header.VisitAll(func(key, value []byte) {
+						if v, ok := fr.headers[string(key)]; ok {
+							fr.headers[string(key)] = v + ", " + string(value)
+							return
+						}
+						fr.headers[string(key)] = string(value)
 					})
-					return
+					obj := vm.NewObject()
+					f.initResponse(obj, fr)
+					resolve(obj)
 				}
-				job.loop.RunOnLoop(func(vm *goja.Runtime) {
-					resolve(vm.ToValue(jsonData))
-				})
-			}()
-			return p
-		})
-
-		// Add text method
-		responseObj.Set("text", func() *goja.Promise {
-			p, resolve, _ := vm.NewPromise()
-			job.loop.RunOnLoop(func(vm *goja.Runtime) {
-				resolve(vm.ToValue(res.Body))
 			})
-			return p
-		})
-
-		// Add headers
-		headers := vm.NewObject()
-		res.Res.Header.VisitAll(func(key, value []byte) {
-			headers.Set(string(key), string(value))
-		})
-		responseObj.Set("headers", headers)
-
-		return responseObj
+		}()
+		return vm.ToValue(promise)
 	})
 }
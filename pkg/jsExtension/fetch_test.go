@@ -0,0 +1,253 @@
+package jsExtension
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miru-project/miru-core/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+// serveTestFetch answers fetch requests of the tests: /echo returns what it
+// received, /binary every byte value, /redirect redirects to /echo and /slow
+// blocks until the test ends
+func serveTestFetch(t *testing.T) {
+	t.Helper()
+	network.UseCookieJar(t.TempDir())
+	release := make(chan struct{})
+	network.SetTransport(func(req *fasthttp.Request, res *fasthttp.Response) error {
+		switch string(req.URI().Path()) {
+		case "/echo":
+			received := map[string]any{
+				"method":      string(req.Header.Method()),
+				"contentType": string(req.Header.ContentType()),
+				"body":        string(req.Body()),
+			}
+			if mediaType, params, _ := mime.ParseMediaType(string(req.Header.ContentType())); mediaType == "multipart/form-data" {
+				form, err := multipart.NewReader(strings.NewReader(string(req.Body())), params["boundary"]).ReadForm(1 << 20)
+				if err != nil {
+					return err
+				}
+				received["form"] = form.Value
+				for name, files := range form.File {
+					f, _ := files[0].Open()
+					data := make([]byte, files[0].Size)
+					f.Read(data)
+					received["file"] = map[string]string{"name": name, "filename": files[0].Filename, "data": string(data)}
+				}
+			}
+			body, _ := json.Marshal(received)
+			res.Header.Set("X-Test", "echo")
+			res.SetBody(body)
+		case "/binary":
+			data := make([]byte, 256)
+			for i := range data {
+				data[i] = byte(i)
+			}
+			res.SetBody(data)
+		case "/redirect":
+			res.SetStatusCode(fasthttp.StatusFound)
+			res.Header.Set("Location", "/echo")
+		case "/outside":
+			res.SetStatusCode(fasthttp.StatusFound)
+			res.Header.Set("Location", "https://tracker.test/echo")
+		case "/slow":
+			<-release
+		default:
+			res.SetStatusCode(fasthttp.StatusNotFound)
+		}
+		return nil
+	})
+	t.Cleanup(func() {
+		close(release)
+		network.SetTransport(nil)
+	})
+}
+
+func loadTestFetch(t *testing.T, pkg string, code string) {
+	t.Helper()
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	LoadApiV2(&Ext{Name: pkg, Pkg: pkg, ApiVersion: "2", Website: "https://example.com", Context: &code})
+	if err := ApiPkgCache.Load(pkg).Ext.Error; err != "" {
+		t.Fatal(err)
+	}
+}
+
+func fetchResult(t *testing.T, pkg string) map[string]any {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := Latest[map[string]any](ctx, pkg, 1)
+	if !assert.NoError(t, err) || !assert.Len(t, res, 1) {
+		t.FailNow()
+	}
+	var url string
+	if u, ok := (*res[0])["url"].(string); ok {
+		url = u
+	}
+	out := map[string]any{}
+	assert.NoError(t, json.Unmarshal([]byte(url), &out))
+	return out
+}
+
+// The results of the test extensions are returned as JSON in the url of a
+// single latest item
+func TestFetchBodies(t *testing.T) {
+	serveTestFetch(t)
+	loadTestFetch(t, "test.fetch.bodies", `
+async function post(body) {
+  const res = await fetch("https://example.com/echo", { method: "post", body });
+  return res.json();
+}
+async function latest(page) {
+  const form = new FormData();
+  form.append("a", "1");
+  form.append("a", "2");
+  form.set("b", "x");
+  form.set("a", "3");
+  form.append("file", new Uint8Array([104, 105]), "hi.txt");
+  const binary = await fetch("https://example.com/binary");
+  const bytes = new Uint8Array(await binary.arrayBuffer());
+  const out = {
+    text: await post("plain"),
+    buffer: await post(new Uint8Array([111, 107]).buffer),
+    view: await post(new Uint8Array([0, 111, 107, 0]).subarray(1, 3)),
+    params: await post(new URLSearchParams({ q: "a b", page: "2" })),
+    form: await post(form),
+    formGet: [form.get("a"), form.getAll("a"), form.has("b"), form.entries().length],
+    binaryLength: bytes.length,
+    binaryLast: bytes[255],
+    status: binary.status,
+    ok: binary.ok,
+    header: (await fetch("https://example.com/echo")).headers.get("x-test"),
+  };
+  return [{ title: "fetch", url: JSON.stringify(out) }];
+}`)
+	out := fetchResult(t, "test.fetch.bodies")
+
+	assert.Equal(t, map[string]any{"method": "POST", "contentType": "text/plain;charset=UTF-8", "body": "plain"}, out["text"])
+	assert.Equal(t, map[string]any{"method": "POST", "contentType": "", "body": "ok"}, out["buffer"])
+	assert.Equal(t, "ok", out["view"].(map[string]any)["body"])
+	params := out["params"].(map[string]any)
+	assert.Equal(t, "application/x-www-form-urlencoded;charset=UTF-8", params["contentType"])
+	assert.Equal(t, "q=a+b&page=2", params["body"])
+	form := out["form"].(map[string]any)
+	assert.Contains(t, form["contentType"], "multipart/form-data; boundary=")
+	assert.Equal(t, map[string]any{"a": []any{"3"}, "b": []any{"x"}}, form["form"])
+	assert.Equal(t, map[string]any{"name": "file", "filename": "hi.txt", "data": "hi"}, form["file"])
+	assert.Equal(t, []any{"3", []any{"3"}, true, float64(3)}, out["formGet"])
+	assert.Equal(t, float64(256), out["binaryLength"])
+	assert.Equal(t, float64(255), out["binaryLast"])
+	assert.Equal(t, float64(200), out["status"])
+	assert.Equal(t, true, out["ok"])
+	assert.Equal(t, "echo", out["header"])
+}
+
+func TestFetchRedirects(t *testing.T) {
+	serveTestFetch(t)
+	loadTestFetch(t, "test.fetch.redirects", `
+async function latest(page) {
+  const followed = await fetch(new Request("https://example.com/redirect", { method: "POST", body: "x" }));
+  const manual = await fetch("https://example.com/redirect", { redirect: "manual" });
+  let error = "";
+  try {
+    await fetch("https://example.com/redirect", { redirect: "error" });
+  } catch (e) {
+    error = String(e);
+  }
+  let outside = "";
+  try {
+    await fetch("https://example.com/outside");
+  } catch (e) {
+    outside = String(e);
+  }
+  const out = {
+    url: followed.url,
+    redirected: followed.redirected,
+    method: (await followed.json()).method,
+    manualStatus: manual.status,
+    location: manual.headers.get("Location"),
+    error,
+    outside,
+  };
+  return [{ title: "fetch", url: JSON.stringify(out) }];
+}`)
+	out := fetchResult(t, "test.fetch.redirects")
+
+	assert.Equal(t, "https://example.com/echo", out["url"])
+	assert.Equal(t, true, out["redirected"])
+	assert.Equal(t, "GET", out["method"])
+	assert.Equal(t, float64(302), out["manualStatus"])
+	assert.Equal(t, "/echo", out["location"])
+	assert.Contains(t, out["error"], "redirected")
+	assert.Contains(t, out["outside"], "is not allowed to access tracker.test")
+}
+
+func TestFetchAbort(t *testing.T) {
+	serveTestFetch(t)
+	loadTestFetch(t, "test.fetch.abort", `
+async function latest(page) {
+  const controller = new AbortController();
+  const events = [];
+  controller.signal.addEventListener("abort", (e) => events.push(e.type));
+  const pending = fetch("https://example.com/slow", { signal: controller.signal });
+  setTimeout(() => controller.abort(), 10);
+  let aborted = "";
+  try {
+    await pending;
+  } catch (e) {
+    aborted = e.name;
+  }
+  let again = "";
+  try {
+    await fetch("https://example.com/echo", { signal: controller.signal });
+  } catch (e) {
+    again = e.name;
+  }
+  let timeout = "";
+  try {
+    await fetch("https://example.com/slow", { signal: AbortSignal.timeout(10) });
+  } catch (e) {
+    timeout = e.name;
+  }
+  const out = { aborted, again, timeout, events, flag: controller.signal.aborted };
+  return [{ title: "fetch", url: JSON.stringify(out) }];
+}`)
+	out := fetchResult(t, "test.fetch.abort")
+
+	assert.Equal(t, "AbortError", out["aborted"])
+	assert.Equal(t, "AbortError", out["again"])
+	assert.Equal(t, "TimeoutError", out["timeout"])
+	assert.Equal(t, []any{"abort"}, out["events"])
+	assert.Equal(t, true, out["flag"])
+}
+
+func TestJsRequest(t *testing.T) {
+	serveTestFetch(t)
+	loadTestFetch(t, "test.fetch.jsrequest", `
+async function latest(page) {
+  const requests = [];
+  for (let i = 0; i < 8; i++) {
+    requests.push(jsRequest("https://example.com/echo?n=" + i, { method: "get" }));
+  }
+  const bodies = (await Promise.all(requests)).map((body) => JSON.parse(body).method);
+  const outside = await jsRequest("https://tracker.test/", { method: "get" }).catch((e) => String(e));
+  const redirected = await jsRequest("https://example.com/outside", { method: "get", max_redirects: 20 }).catch((e) => String(e));
+  return [{ title: "jsRequest", url: JSON.stringify({ bodies, outside, redirected }) }];
+}`)
+	out := fetchResult(t, "test.fetch.jsrequest")
+
+	assert.Len(t, out["bodies"], 8)
+	assert.Equal(t, "GET", out["bodies"].([]any)[0])
+	// Arguments are checked on the loop and still reject the promise
+	assert.Contains(t, out["outside"], "is not allowed to access tracker.test")
+	// Scripts can't make request follow a redirect to a blocked host
+	assert.Equal(t, "", out["redirected"])
+}
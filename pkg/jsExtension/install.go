@@ -0,0 +1,286 @@
+package jsExtension
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/pkg/db"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+// Scripts are written to the staging directory and compiled there before they
+// are renamed over the installed script, so the watcher and the runtimes never
+// see a partial file. The replaced script is kept in the previous directory
+// until the next install of the package or a rollback
+const (
+	stagingDir  = "staging"
+	previousDir = "previous"
+)
+
+func stagingPath(pkg string) string {
+	return filepath.Join(ExtPath, stagingDir, pkg+".js")
+}
+
+func previousPath(pkg string) string {
+	return filepath.Join(ExtPath, previousDir, pkg+".js")
+}
+
+// previousRecordPath holds the verification record of the previous script
+func previousRecordPath(pkg string) string {
+	return filepath.Join(ExtPath, previousDir, pkg+".json")
+}
+
+// installing counts the holds on the packages written by the update service,
+// the watcher leaves them alone since they are loaded and checked here
+var installing = struct {
+	sync.Mutex
+	pkgs map[string]int
+}{pkgs: map[string]int{}}
+
+// holdWatcher keeps the watcher away from pkg until the returned func is
+// called, plus a moment for the file events still on their way
+func holdWatcher(pkg string) func() {
+	installing.Lock()
+	installing.pkgs[pkg]++
+	installing.Unlock()
+	return func() {
+		time.AfterFunc(time.Second, func() {
+			installing.Lock()
+			if installing.pkgs[pkg]--; installing.pkgs[pkg] == 0 {
+				delete(installing.pkgs, pkg)
+			}
+			installing.Unlock()
+		})
+	}
+}
+
+// isInstalling reports whether the update service is writing pkg
+func isInstalling(pkg string) bool {
+	installing.Lock()
+	defer installing.Unlock()
+	return installing.pkgs[pkg] > 0
+}
+
+// writeStaged writes content to the staging file of pkg
+func writeStaged(pkg string, content []byte) (string, error) {
+	loc := stagingPath(pkg)
+	if e := os.MkdirAll(filepath.Dir(loc), os.ModePerm); e != nil {
+		return "", e
+	}
+	if e := os.WriteFile(loc, content, 0644); e != nil {
+		return "", fmt.Errorf("failed to stage js extension %s: %v", pkg, e)
+	}
+	return loc, nil
+}
+
+// stageScript writes content to the staging directory and checks that it is
+// an extension for pkg that compiles
+func stageScript(pkg string, content []byte) (string, *Ext, error) {
+	loc, e := writeStaged(pkg, content)
+	if e != nil {
+		return "", nil, e
+	}
+	ext := &Ext{Name: filepath.Base(loc)}
+	if e := ext.filterExt(loc); e != nil {
+		os.Remove(loc)
+		return "", nil, fmt.Errorf("extension %s is not valid: %v", pkg, e)
+	}
+	code := *ext.Context
+	switch ext.ApiVersion {
+	case "3":
+		code = replaceExportDeclarations(code)
+	case "2":
+	default:
+		code = replaceClassExtendsDeclaration(code)
+	}
+	if _, e := goja.Compile(pkg+".js", code, true); e != nil {
+		os.Remove(loc)
+		return "", nil, fmt.Errorf("extension %s does not compile: %v", pkg, e)
+	}
+	return loc, ext, nil
+}
+
+// keepPrevious saves the replaced script of pkg and its verification record
+// so the install can be rolled back later
+func keepPrevious(pkg string, script []byte, rec *ent.ExtensionVerification) error {
+	loc := previousPath(pkg)
+	if e := os.MkdirAll(filepath.Dir(loc), os.ModePerm); e != nil {
+		return e
+	}
+	if e := os.WriteFile(loc, script, 0644); e != nil {
+		return e
+	}
+	recLoc := previousRecordPath(pkg)
+	if rec == nil {
+		if e := os.Remove(recLoc); e != nil && !errors.Is(e, os.ErrNotExist) {
+			return e
+		}
+		return nil
+	}
+	data, e := json.Marshal(rec)
+	if e != nil {
+		return e
+	}
+	return os.WriteFile(recLoc, data, 0644)
+}
+
+// loadPrevious reads what keepPrevious saved for pkg
+func loadPrevious(pkg string) ([]byte, *ent.ExtensionVerification, error) {
+	loc := previousPath(pkg)
+	script, e := os.ReadFile(loc)
+	if errors.Is(e, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("no previous version of %s to roll back to", pkg)
+	}
+	if e != nil {
+		return nil, nil, e
+	}
+	data, e := os.ReadFile(previousRecordPath(pkg))
+	if errors.Is(e, os.ErrNotExist) {
+		return script, nil, nil
+	}
+	if e != nil {
+		return nil, nil, e
+	}
+	rec := &ent.ExtensionVerification{}
+	if e := json.Unmarshal(data, rec); e != nil {
+		return nil, nil, fmt.Errorf("invalid verification record of the previous version of %s: %v", pkg, e)
+	}
+	return script, rec, nil
+}
+
+func discardPrevious(pkg string) {
+	os.Remove(previousPath(pkg))
+	os.Remove(previousRecordPath(pkg))
+}
+
+// install is an extension written by the update service together with the
+// file it replaced
+type install struct {
+	pkg      string
+	loc      string
+	previous []byte
+	api      *ExtApi
+	// verification of the replaced script
+	verification *ent.ExtensionVerification
+}
+
+// installPackage stages content as the script of pkg, downloads the libraries
+// it requires, moves it into place and loads it, must hold upgradeLock. Scripts that fail to stage leave the
+// installed one untouched. When the new script fails to load the install is
+// returned with the error so the caller can restore the previous version
+func installPackage(pkg string, content []byte, v *Verification) (*install, error) {
+	staged, ext, e := stageScript(pkg, content)
+	if e != nil {
+		return nil, e
+	}
+	if e := installLibraries(v.Repo, ext.Requires); e != nil {
+		os.Remove(staged)
+		return nil, fmt.Errorf("failed to install the libraries of %s: %v", pkg, e)
+	}
+	inst := &install{pkg: pkg, loc: filepath.Join(ExtPath, pkg+".js")}
+	previous, e := os.ReadFile(inst.loc)
+	if e != nil && !errors.Is(e, os.ErrNotExist) {
+		return nil, e
+	}
+	inst.previous = previous
+	if inst.verification, e = db.GetVerification(pkg); e != nil {
+		return nil, e
+	}
+	if previous != nil {
+		if e := keepPrevious(pkg, previous, inst.verification); e != nil {
+			return nil, fmt.Errorf("failed to keep the previous version of %s: %v", pkg, e)
+		}
+	}
+	if e := saveVerification(pkg, v); e != nil {
+		return nil, e
+	}
+
+	defer holdWatcher(pkg)()
+	if e := os.Rename(staged, inst.loc); e != nil {
+		os.Remove(staged)
+		if re := restoreVerification(pkg, inst.verification); re != nil {
+			return nil, fmt.Errorf("failed to install js extension %s to %s: %v, restoring its verification failed: %v", pkg, ExtPath, e, re)
+		}
+		return nil, fmt.Errorf("failed to install js extension %s to %s: %v", pkg, ExtPath, e)
+	}
+	api, e := reload(pkg, inst.loc)
+	inst.api = api
+	if e != nil {
+		return inst, fmt.Errorf("extension %s failed to load: %v", pkg, e)
+	}
+	// Earlier failed downloads are superseded by the installed script
+	if e := db.DeleteVerificationFailure(pkg); e != nil {
+		log.Println("Failed to delete the failed verification of", pkg, ":", e)
+	}
+	if api != nil {
+		api.Ext.FailedVerification = nil
+	}
+	return inst, nil
+}
+
+// restore puts back the script and the verification replaced by the install.
+// The kept previous version is discarded since it is installed again
+func (inst *install) restore() error {
+	defer holdWatcher(inst.pkg)()
+	if e := restoreVerification(inst.pkg, inst.verification); e != nil {
+		return e
+	}
+	discardPrevious(inst.pkg)
+	if inst.previous == nil {
+		if pool, ok := extMemMap.LoadAndDelete(inst.pkg); ok {
+			pool.(*runtimePool).close()
+		}
+		ApiPkgCache.Remove(inst.pkg)
+		return os.Remove(inst.loc)
+	}
+	staged, e := writeStaged(inst.pkg, inst.previous)
+	if e != nil {
+		return e
+	}
+	if e := os.Rename(staged, inst.loc); e != nil {
+		os.Remove(staged)
+		return e
+	}
+	_, e = reload(inst.pkg, inst.loc)
+	return e
+}
+
+// RollbackExtension reinstalls the version of pkg replaced by its last
+// install or upgrade
+func RollbackExtension(pkg string) (*ExtApi, error) {
+	upgradeLock.Lock()
+	defer upgradeLock.Unlock()
+	script, rec, e := loadPrevious(pkg)
+	if e != nil {
+		return nil, e
+	}
+	inst := &install{pkg: pkg, loc: filepath.Join(ExtPath, pkg+".js"), previous: script, verification: rec}
+	if e := inst.restore(); e != nil {
+		return nil, fmt.Errorf("failed to roll back extension %s: %v", pkg, e)
+	}
+	return ApiPkgCache.Load(pkg), nil
+}
+
+// reload loads the script at loc and closes the runtimes of the version it
+// replaced, also when the new version failed before it had runtimes of its own
+func reload(pkg string, loc string) (*ExtApi, error) {
+	old, _ := extMemMap.Load(pkg)
+	api, e := LoadFile(loc)
+	if old == nil {
+		return api, e
+	}
+	if cur, _ := extMemMap.Load(pkg); cur != old {
+		old.(*runtimePool).close()
+	} else if api != nil && api.pool != old {
+		extMemMap.CompareAndDelete(pkg, old)
+		old.(*runtimePool).close()
+	}
+	return api, e
+}
@@ -0,0 +1,64 @@
+package jsExtension
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstallIsStagedAndRollsBack(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved := ExtPath
+	ExtPath = t.TempDir()
+	t.Cleanup(func() {
+		ExtPath = saved
+	})
+	repo := "http://staging.test/index.json"
+	saveTestRepo(t, repo, "staging test")
+
+	pkg := "test.install.staged"
+	loc := filepath.Join(ExtPath, pkg+".js")
+	installed := testScript(pkg, "1.0.0")
+	files := map[string]string{
+		"/index.json":          `[{"name": "Staged", "package": "` + pkg + `", "version": "1.1.0"}]`,
+		"/repo/" + pkg + ".js": testScript(pkg, "1.1.0") + "}",
+	}
+	serveTestRepo(t, files)
+	installTestScript(t, pkg, installed)
+
+	// Scripts that do not compile never replace the installed one
+	assert.ErrorContains(t, DownloadExtension(repo, pkg), "does not compile")
+	script, err := os.ReadFile(loc)
+	assert.NoError(t, err)
+	assert.Equal(t, installed, string(script))
+	assert.NoFileExists(t, stagingPath(pkg))
+	_, err = RollbackExtension(pkg)
+	assert.ErrorContains(t, err, "no previous version")
+
+	// Scripts that compile but fail to load are kept until rolled back
+	files["/repo/"+pkg+".js"] = testScript(pkg, "1.1.0") + `throw new Error("broken at load");`
+	assert.ErrorContains(t, DownloadExtension(repo, pkg), "roll it back")
+	assert.NotEmpty(t, ApiPkgCache.Load(pkg).Ext.Error)
+	assert.FileExists(t, previousPath(pkg))
+	assert.NoFileExists(t, stagingPath(pkg))
+
+	api, err := RollbackExtension(pkg)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", api.Ext.Version)
+	assert.Empty(t, api.Ext.Error)
+	script, err = os.ReadFile(loc)
+	assert.NoError(t, err)
+	assert.Equal(t, installed, string(script))
+	assert.NoFileExists(t, previousPath(pkg))
+
+	// Working upgrades keep the replaced version too
+	files["/repo/"+pkg+".js"] = testScript(pkg, "1.1.0")
+	assert.NoError(t, DownloadExtension(repo, pkg))
+	assert.Equal(t, "1.1.0", ApiPkgCache.Load(pkg).Ext.Version)
+	api, err = RollbackExtension(pkg)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", api.Ext.Version)
+}
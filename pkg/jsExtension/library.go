@@ -0,0 +1,227 @@
+package jsExtension
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/dop251/goja"
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/pkg/db"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+// Shared libraries are helper modules published in a repository index with
+// "library": true, one entry per version. The script of every version is
+// served as repo/<name>@<version>.js next to the index. Extensions declare the
+// ones they use with `@require name constraint` comments or the requires of
+// their manifest, and load them with require(name). Every downloaded version
+// is cached under jsRoot/<name>/<version>.js and an extension gets the newest
+// cached version matching its constraint
+
+var libraryName = regexp.MustCompile(`^\w[\w.-]*$`)
+
+// registeredLibraries holds the library versions registered in sharedRegistry
+var registeredLibraries sync.Map
+
+// libraryModule is the module name a library version is registered under
+func libraryModule(name string, version string) string {
+	return name + "@" + version
+}
+
+func libraryPath(name string, version string) string {
+	return filepath.Join(jsRoot, name, version+".js")
+}
+
+func libraryConstraint(name string, constraint string) (*semver.Constraints, error) {
+	if !libraryName.MatchString(name) {
+		return nil, fmt.Errorf("invalid library name %q", name)
+	}
+	if constraint == "" {
+		constraint = "*"
+	}
+	c, e := semver.NewConstraint(constraint)
+	if e != nil {
+		return nil, fmt.Errorf("invalid version constraint %q for library %s: %v", constraint, name, e)
+	}
+	return c, nil
+}
+
+// cachedLibrary returns the newest cached version of name matching c
+func cachedLibrary(name string, c *semver.Constraints) (string, bool) {
+	if jsRoot == "" {
+		return "", false
+	}
+	files, e := os.ReadDir(filepath.Join(jsRoot, name))
+	if e != nil {
+		return "", false
+	}
+	var newest *semver.Version
+	for _, file := range files {
+		v, e := semver.NewVersion(strings.TrimSuffix(file.Name(), ".js"))
+		if e != nil || file.IsDir() || filepath.Ext(file.Name()) != ".js" || !c.Check(v) {
+			continue
+		}
+		if newest == nil || v.GreaterThan(newest) {
+			newest = v
+		}
+	}
+	if newest == nil {
+		return "", false
+	}
+	return newest.Original(), true
+}
+
+// resolveLibraries picks the cached version of every library the extension
+// requires and registers it as a module
+func (ext *Ext) resolveLibraries() error {
+	if len(ext.Requires) == 0 {
+		return nil
+	}
+	ext.Libraries = map[string]string{}
+	for name, constraint := range ext.Requires {
+		c, e := libraryConstraint(name, constraint)
+		if e != nil {
+			return e
+		}
+		version, ok := cachedLibrary(name, c)
+		if !ok {
+			return fmt.Errorf("library %s %s is not installed", name, constraint)
+		}
+		if e := registerLibrary(name, version); e != nil {
+			return e
+		}
+		ext.Libraries[name] = version
+	}
+	return nil
+}
+
+func registerLibrary(name string, version string) error {
+	id := libraryModule(name, version)
+	if _, ok := registeredLibraries.Load(id); ok {
+		return nil
+	}
+	source, e := os.ReadFile(libraryPath(name, version))
+	if e != nil {
+		return fmt.Errorf("failed to read library %s: %v", id, e)
+	}
+	if e := registerJSModule(id, string(source), func(*goja.Runtime, *goja.Object) {}); e != nil {
+		return e
+	}
+	registeredLibraries.Store(id, true)
+	return nil
+}
+
+// requireLibraries wraps require so the libraries of the extension resolve to
+// the versions it was loaded with
+func (ser *ExtBaseService) requireLibraries(require func(string) (goja.Value, error)) func(string) (goja.Value, error) {
+	if len(ser.libraries) == 0 {
+		return require
+	}
+	return func(name string) (goja.Value, error) {
+		if version, ok := ser.libraries[name]; ok {
+			name = libraryModule(name, version)
+		}
+		return require(name)
+	}
+}
+
+// installLibraries downloads the libraries in requires that have no matching
+// cached version from the repository at repoUrl
+func installLibraries(repoUrl string, requires map[string]string) error {
+	for name, constraint := range requires {
+		c, e := libraryConstraint(name, constraint)
+		if e != nil {
+			return e
+		}
+		if _, ok := cachedLibrary(name, c); ok {
+			continue
+		}
+		if jsRoot == "" {
+			return fmt.Errorf("library %s %s is not installed and there is no library directory", name, constraint)
+		}
+		entry, e := findLibrary(repoUrl, name, c)
+		if e != nil {
+			return e
+		}
+		// Every version has its own script so the cache never files one
+		// version under another
+		content, e := fetchScript(repoUrl, libraryModule(name, entry.Version))
+		if e != nil {
+			return e
+		}
+		keys, e := db.GetRepositoryTrustedKeys(repoUrl)
+		if e != nil && !ent.IsNotFound(e) {
+			return e
+		}
+		if _, e := verifyScript(entry, keys, content); e != nil {
+			return fmt.Errorf("library %s %s from %s failed verification: %v", name, entry.Version, repoUrl, e)
+		}
+		if e := saveLibrary(name, entry.Version, content); e != nil {
+			return e
+		}
+		log.Println("Installed library:", name, entry.Version, "from", repoUrl)
+	}
+	return nil
+}
+
+// findLibrary returns the newest version of the library name in the fetched
+// index of repoUrl that matches c
+func findLibrary(repoUrl string, name string, c *semver.Constraints) (*GithubExtension, error) {
+	if len(fetchedExtensionRepo) == 0 {
+		FetchExtensionRepo()
+	}
+	var found *GithubExtension
+	var newest *semver.Version
+	repo := fetchedExtensionRepo[repoUrl]
+	for i := range repo {
+		if !repo[i].Library || repo[i].Package != name {
+			continue
+		}
+		v, e := semver.NewVersion(repo[i].Version)
+		if e != nil || !c.Check(v) {
+			continue
+		}
+		if newest == nil || v.GreaterThan(newest) {
+			found, newest = &repo[i], v
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("library %s %s not found in repository %s", name, c, repoUrl)
+	}
+	return found, nil
+}
+
+// saveLibrary checks that content compiles as a module and caches it
+func saveLibrary(name string, version string, content []byte) error {
+	if _, e := semver.NewVersion(version); e != nil {
+		return fmt.Errorf("library %s has an invalid version %q", name, version)
+	}
+	if _, e := compileJSModule(libraryModule(name, version), string(content)); e != nil {
+		return e
+	}
+	loc := libraryPath(name, version)
+	if e := os.MkdirAll(filepath.Dir(loc), os.ModePerm); e != nil {
+		return e
+	}
+	tmp, e := os.CreateTemp(filepath.Dir(loc), "."+version+".*")
+	if e != nil {
+		return e
+	}
+	_, e = tmp.Write(content)
+	if ce := tmp.Close(); e == nil {
+		e = ce
+	}
+	if e == nil {
+		e = os.Rename(tmp.Name(), loc)
+	}
+	if e != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to save library %s %s: %v", name, version, e)
+	}
+	return nil
+}
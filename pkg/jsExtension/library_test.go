@@ -0,0 +1,94 @@
+package jsExtension
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func libraryScript(pkg string, constraint string) string {
+	return `// @name ` + pkg + `
+// @version 1.0.0
+// @package ` + pkg + `
+// @apiVersion 2
+// @require miru-test-decrypt ` + constraint + `
+
+const { decrypt } = require("miru-test-decrypt");
+
+async function latest(page) {
+  return [{ title: decrypt("abc"), url: "/" }];
+}
+`
+}
+
+func TestExtensionsRequireSharedLibraries(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved, savedRoot := ExtPath, jsRoot
+	ExtPath, jsRoot = t.TempDir(), t.TempDir()
+	t.Cleanup(func() {
+		ExtPath, jsRoot = saved, savedRoot
+	})
+	repo := "http://library.test/index.json"
+	saveTestRepo(t, repo, "library test")
+	lib := `exports.decrypt = (s) => "v1:" + s.split("").reverse().join("");`
+	files := map[string]string{
+		"/index.json": `[
+  {"name": "Decrypt", "package": "miru-test-decrypt", "version": "1.1.0", "library": true},
+  {"name": "Decrypt", "package": "miru-test-decrypt", "version": "1.2.0", "library": true, "hash": "sha256:` + scriptHash([]byte(lib)) + `"},
+  {"name": "User", "package": "test.library.user", "version": "1.0.0"},
+  {"name": "Older", "package": "test.library.older", "version": "1.0.0"},
+  {"name": "Newer", "package": "test.library.newer", "version": "1.0.0"},
+  {"name": "Cached", "package": "test.library.cached", "version": "1.0.0"}
+]`,
+		"/repo/miru-test-decrypt@1.1.0.js": `exports.decrypt = (s) => "v1.1:" + s;`,
+		"/repo/miru-test-decrypt@1.2.0.js": lib,
+		"/repo/test.library.user.js":       libraryScript("test.library.user", "^1.0.0"),
+		"/repo/test.library.older.js":      libraryScript("test.library.older", "~1.1.0"),
+		"/repo/test.library.newer.js":      libraryScript("test.library.newer", "^2.0.0"),
+		"/repo/test.library.cached.js":     libraryScript("test.library.cached", "~1.2"),
+	}
+	serveTestRepo(t, files)
+
+	assert.NoError(t, DownloadExtension(repo, "test.library.user"))
+	assert.FileExists(t, filepath.Join(jsRoot, "miru-test-decrypt", "1.2.0.js"))
+	res, err := Invoke(t.Context(), "test.library.user", "latest", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1:cba", res.([]any)[0].(map[string]any)["title"])
+	assert.Equal(t, map[string]string{"miru-test-decrypt": "1.2.0"}, ApiPkgCache.Load("test.library.user").Ext.Libraries)
+
+	// Older versions are downloaded from their own script
+	assert.NoError(t, DownloadExtension(repo, "test.library.older"))
+	res, err = Invoke(t.Context(), "test.library.older", "latest", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.1:abc", res.([]any)[0].(map[string]any)["title"])
+	assert.Equal(t, map[string]string{"miru-test-decrypt": "1.1.0"}, ApiPkgCache.Load("test.library.older").Ext.Libraries)
+
+	// Libraries missing from the index keep the extension from installing
+	assert.ErrorContains(t, DownloadExtension(repo, "test.library.newer"), "not found")
+	assert.NoFileExists(t, filepath.Join(ExtPath, "test.library.newer.js"))
+
+	// Cached versions are used without downloading them again
+	delete(files, "/repo/miru-test-decrypt@1.2.0.js")
+	assert.NoError(t, DownloadExtension(repo, "test.library.cached"))
+
+	// Every extension gets the newest version matching its own constraint
+	v2 := `exports.decrypt = (s) => "v2:" + s;`
+	assert.NoError(t, saveLibrary("miru-test-decrypt", "2.0.0", []byte(v2)))
+	installTestScript(t, "test.library.newer", libraryScript("test.library.newer", "^2.0.0"))
+	res, err = Invoke(t.Context(), "test.library.newer", "latest", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "v2:abc", res.([]any)[0].(map[string]any)["title"])
+	_, err = LoadFile(filepath.Join(ExtPath, "test.library.user.js"))
+	assert.NoError(t, err)
+	res, err = Invoke(t.Context(), "test.library.user", "latest", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1:cba", res.([]any)[0].(map[string]any)["title"])
+
+	// Scripts requiring libraries that were never installed report it
+	assert.NoError(t, os.RemoveAll(filepath.Join(jsRoot, "miru-test-decrypt")))
+	_, err = LoadFile(filepath.Join(ExtPath, "test.library.cached.js"))
+	assert.ErrorContains(t, err, "not installed")
+}
@@ -0,0 +1,160 @@
+package jsExtension
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/console"
+)
+
+// Levels of captured extension logs, exceptions are errors thrown out of the extension
+const (
+	LogLevelLog       = "log"
+	LogLevelWarn      = "warn"
+	LogLevelError     = "error"
+	LogLevelException = "exception"
+)
+
+// extLogCapacity is the number of entries kept per package, older ones are dropped
+const extLogCapacity = 500
+
+// LogEntry is a line written by an extension
+type LogEntry struct {
+	Pkg     string    `json:"package"`
+	Time    time.Time `json:"time"`
+	Level   string    `json:"level"`
+	Message string    `json:"message"`
+}
+
+// extLog is the ring buffer of one package and its subscribers
+type extLog struct {
+	lock    sync.Mutex
+	entries []LogEntry
+	next    int
+	subs    map[chan LogEntry]struct{}
+}
+
+// extLogs maps packages to their *extLog
+var extLogs = sync.Map{}
+
+// lockLogOf returns the log of pkg locked, creating it for writers and
+// subscribers
+func lockLogOf(pkg string) *extLog {
+	for {
+		value, _ := extLogs.LoadOrStore(pkg, &extLog{subs: map[chan LogEntry]struct{}{}})
+		l := value.(*extLog)
+		l.lock.Lock()
+		// The log may have been dropped while waiting for the lock
+		if current, _ := extLogs.Load(pkg); current == l {
+			return l
+		}
+		l.lock.Unlock()
+	}
+}
+
+// dropIfUnused forgets the log of pkg when it has no entries and no
+// subscribers, must hold the lock
+func (l *extLog) dropIfUnused(pkg string) {
+	if len(l.entries) == 0 && len(l.subs) == 0 {
+		extLogs.CompareAndDelete(pkg, l)
+	}
+}
+
+// writeLog adds an entry to the log of pkg and sends it to the subscribers
+func writeLog(pkg string, level string, message string) {
+	entry := LogEntry{Pkg: pkg, Time: time.Now(), Level: level, Message: message}
+	l := lockLogOf(pkg)
+	defer l.lock.Unlock()
+	if len(l.entries) < extLogCapacity {
+		l.entries = append(l.entries, entry)
+	} else {
+		l.entries[l.next] = entry
+	}
+	l.next = (l.next + 1) % extLogCapacity
+	for ch := range l.subs {
+		// Slow subscribers miss entries instead of blocking the extension
+		select {
+		case ch <- entry:
+		default:
+		}
+	}
+}
+
+// snapshot returns the entries from the oldest to the newest, must hold the lock
+func (l *extLog) snapshot() []LogEntry {
+	if len(l.entries) < extLogCapacity {
+		return append([]LogEntry(nil), l.entries...)
+	}
+	return append(append([]LogEntry(nil), l.entries[l.next:]...), l.entries[:l.next]...)
+}
+
+// ExtensionLogs returns the captured logs of pkg, or of every package when pkg
+// is empty, from the oldest to the newest
+func ExtensionLogs(pkg string) []LogEntry {
+	if pkg != "" {
+		value, ok := extLogs.Load(pkg)
+		if !ok {
+			return nil
+		}
+		l := value.(*extLog)
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		return l.snapshot()
+	}
+	var entries []LogEntry
+	extLogs.Range(func(_, value any) bool {
+		l := value.(*extLog)
+		l.lock.Lock()
+		entries = append(entries, l.snapshot()...)
+		l.lock.Unlock()
+		return true
+	})
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+	return entries
+}
+
+// TailLogs returns the captured logs of pkg and a channel receiving the new
+// ones until cancel is called
+func TailLogs(pkg string) (backlog []LogEntry, ch <-chan LogEntry, cancel func()) {
+	sub := make(chan LogEntry, 64)
+	l := lockLogOf(pkg)
+	backlog = l.snapshot()
+	l.subs[sub] = struct{}{}
+	l.lock.Unlock()
+	return backlog, sub, func() {
+		l.lock.Lock()
+		delete(l.subs, sub)
+		l.dropIfUnused(pkg)
+		l.lock.Unlock()
+	}
+}
+
+// ClearLogs drops the captured logs of pkg
+func ClearLogs(pkg string) {
+	value, ok := extLogs.Load(pkg)
+	if !ok {
+		return
+	}
+	l := value.(*extLog)
+	l.lock.Lock()
+	l.entries, l.next = nil, 0
+	l.dropIfUnused(pkg)
+	l.lock.Unlock()
+}
+
+// extPrinter writes console output into the log of a package
+type extPrinter string
+
+func (p extPrinter) Log(s string)   { writeLog(string(p), LogLevelLog, s) }
+func (p extPrinter) Warn(s string)  { writeLog(string(p), LogLevelWarn, s) }
+func (p extPrinter) Error(s string) { writeLog(string(p), LogLevelError, s) }
+
+// enableConsole sets a console writing into the log of pkg
+func enableConsole(vm *goja.Runtime, pkg string) {
+	module := vm.NewObject()
+	module.Set("exports", vm.NewObject())
+	console.RequireWithPrinter(extPrinter(pkg))(vm, module)
+	vm.Set("console", module.Get("exports"))
+}
@@ -0,0 +1,107 @@
+package jsExtension
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsoleIsCapturedPerPackage(t *testing.T) {
+	loadTestExtension(t, "test.logs.console", `
+async function latest(page) {
+  console.log("page %d", page);
+  console.warn("slow");
+  setTimeout(() => { throw new Error("late failure"); }, 0);
+  await new Promise((resolve) => setTimeout(resolve, 20));
+  return [];
+}
+async function search(kw, page, filter) {
+  throw new Error("search failed");
+}`)
+	ClearLogs("test.logs.console")
+	backlog, ch, cancel := TailLogs("test.logs.console")
+	defer cancel()
+	assert.Empty(t, backlog)
+
+	_, err := Latest[map[string]any](context.Background(), "test.logs.console", 3)
+	assert.NoError(t, err)
+	_, err = Invoke(context.Background(), "test.logs.console", "search", "kw", 1, nil)
+	assert.Error(t, err)
+
+	var levels, messages []string
+	for _, entry := range ExtensionLogs("test.logs.console") {
+		assert.Equal(t, "test.logs.console", entry.Pkg)
+		assert.False(t, entry.Time.IsZero())
+		levels = append(levels, entry.Level)
+		messages = append(messages, entry.Message)
+	}
+	assert.Equal(t, []string{LogLevelLog, LogLevelWarn, LogLevelException, LogLevelException}, levels)
+	assert.Equal(t, "page 3", messages[0])
+	assert.Contains(t, messages[2], "late failure")
+	assert.Contains(t, messages[3], "search failed")
+
+	for range levels {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("tail did not receive every entry")
+		}
+	}
+}
+
+func TestLogRingBufferKeepsNewestEntries(t *testing.T) {
+	pkg := "test.logs.ring"
+	for i := range extLogCapacity + 10 {
+		writeLog(pkg, LogLevelLog, fmt.Sprint(i))
+	}
+	entries := ExtensionLogs(pkg)
+	if assert.Len(t, entries, extLogCapacity) {
+		assert.Equal(t, "10", entries[0].Message)
+		assert.Equal(t, fmt.Sprint(extLogCapacity+9), entries[extLogCapacity-1].Message)
+	}
+	assert.Subset(t, ExtensionLogs(""), entries[:1])
+}
+
+func TestLogsOfEveryPackageAreInTimeOrder(t *testing.T) {
+	for i := range 6 {
+		writeLog(fmt.Sprint("test.logs.order", i%3), LogLevelLog, fmt.Sprint(i))
+	}
+	t.Cleanup(func() {
+		for i := range 3 {
+			ClearLogs(fmt.Sprint("test.logs.order", i))
+		}
+	})
+	var messages []string
+	entries := ExtensionLogs("")
+	for i, entry := range entries {
+		if i > 0 {
+			assert.False(t, entry.Time.Before(entries[i-1].Time))
+		}
+		if strings.HasPrefix(entry.Pkg, "test.logs.order") {
+			messages = append(messages, entry.Message)
+		}
+	}
+	assert.Equal(t, []string{"0", "1", "2", "3", "4", "5"}, messages)
+}
+
+func TestReadingLogsDoesNotKeepBuffers(t *testing.T) {
+	pkg := "test.logs.unknown"
+	assert.Empty(t, ExtensionLogs(pkg))
+	_, ok := extLogs.Load(pkg)
+	assert.False(t, ok)
+
+	_, _, cancel := TailLogs(pkg)
+	cancel()
+	_, ok = extLogs.Load(pkg)
+	assert.False(t, ok)
+
+	writeLog(pkg, LogLevelLog, "kept")
+	assert.Len(t, ExtensionLogs(pkg), 1)
+	ClearLogs(pkg)
+	_, ok = extLogs.Load(pkg)
+	assert.False(t, ok)
+}
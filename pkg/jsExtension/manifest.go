@@ -0,0 +1,279 @@
+package jsExtension
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/dop251/goja"
+)
+
+// CoreVersion is the version of miru-core checked against the minCoreVersion of
+// API v3 manifests, set at build time with
+// -ldflags "-X github.com/miru-project/miru-core/pkg/jsExtension.CoreVersion=x.y.z"
+var CoreVersion = "1.0.0"
+
+// Manifest is the structured metadata of an API v3 extension. It is either
+// written as JSON in a `/* @manifest {...} */` block or exported from the
+// script with `export const manifest = {...}`
+type Manifest struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version"`
+	Package        string       `json:"package"`
+	Author         string       `json:"author,omitempty"`
+	License        string       `json:"license,omitempty"`
+	Lang           string       `json:"lang,omitempty"`
+	Icon           string       `json:"icon,omitempty"`
+	WebSite        string       `json:"webSite,omitempty"`
+	Description    string       `json:"description,omitempty"`
+	Tags           []string     `json:"tags,omitempty"`
+	Type           string       `json:"type,omitempty"`
+	Methods        []string     `json:"methods"`
+	Capabilities   Capabilities `json:"capabilities"`
+	MinCoreVersion string       `json:"minCoreVersion,omitempty"`
+	// Validation mode of the results, lenient or strict
+	Validation string `json:"validation,omitempty"`
+	// Shared libraries of the repository the extension requires, name to
+	// version constraint. Exported manifests are read too late for them
+	Requires map[string]string `json:"requires,omitempty"`
+}
+
+// Capabilities are the host apis an API v3 extension asks for
+type Capabilities struct {
+	// Hosts the extension sends requests to, `*.example.com` matches subdomains
+	Domains  []string `json:"domains,omitempty"`
+	Settings bool     `json:"settings,omitempty"`
+	Cookies  bool     `json:"cookies,omitempty"`
+	Storage  bool     `json:"storage,omitempty"`
+}
+
+// manifestMethods maps the methods a manifest can declare to their js entry points
+var manifestMethods = map[string]string{
+	"latest":      "latest",
+	"search":      "search",
+	"detail":      "detail",
+	"watch":       "watch",
+	"mirror":      "mirror",
+	"filters":     "createFilter",
+	"popular":     "popular",
+	"tags":        "tags",
+	"checkUpdate": "checkUpdate",
+}
+
+var watchTypes = []string{"bangumi", "manga", "fikushon"}
+
+var (
+	manifestBlock  = regexp.MustCompile(`(?s)/\*+\s*@manifest\s*(\{.*?\})\s*\*+/`)
+	manifestExport = regexp.MustCompile(`(?m)^(\s*)export\s+const\s+manifest\s*=`)
+	functionExport = regexp.MustCompile(`(?m)^(\s*)export\s+((?:async\s+)?function\b)`)
+	domainPattern  = regexp.MustCompile(`^(\*\.)?[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+	errNoManifest  = errors.New("extension does not export a manifest object")
+)
+
+// parseManifest decodes a manifest, unknown fields are rejected so typos don't go unnoticed
+func parseManifest(data []byte) (*Manifest, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var m Manifest
+	if e := dec.Decode(&m); e != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", e)
+	}
+	return &m, nil
+}
+
+// validate checks the manifest against the file it was loaded from and the running core
+func (m *Manifest) validate(fileName string) error {
+	var problems []string
+	if m.Package == "" {
+		problems = append(problems, "package is required")
+	} else if m.Package+".js" != fileName {
+		problems = append(problems, fmt.Sprintf("package %s does not match the file name %s", m.Package, fileName))
+	}
+	if m.Name == "" {
+		problems = append(problems, "name is required")
+	}
+	if _, e := semver.NewVersion(m.Version); e != nil {
+		problems = append(problems, fmt.Sprintf("version %q is not a semantic version", m.Version))
+	}
+	if m.WebSite != "" {
+		if u, e := url.Parse(m.WebSite); e != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("webSite %q is not an http(s) url", m.WebSite))
+		}
+	}
+	if m.Type != "" && !slices.Contains(watchTypes, m.Type) {
+		problems = append(problems, fmt.Sprintf("type %q must be one of %s", m.Type, strings.Join(watchTypes, ", ")))
+	}
+
+	if m.Validation != "" && !slices.Contains(validationModes, m.Validation) {
+		problems = append(problems, fmt.Sprintf("validation %q must be one of %s", m.Validation, strings.Join(validationModes, ", ")))
+	}
+
+	for name, constraint := range m.Requires {
+		if _, e := libraryConstraint(name, constraint); e != nil {
+			problems = append(problems, e.Error())
+		}
+	}
+
+	if len(m.Methods) == 0 {
+		problems = append(problems, "methods must declare at least one method")
+	}
+	for i, method := range m.Methods {
+		if _, ok := manifestMethods[method]; !ok {
+			problems = append(problems, fmt.Sprintf("methods[%d]: unknown method %q", i, method))
+		} else if slices.Index(m.Methods, method) != i {
+			problems = append(problems, fmt.Sprintf("methods[%d]: %q is declared twice", i, method))
+		}
+	}
+	for i, domain := range m.Capabilities.Domains {
+		if !domainPattern.MatchString(domain) {
+			problems = append(problems, fmt.Sprintf("capabilities.domains[%d]: %q is not a host name", i, domain))
+		}
+	}
+
+	if m.MinCoreVersion != "" {
+		required, e := semver.NewVersion(m.MinCoreVersion)
+		if e != nil {
+			problems = append(problems, fmt.Sprintf("minCoreVersion %q is not a semantic version", m.MinCoreVersion))
+		} else if core, e := semver.NewVersion(CoreVersion); e == nil && core.LessThan(required) {
+			problems = append(problems, fmt.Sprintf("requires miru-core %s or newer, running %s", required, core))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid manifest: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// declares reports whether an entry point is one of the declared methods,
+// entry points that are not part of the manifest (e.g. load) are always allowed
+func (m *Manifest) declares(entryPoint string) bool {
+	for method, fn := range manifestMethods {
+		if fn == entryPoint {
+			return slices.Contains(m.Methods, method)
+		}
+	}
+	return true
+}
+
+// entryPointStubs returns the placeholder entry points the base runtime set on
+// scope, the global object for V2 and the Extension prototype for V1
+func entryPointStubs(scope *goja.Object) map[string]goja.Value {
+	stubs := make(map[string]goja.Value, len(manifestMethods))
+	for _, fn := range manifestMethods {
+		stubs[fn] = scope.Get(fn)
+	}
+	return stubs
+}
+
+// implements reports whether the entry point fn on scope is defined by the
+// script and not left to the placeholder of the base runtime
+func implements(scope *goja.Object, stubs map[string]goja.Value, fn string) bool {
+	value := scope.Get(fn)
+	_, ok := goja.AssertFunction(value)
+	return ok && (stubs[fn] == nil || !value.SameAs(stubs[fn]))
+}
+
+// implementedMethods returns the sorted methods, named like in manifests,
+// whose entry points the script defines on scope
+func implementedMethods(scope *goja.Object, stubs map[string]goja.Value) []string {
+	methods := []string{}
+	for method, fn := range manifestMethods {
+		if implements(scope, stubs, fn) {
+			methods = append(methods, method)
+		}
+	}
+	slices.Sort(methods)
+	return methods
+}
+
+// checkEntryPoints makes sure every declared method is defined by the script
+// and not left to the placeholder of the base runtime
+func (m *Manifest) checkEntryPoints(vm *goja.Runtime, stubs map[string]goja.Value) error {
+	var missing []string
+	for _, method := range m.Methods {
+		fn := manifestMethods[method]
+		if !implements(vm.GlobalObject(), stubs, fn) {
+			missing = append(missing, fn)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("invalid manifest: declared methods are not defined: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// applyManifest copies the manifest into the extension metadata
+func (ext *Ext) applyManifest(m *Manifest) {
+	ext.Manifest = m
+	ext.ApiVersion = "3"
+	ext.Name = m.Name
+	ext.Version = m.Version
+	ext.Pkg = m.Package
+	ext.Author = m.Author
+	ext.License = m.License
+	ext.Lang = m.Lang
+	ext.Icon = m.Icon
+	ext.Website = m.WebSite
+	ext.Description = m.Description
+	ext.Tags = m.Tags
+	ext.WatchType = m.Type
+	ext.Requires = m.Requires
+	ext.Methods = slices.Sorted(slices.Values(m.Methods))
+}
+
+// parseManifestMetadata handles the metadata of API v3 scripts. ok is false
+// when the script carries no manifest and the @key comments should be used.
+// Exported manifests can only be read by running the script, so only the
+// package is known until the extension is loaded
+func (ext *Ext) parseManifestMetadata(content string, fileName string) (ok bool, err error) {
+	if match := manifestBlock.FindStringSubmatch(content); match != nil {
+		m, e := parseManifest([]byte(match[1]))
+		if e != nil {
+			return true, e
+		}
+		if e := m.validate(fileName); e != nil {
+			return true, e
+		}
+		ext.applyManifest(m)
+		return true, nil
+	}
+	if manifestExport.MatchString(content) {
+		ext.ApiVersion = "3"
+		ext.Pkg = strings.TrimSuffix(fileName, ".js")
+		return true, nil
+	}
+	return false, nil
+}
+
+// replaceExportDeclarations turns the module style exports of API v3 scripts into globals
+func replaceExportDeclarations(jsCode string) string {
+	jsCode = manifestExport.ReplaceAllString(jsCode, "${1}globalThis.manifest =")
+	return functionExport.ReplaceAllString(jsCode, "${1}${2}")
+}
+
+// exportedManifest reads and validates the manifest object set by the script
+func exportedManifest(vm *goja.Runtime, fileName string) (*Manifest, error) {
+	value := vm.GlobalObject().Get("manifest")
+	if value == nil || goja.IsUndefined(value) || goja.IsNull(value) {
+		return nil, errNoManifest
+	}
+	data, e := json.Marshal(value.Export())
+	if e != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", e)
+	}
+	m, e := parseManifest(data)
+	if e != nil {
+		return nil, e
+	}
+	if e := m.validate(fileName); e != nil {
+		return nil, e
+	}
+	return m, nil
+}
@@ -0,0 +1,131 @@
+package jsExtension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+// loadTestExtensionV3 parses the manifest of code the way the loader does and loads it
+func loadTestExtensionV3(t *testing.T, pkg string, code string) *Ext {
+	t.Helper()
+	compileTestRuntimes(t)
+	ext := &Ext{Name: pkg + ".js"}
+	if err := ext.ParseExtMetadata(code, pkg+".js"); err != nil {
+		t.Fatal(err)
+	}
+	LoadApiV3(ext)
+	return ApiPkgCache.Load(pkg).Ext
+}
+
+func TestParseManifestBlock(t *testing.T) {
+	ext := &Ext{}
+	err := ext.ParseExtMetadata(`/** @manifest
+{
+  "name": "Example",
+  "version": "1.2.0",
+  "package": "test.manifest.block",
+  "webSite": "https://example.com",
+  "type": "manga",
+  "methods": ["latest", "search", "filters"],
+  "capabilities": { "domains": ["example.com", "*.cdn.example.com"], "settings": true }
+}
+*/
+async function latest(page) { return []; }`, "test.manifest.block.js")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "3", ext.ApiVersion)
+	assert.Equal(t, "test.manifest.block", ext.Pkg)
+	assert.Equal(t, "https://example.com", ext.Website)
+	assert.Equal(t, "manga", ext.WatchType)
+	assert.True(t, ext.Manifest.declares("createFilter"))
+	assert.True(t, ext.Manifest.declares("load"))
+	assert.False(t, ext.Manifest.declares("watch"))
+}
+
+func TestParseManifestRejectsMismatches(t *testing.T) {
+	tests := map[string]struct {
+		manifest string
+		err      string
+	}{
+		"unknown field": {
+			manifest: `{"name": "a", "version": "1.0.0", "package": "test.manifest.bad", "methods": ["latest"], "permissions": []}`,
+			err:      `unknown field "permissions"`,
+		},
+		"package": {
+			manifest: `{"name": "a", "version": "1.0.0", "package": "other", "methods": ["latest"]}`,
+			err:      "package other does not match the file name test.manifest.bad.js",
+		},
+		"method": {
+			manifest: `{"name": "a", "version": "1.0.0", "package": "test.manifest.bad", "methods": ["latest", "trending"]}`,
+			err:      `methods[1]: unknown method "trending"`,
+		},
+		"domain": {
+			manifest: `{"name": "a", "version": "1.0.0", "package": "test.manifest.bad", "methods": ["latest"], "capabilities": {"domains": ["https://example.com/"]}}`,
+			err:      `capabilities.domains[0]: "https://example.com/" is not a host name`,
+		},
+		"core version": {
+			manifest: `{"name": "a", "version": "1.0.0", "package": "test.manifest.bad", "methods": ["latest"], "minCoreVersion": "99.0.0"}`,
+			err:      "requires miru-core 99.0.0 or newer, running " + CoreVersion,
+		},
+		"version": {
+			manifest: `{"name": "a", "version": "one", "package": "test.manifest.bad", "methods": ["latest"]}`,
+			err:      `version "one" is not a semantic version`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ext := &Ext{}
+			err := ext.ParseExtMetadata("/* @manifest "+tt.manifest+" */", "test.manifest.bad.js")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestLoadExportedManifest(t *testing.T) {
+	ext := loadTestExtensionV3(t, "test.manifest.export", `
+export const manifest = {
+  name: "Exported",
+  version: "0.3.1",
+  package: "test.manifest.export",
+  webSite: "https://example.com",
+  methods: ["search", "detail"],
+};
+
+export async function search(kw, page, filter) {
+  return [{ title: kw, url: Miru.website }];
+}
+
+export async function detail(url) {
+  return { title: getSetting("quality") };
+}
+
+async function latest(page) {
+  return [];
+}`)
+	assert.Empty(t, ext.Error)
+	assert.Equal(t, "Exported", ext.Name)
+	assert.Equal(t, "3", ext.ApiVersion)
+
+	res, err := Search[proto.ExtensionListItem](context.Background(), "test.manifest.export", 1, "kw", "")
+	if assert.NoError(t, err) && assert.Len(t, res, 1) {
+		assert.Equal(t, "https://example.com", res[0].Url)
+	}
+
+	_, err = Latest[proto.ExtensionListItem](context.Background(), "test.manifest.export", 1)
+	assert.ErrorContains(t, err, "does not declare latest in its manifest")
+
+	_, err = Detail[proto.ExtensionDetail](context.Background(), "test.manifest.export", "/a")
+	assert.ErrorContains(t, err, "does not declare the settings capability")
+}
+
+func TestLoadManifestMissingEntryPoint(t *testing.T) {
+	ext := loadTestExtensionV3(t, "test.manifest.missing", `
+/* @manifest {"name": "Missing", "version": "1.0.0", "package": "test.manifest.missing", "methods": ["latest", "watch"]} */
+async function latest(page) {
+  return [];
+}`)
+	assert.Contains(t, ext.Error, "declared methods are not defined: watch")
+}
@@ -15,4 +15,28 @@ type Ext struct {
 	Error       string   `json:"error,omitempty"`
 	Context     *string
 	WatchType   string `json:"type"`
+	// Manifest declared by API v3 extensions, nil for older api versions
+	Manifest *Manifest `json:"manifest,omitempty"`
+	// Outcome of verifying the script against its repository, nil when it
+	// was not downloaded from one
+	Verification *Verification `json:"verification,omitempty"`
+	// Last script downloaded for the extension that failed verification, it
+	// was not installed
+	FailedVerification *Verification `json:"failedVerification,omitempty"`
+	// Disabled extensions are listed but never run
+	Disabled bool `json:"disabled,omitempty"`
+	// Version the user pinned the extension at, empty when it is not pinned
+	Pinned string `json:"pinned,omitempty"`
+	// Hosts besides the website declared with @domains by API v1 and v2
+	// extensions, API v3 extensions declare them in their manifest
+	Domains []string `json:"domains,omitempty"`
+	// Hosts the extension was blocked from since it was loaded, granting
+	// them allows the requests
+	BlockedDomains []string `json:"blockedDomains,omitempty"`
+	// Shared libraries the extension requires, name to version constraint
+	Requires map[string]string `json:"requires,omitempty"`
+	// Versions of the required libraries the extension was loaded with
+	Libraries map[string]string `json:"libraries,omitempty"`
+	// Methods the extension implements, named like in API v3 manifests
+	Methods []string `json:"methods,omitempty"`
 }
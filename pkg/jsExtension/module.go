@@ -2,11 +2,9 @@ package jsExtension
 
 import (
 	"github.com/dop251/goja"
-	"github.com/dop251/goja_nodejs/console"
 	"github.com/dop251/goja_nodejs/require"
 	"github.com/dop251/goja_nodejs/url"
 	errorhandle "github.com/miru-project/miru-core/pkg/errorHandle"
-	"github.com/miru-project/miru-core/pkg/logger"
 	log "github.com/miru-project/miru-core/pkg/logger"
 )
 
@@ -15,13 +13,12 @@ func initModule() {
 	linkeDomProgram, e := goja.Compile("linkedom.js", linkeDom, true)
 	vm := goja.New()
 	vm.RunProgram(linkeDomProgram)
-	parseHtml := vm.Get("parseHTML").Export().(func(goja.FunctionCall) goja.Value)
-	logger.Println(parseHtml)
 	if e != nil {
 		log.Println("Error executing linkedom:", e)
 	}
 	RegisterJSModule("linkedom", linkeDom, func(vm *goja.Runtime, module *goja.Object) {
 	})
+	sharedRegistry.RegisterNativeModule(domModule, requireDOM)
 
 	cryptoJs := string(errorhandle.HandleFatal(fs.ReadFile("assets/crypto-js/crypto-js.js")))
 	RegisterJSModule("crypto-js", cryptoJs, func(vm *goja.Runtime, module *goja.Object) {
@@ -47,7 +44,9 @@ func initModule() {
 func (ser *ExtBaseService) addModule(module *require.RequireModule, vm *goja.Runtime, job *Job) {
 	initCrypto(vm)
 	url.Enable(vm)
-	console.Enable(vm)
-	vm.Set("require", module.Require)
+	enableXPath(vm)
+	enableConsole(vm, job.pkg)
+	enableWebCompat(vm, job.pkg)
+	vm.Set("require", ser.requireLibraries(module.Require))
 	ser.initFetch(vm, job)
 }
@@ -0,0 +1,196 @@
+package jsExtension
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/miru-project/miru-core/config"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+// runtimePool holds the pre-initialised runtimes of one extension so that
+// calls to the same package can run concurrently. Settings and cookies live in
+// the database and the shared cookie jar, every runtime runs load() once so
+// js state set up there is the same on all of them.
+type runtimePool struct {
+	api  *ExtApi
+	pkg  string
+	size int
+	// slots limits the number of runtimes in use at the same time
+	slots chan struct{}
+	lock  sync.Mutex
+	idle  []*extRuntime
+	// closed pools terminate runtimes instead of taking them back
+	closed bool
+}
+
+var poolJanitor sync.Once
+
+func newRuntimePool(api *ExtApi) *runtimePool {
+	size := config.Global.ExtensionPoolSize
+	if size <= 0 {
+		size = config.DefaultExtensionPoolSize
+	}
+	pool := &runtimePool{
+		api:   api,
+		pkg:   api.Ext.Pkg,
+		size:  size,
+		slots: make(chan struct{}, size),
+	}
+	poolJanitor.Do(func() {
+		go evictIdleRuntimes()
+	})
+	return pool
+}
+
+func poolIdleTimeout() time.Duration {
+	if config.Global.ExtensionIdleTimeout > 0 {
+		return time.Duration(config.Global.ExtensionIdleTimeout) * time.Second
+	}
+	return config.DefaultExtensionIdleTimeout * time.Second
+}
+
+// acquire returns a free runtime, creating one when the pool is not full yet.
+// Calls still waiting when the extension is reloaded or removed fail instead
+// of running the old script
+func (p *runtimePool) acquire(ctx context.Context) (*extRuntime, error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, &ExtTimeoutError{Pkg: p.pkg, Err: ctx.Err()}
+	}
+
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		<-p.slots
+		return nil, p.closedError()
+	}
+	if n := len(p.idle); n > 0 {
+		rt := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.lock.Unlock()
+		return rt, nil
+	}
+	p.lock.Unlock()
+
+	rt, err := p.create(ctx)
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+	// The pool may have been closed while load() ran
+	p.lock.Lock()
+	closed := p.closed
+	p.lock.Unlock()
+	if closed {
+		p.discard(rt)
+		return nil, p.closedError()
+	}
+	return rt, nil
+}
+
+func (p *runtimePool) closedError() error {
+	return fmt.Errorf("extension %s was unloaded", p.pkg)
+}
+
+// create initialises a runtime and runs the extension load hook on it
+func (p *runtimePool) create(ctx context.Context) (*extRuntime, error) {
+	rt, err := p.api.initRuntime(p.pkg)
+	if err == nil {
+		if _, err = rt.call(ctx, p.pkg, p.api.invoker("load")); err != nil {
+			rt.terminate()
+		}
+	}
+	var budget *BudgetError
+	if errors.As(err, &budget) {
+		p.api.overBudget(budget)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+// warm creates the first runtime so load errors show up when the extension is loaded
+func (p *runtimePool) warm(ctx context.Context) error {
+	ctx, cancel := withCallTimeout(ctx)
+	defer cancel()
+	rt, err := p.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	p.release(rt)
+	return nil
+}
+
+// release gives a runtime back to the pool
+func (p *runtimePool) release(rt *extRuntime) {
+	rt.lastUsed = time.Now()
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		rt.terminate()
+	} else {
+		p.idle = append(p.idle, rt)
+		p.lock.Unlock()
+	}
+	<-p.slots
+}
+
+// discard drops a runtime that can't be trusted anymore, e.g. after an interrupt
+func (p *runtimePool) discard(rt *extRuntime) {
+	rt.terminate()
+	<-p.slots
+}
+
+// evict terminates runtimes that have been idle for longer than timeout,
+// the most recently used one is kept warm
+func (p *runtimePool) evict(timeout time.Duration) {
+	p.lock.Lock()
+	var stale []*extRuntime
+	keep := p.idle[:0]
+	for i, rt := range p.idle {
+		if i < len(p.idle)-1 && time.Since(rt.lastUsed) > timeout {
+			stale = append(stale, rt)
+			continue
+		}
+		keep = append(keep, rt)
+	}
+	p.idle = keep
+	p.lock.Unlock()
+
+	for _, rt := range stale {
+		rt.terminate()
+	}
+	if len(stale) > 0 {
+		log.Println("Evicted idle runtimes:", p.pkg, len(stale))
+	}
+}
+
+// close terminates the idle runtimes, runtimes in use are terminated on release
+func (p *runtimePool) close() {
+	p.lock.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.closed = true
+	p.lock.Unlock()
+
+	for _, rt := range idle {
+		rt.terminate()
+	}
+}
+
+func evictIdleRuntimes() {
+	for {
+		timeout := poolIdleTimeout()
+		time.Sleep(timeout / 2)
+		extMemMap.Range(func(key, value any) bool {
+			value.(*runtimePool).evict(timeout)
+			return true
+		})
+	}
+}
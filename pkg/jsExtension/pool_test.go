@@ -0,0 +1,103 @@
+package jsExtension
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolRunsCallsConcurrently(t *testing.T) {
+	loadTestExtension(t, "test.pool.concurrent", `
+let token;
+async function load() { token = "loaded"; }
+async function detail(url) {
+  await new Promise((resolve) => setTimeout(resolve, 1000));
+  return { title: token };
+}
+async function latest(page) { return [{ title: token, url: "/" }]; }`)
+
+	detailDone := make(chan error, 1)
+	go func() {
+		_, err := Detail[proto.ExtensionDetail](context.Background(), "test.pool.concurrent", "/slow")
+		detailDone <- err
+	}()
+	// Give detail time to take the warm runtime
+	time.Sleep(50 * time.Millisecond)
+
+	start := time.Now()
+	res, err := Latest[map[string]any](context.Background(), "test.pool.concurrent", 1)
+	assert.NoError(t, err)
+	// latest ran on a second runtime that also went through load()
+	assert.Equal(t, "loaded", (*res[0])["title"])
+	assert.Less(t, time.Since(start), 900*time.Millisecond)
+	assert.NoError(t, <-detailDone)
+}
+
+func TestPoolDiscardsInterruptedRuntime(t *testing.T) {
+	loadTestExtension(t, "test.pool.discard", `
+async function search(kw, page, filter) { while (true) {} }
+async function latest(page) { return [{ title: "ok", url: "/" }]; }`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := Search[proto.ExtensionListItem](ctx, "test.pool.discard", 1, "kw", "null")
+	var timeout *ExtTimeoutError
+	assert.ErrorAs(t, err, &timeout)
+
+	pool := ApiPkgCache.Load("test.pool.discard").pool
+	pool.lock.Lock()
+	idle := len(pool.idle)
+	pool.lock.Unlock()
+	assert.Equal(t, 0, idle)
+	assert.Len(t, pool.slots, 0)
+
+	res, err := Latest[map[string]any](context.Background(), "test.pool.discard", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", (*res[0])["title"])
+}
+
+func TestPoolAcquireAfterClose(t *testing.T) {
+	loadTestExtension(t, "test.pool.closed", `
+async function latest(page) { return [{ title: "ok", url: "/" }]; }`)
+	pool := ApiPkgCache.Load("test.pool.closed").pool
+
+	// Take every slot so the next call has to wait
+	var held []*extRuntime
+	for range cap(pool.slots) {
+		rt, err := pool.acquire(context.Background())
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		held = append(held, rt)
+	}
+	waiting := make(chan error, 1)
+	go func() {
+		rt, err := pool.acquire(context.Background())
+		if rt != nil {
+			pool.release(rt)
+		}
+		waiting <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	pool.close()
+	for _, rt := range held {
+		pool.release(rt)
+	}
+	select {
+	case err := <-waiting:
+		assert.ErrorContains(t, err, "was unloaded")
+	case <-time.After(time.Second):
+		t.Fatal("waiting call did not return")
+	}
+
+	_, err := pool.acquire(context.Background())
+	assert.ErrorContains(t, err, "was unloaded")
+	pool.lock.Lock()
+	assert.Empty(t, pool.idle)
+	pool.lock.Unlock()
+	assert.Len(t, pool.slots, 0)
+}
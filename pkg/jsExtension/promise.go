@@ -1,81 +1,115 @@
 package jsExtension
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	log "github.com/miru-project/miru-core/pkg/logger"
 
 	"github.com/dop251/goja"
 )
 
+// PromiseResult is the settled outcome of an extension call
 type PromiseResult struct {
-	promise *goja.Promise
-	err     error
+	value any
+	err   error
 }
 
-// Create a go routine that check Promise is fulfilled or rejected
-// and return the result
-func await(promise *goja.Promise) (any, error) {
-	done := make(chan int)
-	var dataOut any
-	go func() {
-		defer close(done)
+// Wait for the settled result pushed by handlePromise and return it
+func await(ctx context.Context, res chan PromiseResult) (any, error) {
+	select {
+	case result := <-res:
+		return result.value, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
 
-		for promise.State() == goja.PromiseStatePending {
-			time.Sleep(50 * time.Millisecond)
+// Convert the reason of a rejected promise into a go error
+func rejectionError(reason goja.Value) error {
+	switch res := reason.(type) {
+	case *goja.Object:
+		err := res.GetOwnPropertyNames()
+		errStr := "Js exception:"
+		for _, v := range err {
+			errStr += fmt.Sprintln(v, ":", res.Get(v).String())
 		}
-
-	}()
-	<-done
-	switch promise.State() {
-	case goja.PromiseStateFulfilled:
-
-		o := promise.Result().Export()
-		return o, nil
-
-	default: // case goja.PromiseStateRejected:
-
-		state := promise.State()
-		log.Println(state)
-		switch promise.Result().(type) {
-		case *goja.Object:
-			res := promise.Result().(*goja.Object)
-			err := res.GetOwnPropertyNames()
-			errStr := "Js exception:"
-			for _, v := range err {
-				errStr += fmt.Sprintln(v, ":", res.Get(v).String())
-			}
-			return dataOut, errors.New(errStr)
-		case goja.Value:
-			return dataOut, errors.New(promise.Result().String())
-		default:
-			return dataOut, errors.New("Js exception")
+		// Objects without own properties fall back to their string form
+		if len(err) == 0 {
+			errStr += res.String()
 		}
+		return errors.New(errStr)
+	case goja.Value:
+		return errors.New(res.String())
+	default:
+		return errors.New("Js exception")
 	}
 }
 
-// Promise for creating a single channel
-func (ser *ExtBaseService) resolvePromise(resolve func(any) error, reason any, job *Job) {
+// resolvePromise settles the promise of an async native with value, vm.ToValue
+// converts it on the loop
+func (ser *ExtBaseService) resolvePromise(resolve func(any) error, value any, job *Job) {
 
-	job.Done()
-	resolve(reason)
+	job.loop.RunOnLoop(func(*goja.Runtime) {
+		job.Done()
+		resolve(value)
+	})
 
 }
 func (ser *ExtBaseService) rejectPromise(reject func(any) error, reason any, job *Job) {
 
-	job.Done()
-	reject(reason)
+	job.loop.RunOnLoop(func(vm *goja.Runtime) {
+		job.Done()
+		reject(rejection(vm, reason))
+	})
 }
 
-// Create a single channel
-func (ser *ExtBaseService) createSingleChannel(vm *goja.Runtime, name string, job *Job, fun func(call goja.FunctionCall, resolve func(any) error) any) {
+// rejection turns what a native panicked with into the reason of its promise.
+// Go errors become js errors so the message survives the rejection
+func rejection(vm *goja.Runtime, reason any) any {
+	switch r := reason.(type) {
+	case *goja.Exception:
+		return r.Value()
+	case error:
+		return vm.NewGoError(r)
+	}
+	return reason
+}
+
+// prepareWork runs prepare and returns what it panicked with as the reason.
+// Interrupts are passed on so the running call still stops
+func prepareWork(prepare func(call goja.FunctionCall) func(ctx context.Context) any, call goja.FunctionCall) (work func(ctx context.Context) any, reason any) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(*goja.InterruptedError); ok {
+				panic(r)
+			}
+			reason = r
+		}
+	}()
+	return prepare(call), nil
+}
+
+// createSingleChannel defines name as an async native. prepare runs on the
+// event loop and reads everything the work needs from the arguments, the work
+// it returns runs on its own goroutine and must not touch the runtime, which
+// is not goroutine safe. Panics of either reject the promise
+func (ser *ExtBaseService) createSingleChannel(vm *goja.Runtime, name string, job *Job, prepare func(call goja.FunctionCall) func(ctx context.Context) any) {
 
 	vm.Set(name, func(call goja.FunctionCall) goja.Value {
 		promise, resolve, reject := vm.NewPromise()
+		// Capture the running call so the request is aborted together with it
+		ctx := job.Context()
+		work, reason := prepareWork(prepare, call)
+		if reason != nil {
+			reject(rejection(vm, reason))
+			return vm.ToValue(promise)
+		}
 		// 增加一個等待事件
-		job.Add()
+		if e := job.Add(); e != nil {
+			panic(vm.NewGoError(e))
+		}
 		// 異步方法
 		go func() {
 
@@ -86,9 +120,8 @@ func (ser *ExtBaseService) createSingleChannel(vm *goja.Runtime, name string, jo
 				}
 			}()
 
-			// Use RunOnLoop to dispatch function to event goroutine
-			reason := fun(call, resolve)
-			ser.resolvePromise(resolve, reason, job)
+			// Settle on the event loop, the runtime is not goroutine safe
+			ser.resolvePromise(resolve, work(ctx), job)
 
 		}()
 		// 返回 promise
@@ -96,17 +129,45 @@ func (ser *ExtBaseService) createSingleChannel(vm *goja.Runtime, name string, jo
 	})
 }
 
-func handlePromise(o goja.Value, res chan PromiseResult, e error) {
+// handlePromise pushes the result of an evaluated extension entry point into res.
+// Must be called on the event loop: pending promises get then handlers that
+// write to res as soon as the loop settles them.
+func handlePromise(vm *goja.Runtime, o goja.Value, res chan PromiseResult, e error) {
 	if e != nil {
 		// This kind of error happens before the async function is called
 		res <- PromiseResult{err: e}
 		return
 	}
-	out := o.Export()
-	if out == nil {
-		res <- PromiseResult{promise: &goja.Promise{}}
+	promise, ok := o.Export().(*goja.Promise)
+	if !ok {
+		// Entry point is not async, the value is already the result
+		res <- PromiseResult{value: o.Export()}
+		return
+	}
+
+	switch promise.State() {
+	case goja.PromiseStateFulfilled:
+		res <- PromiseResult{value: promise.Result().Export()}
 		return
+	case goja.PromiseStateRejected:
+		res <- PromiseResult{err: rejectionError(promise.Result())}
+		return
+	}
+
+	then, ok := goja.AssertFunction(o.ToObject(vm).Get("then"))
+	if !ok {
+		res <- PromiseResult{err: errors.New("promise has no then method")}
+		return
+	}
+	onFulfilled := func(call goja.FunctionCall) goja.Value {
+		res <- PromiseResult{value: call.Argument(0).Export()}
+		return goja.Undefined()
+	}
+	onRejected := func(call goja.FunctionCall) goja.Value {
+		res <- PromiseResult{err: rejectionError(call.Argument(0))}
+		return goja.Undefined()
+	}
+	if _, e := then(o, vm.ToValue(onFulfilled), vm.ToValue(onRejected)); e != nil {
+		res <- PromiseResult{err: e}
 	}
-	// Because it eval async funcion the value become a promise and send to channel
-	res <- PromiseResult{promise: o.Export().(*goja.Promise)}
 }
@@ -0,0 +1,117 @@
+package jsExtension
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/require"
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/ext"
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRuntimeOnce sync.Once
+var testDatabaseOnce sync.Once
+
+// loadTestExtension compiles the base runtimes once and loads code as a V2 extension
+func loadTestExtension(t *testing.T, pkg string, code string) {
+	t.Helper()
+	compileTestRuntimes(t)
+	LoadApiV2(&Ext{Name: pkg, Pkg: pkg, ApiVersion: "2", Context: &code})
+	if err := ApiPkgCache.Load(pkg).Ext.Error; err != "" {
+		t.Fatal(err)
+	}
+}
+
+// loadTestExtensionV1 loads code as a V1 extension declaring a class that extends Extension
+func loadTestExtensionV1(t *testing.T, pkg string, website string, code string) {
+	t.Helper()
+	compileTestRuntimes(t)
+	LoadApiV1(&Ext{Name: pkg, Pkg: pkg, ApiVersion: "1", Website: website, Context: &code})
+	if err := ApiPkgCache.Load(pkg).Ext.Error; err != "" {
+		t.Fatal(err)
+	}
+}
+
+// useTestDatabase points the ent client at an empty sqlite database
+func useTestDatabase(t *testing.T) {
+	testDatabaseOnce.Do(func() {
+		dir, err := os.MkdirTemp("", "miru-jsextension")
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.Global.Database.Driver = "sqlite3"
+		config.Global.Database.DBName = filepath.Join(dir, "miru.db")
+		// The client is opened lazily, which is not safe from concurrent requests
+		ext.EntClient()
+	})
+}
+
+func compileTestRuntimes(t *testing.T) {
+	testRuntimeOnce.Do(func() {
+		v1, err := os.ReadFile("../../binary/assets/runtime_v1.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+		v2, err := os.ReadFile("../../binary/assets/runtime_v2.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+		baseV1 = goja.MustCompile("runtime_v1.js", string(v1), true)
+		baseV2 = goja.MustCompile("runtime_v2.js", string(v2), true)
+		sharedRegistry = require.NewRegistry()
+		sharedRegistry.RegisterNativeModule(domModule, requireDOM)
+	})
+}
+
+func TestAwaitConcurrentCalls(t *testing.T) {
+	loadTestExtension(t, "test.await.concurrent", `
+async function latest(page) {
+  await new Promise((resolve) => setTimeout(resolve, page % 5));
+  return [{ title: "page " + page, url: "/" + page }];
+}`)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(page int) {
+			defer wg.Done()
+			res, err := Latest[map[string]any](context.Background(), "test.await.concurrent", page)
+			if assert.NoError(t, err) && assert.Len(t, res, 1) {
+				assert.Equal(t, fmt.Sprintf("page %d", page), (*res[0])["title"])
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestAwaitRejected(t *testing.T) {
+	loadTestExtension(t, "test.await.rejected", `
+async function search(kw, page, filter) {
+  await null;
+  throw new Error("search failed for " + kw);
+}`)
+
+	for i := 0; i < 10; i++ {
+		_, err := Latest[map[string]any](context.Background(), "test.await.rejected", i)
+		assert.ErrorContains(t, err, "not implement latest")
+	}
+	res, err := Search[proto.ExtensionListItem](context.Background(), "test.await.rejected", 1, "kw", "null")
+	assert.Nil(t, res)
+	assert.ErrorContains(t, err, "search failed for kw")
+}
+
+func TestAwaitSyncValue(t *testing.T) {
+	loadTestExtension(t, "test.await.sync", `
+var latest = (page) => [{ title: "sync " + page, url: "/" }];`)
+
+	res, err := Latest[map[string]any](context.Background(), "test.await.sync", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "sync 3", (*res[0])["title"])
+}
@@ -10,12 +10,26 @@ import (
 // RegisterJSModule registers a JavaScript module from a source string.
 // This allows the module to be loaded via standard require(name) in JavaScript.
 func RegisterJSModule(name string, source string, callback func(*goja.Runtime, *goja.Object)) {
-	// Wrap the source in a Node.js-style module wrapper
+	if err := registerJSModule(name, source, callback); err != nil {
+		panic(err)
+	}
+}
+
+// compileJSModule wraps source in a Node.js-style module wrapper and compiles it
+func compileJSModule(name string, source string) (*goja.Program, error) {
 	wrappedSource := "(function(exports, require, module, __filename, __dirname) {" + source + "\n})"
 
 	program, err := goja.Compile(name, wrappedSource, true)
 	if err != nil {
-		panic(fmt.Errorf("failed to compile module %s: %w", name, err))
+		return nil, fmt.Errorf("failed to compile module %s: %w", name, err)
+	}
+	return program, nil
+}
+
+func registerJSModule(name string, source string, callback func(*goja.Runtime, *goja.Object)) error {
+	program, err := compileJSModule(name, source)
+	if err != nil {
+		return err
 	}
 
 	sharedRegistry.RegisterNativeModule(name, func(vm *goja.Runtime, module *goja.Object) {
@@ -36,6 +50,7 @@ func RegisterJSModule(name string, source string, callback func(*goja.Runtime, *
 			callback(vm, module)
 		}
 	})
+	return nil
 }
 
 func LoadModule(vm *goja.Runtime, module *goja.Object, program *goja.Program, name string) {
@@ -0,0 +1,160 @@
+package jsExtension
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/miru-project/miru-core/pkg/db"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+// domainGrants caches the domains granted by the user per package
+var domainGrants = sync.Map{}
+
+// blockedLock serializes the updates of Ext.BlockedDomains
+var blockedLock sync.Mutex
+
+// DomainBlockedError is returned when an extension accesses a host outside
+// its allowed domains
+type DomainBlockedError struct {
+	Pkg  string
+	Host string
+}
+
+func (e *DomainBlockedError) Error() string {
+	return fmt.Sprintf("extension %s is not allowed to access %s, grant the domain to allow it", e.Pkg, e.Host)
+}
+
+// grantedDomains returns the domains the user granted to pkg
+func grantedDomains(pkg string) ([]string, error) {
+	if domains, ok := domainGrants.Load(pkg); ok {
+		return domains.([]string), nil
+	}
+	domains, e := db.GetGrantedDomains(pkg)
+	if e != nil {
+		return nil, e
+	}
+	domainGrants.Store(pkg, domains)
+	return domains, nil
+}
+
+// GrantDomains allows pkg to access extra domains and returns all granted domains
+func GrantDomains(pkg string, domains []string) ([]string, error) {
+	for _, domain := range domains {
+		if !domainPattern.MatchString(domain) {
+			return nil, fmt.Errorf("%q is not a host name", domain)
+		}
+	}
+	if e := db.GrantDomains(pkg, domains); e != nil {
+		return nil, e
+	}
+	domainGrants.Delete(pkg)
+	forgetBlocked(pkg, domains)
+	return grantedDomains(pkg)
+}
+
+// RevokeDomains removes domains granted to pkg and returns the remaining ones
+func RevokeDomains(pkg string, domains []string) ([]string, error) {
+	if e := db.RevokeDomains(pkg, domains); e != nil {
+		return nil, e
+	}
+	domainGrants.Delete(pkg)
+	return grantedDomains(pkg)
+}
+
+// allowedDomains are the website of the extension, the domains declared with
+// @domains or in its manifest and the ones granted by the user
+func (api *ExtApi) allowedDomains() ([]string, error) {
+	var domains []string
+	if site, e := url.Parse(api.Ext.Website); e == nil && site.Hostname() != "" {
+		host := strings.ToLower(site.Hostname())
+		domains = append(domains, host, "*."+host)
+	}
+	domains = append(domains, api.Ext.Domains...)
+	if m := api.Ext.Manifest; m != nil {
+		domains = append(domains, m.Capabilities.Domains...)
+	}
+	granted, e := grantedDomains(api.Ext.Pkg)
+	if e != nil {
+		return nil, e
+	}
+	return append(domains, granted...), nil
+}
+
+// matchDomain reports whether host is one of domains, `*.example.com` matches subdomains
+func matchDomain(host string, domains []string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	return slices.ContainsFunc(domains, func(domain string) bool {
+		if suffix, ok := strings.CutPrefix(domain, "*"); ok {
+			return strings.HasSuffix(host, suffix)
+		}
+		return host == domain
+	})
+}
+
+// checkURL rejects urls outside the allowed domains of the extension. The
+// error only fails the request, the blocked host is reported on the extension
+// which still loads and runs
+func (api *ExtApi) checkURL(rawURL string) error {
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return fmt.Errorf("invalid url %q: %w", rawURL, e)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("extension %s can only access http(s) urls, got %q", api.Ext.Pkg, rawURL)
+	}
+	domains, e := api.allowedDomains()
+	if e != nil {
+		return e
+	}
+	if matchDomain(u.Hostname(), domains) {
+		return nil
+	}
+	api.reportBlocked(u.Hostname())
+	return &DomainBlockedError{Pkg: api.Ext.Pkg, Host: u.Hostname()}
+}
+
+// reportBlocked publishes host on the extension so the user sees which domain
+// to grant, the extension keeps running
+func (api *ExtApi) reportBlocked(host string) {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	blockedLock.Lock()
+	defer blockedLock.Unlock()
+	if slices.Contains(api.Ext.BlockedDomains, host) {
+		return
+	}
+	// Runtimes of a replaced extension don't report on the new one
+	if cached, ok := ApiPkgCache.Map.Load(api.Ext.Pkg); !ok || cached != api {
+		return
+	}
+	log.Println("Extension", api.Ext.Pkg, "was blocked from accessing", host)
+	ApiPkgCache.Modify(api.Ext.Pkg, func(api *ExtApi) *ExtApi {
+		api.Ext.BlockedDomains = append(slices.Clip(api.Ext.BlockedDomains), host)
+		return api
+	})
+}
+
+// forgetBlocked removes the hosts matched by granted domains from the blocked
+// ones of pkg
+func forgetBlocked(pkg string, granted []string) {
+	blockedLock.Lock()
+	defer blockedLock.Unlock()
+	val, ok := ApiPkgCache.Map.Load(pkg)
+	if !ok {
+		return
+	}
+	blocked := val.(*ExtApi).Ext.BlockedDomains
+	remaining := slices.DeleteFunc(slices.Clone(blocked), func(host string) bool {
+		return matchDomain(host, granted)
+	})
+	if len(remaining) == len(blocked) {
+		return
+	}
+	ApiPkgCache.Modify(pkg, func(api *ExtApi) *ExtApi {
+		api.Ext.BlockedDomains = remaining
+		return api
+	})
+}
@@ -0,0 +1,94 @@
+package jsExtension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchDomain(t *testing.T) {
+	domains := []string{"example.com", "*.cdn.example.com"}
+	assert.True(t, matchDomain("example.com", domains))
+	assert.True(t, matchDomain("EXAMPLE.com.", domains))
+	assert.True(t, matchDomain("img.cdn.example.com", domains))
+	assert.False(t, matchDomain("cdn.example.com", domains))
+	assert.False(t, matchDomain("www.example.com", domains))
+	assert.False(t, matchDomain("badexample.com", domains))
+	assert.False(t, matchDomain("evil-cdn.example.com", domains))
+}
+
+func TestRequestOutsideAllowedDomains(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	pkg := "test.sandbox.request"
+	code := `
+async function latest(page) {
+  return await jsRequest("https://tracker.test/list?page=" + page, { method: "get" });
+}
+async function search(kw, page, filter) {
+  return await fetch("https://tracker.test/search?kw=" + kw);
+}
+async function detail(url) {
+  return { title: getCookies("https://tracker.test/") };
+}`
+	LoadApiV2(&Ext{Name: pkg, Pkg: pkg, ApiVersion: "2", Website: "https://example.com", Context: &code})
+	api := ApiPkgCache.Load(pkg)
+	assert.Empty(t, api.Ext.Error)
+
+	_, err := Latest[map[string]any](context.Background(), pkg, 1)
+	assert.ErrorContains(t, err, "is not allowed to access tracker.test")
+	_, err = Search[proto.ExtensionListItem](context.Background(), pkg, 1, "kw", "")
+	assert.ErrorContains(t, err, "is not allowed to access tracker.test")
+	_, err = Detail[proto.ExtensionDetail](context.Background(), pkg, "/a")
+	assert.ErrorContains(t, err, "is not allowed to access tracker.test")
+	// Blocked requests fail the call, not the extension, and are reported on it
+	assert.Empty(t, api.Ext.Error)
+	assert.Equal(t, []string{"tracker.test"}, ApiPkgCache.Load(pkg).Ext.BlockedDomains)
+
+	assert.NoError(t, api.checkURL("https://example.com/a"))
+	assert.NoError(t, api.checkURL("https://www.example.com/a"))
+	assert.Error(t, api.checkURL("file:///etc/passwd"))
+
+	granted, err := GrantDomains(pkg, []string{"tracker.test"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tracker.test"}, granted)
+	assert.NoError(t, api.checkURL("https://tracker.test/list"))
+	assert.Empty(t, ApiPkgCache.Load(pkg).Ext.BlockedDomains)
+
+	_, err = GrantDomains(pkg, []string{"tracker.test"})
+	assert.NoError(t, err)
+	_, err = GrantDomains(pkg, []string{"https://tracker.test"})
+	assert.Error(t, err)
+
+	granted, err = RevokeDomains(pkg, []string{"tracker.test"})
+	assert.NoError(t, err)
+	assert.Empty(t, granted)
+	assert.Error(t, api.checkURL("https://tracker.test/list"))
+}
+
+func TestDeclaredDomains(t *testing.T) {
+	useTestDatabase(t)
+	pkg := "test.sandbox.declared"
+	ext := &Ext{}
+	err := ext.ParseExtMetadata(`// @name Declared
+// @package `+pkg+`
+// @webSite https://example.com
+// @domains api.example.net, *.CDN.test static.test
+`, pkg+".js")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"api.example.net", "*.cdn.test", "static.test"}, ext.Domains)
+
+	api := &ExtApi{Ext: ext}
+	assert.NoError(t, api.checkURL("https://api.example.net/list"))
+	assert.NoError(t, api.checkURL("https://img.cdn.test/a.png"))
+	assert.NoError(t, api.checkURL("https://static.test/a.js"))
+	var blocked *DomainBlockedError
+	assert.ErrorAs(t, api.checkURL("https://tracker.test/"), &blocked)
+
+	err = (&Ext{}).ParseExtMetadata(`// @package `+pkg+`
+// @domains https://api.example.net
+`, pkg+".js")
+	assert.ErrorContains(t, err, "is not a host name")
+}
@@ -0,0 +1,87 @@
+package jsExtension
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/pkg/db"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+// extensionStates reads the state of every extension the user changed, keyed by package
+func extensionStates() map[string]*ent.ExtensionState {
+	states := map[string]*ent.ExtensionState{}
+	all, e := db.GetAllExtensionStates()
+	if e != nil {
+		log.Println("Failed to read the extension states:", e)
+		return states
+	}
+	for _, s := range all {
+		states[s.Package] = s
+	}
+	return states
+}
+
+// loadState reads whether the extension is disabled or pinned
+func (ext *Ext) loadState() {
+	s, e := db.GetExtensionState(ext.Pkg)
+	if e != nil {
+		log.Println("Failed to read the state of", ext.Pkg, ":", e)
+		return
+	}
+	ext.applyState(s)
+}
+
+func (ext *Ext) applyState(s *ent.ExtensionState) {
+	if s == nil {
+		return
+	}
+	ext.Disabled = !s.Enabled
+	ext.Pinned = s.PinnedVersion
+}
+
+// showDisabled lists a disabled extension without compiling or running it
+func showDisabled(ext *Ext) {
+	if pool, ok := extMemMap.LoadAndDelete(ext.Pkg); ok {
+		pool.(*runtimePool).close()
+	}
+	ApiPkgCache.Store(ext.Pkg, &ExtApi{Ext: ext, asyncCallBack: AsyncCallBack})
+	log.Println("Extension disabled:", ext.Pkg)
+}
+
+// SetExtensionEnabled enables or disables an installed extension. Disabled
+// extensions keep their file and stay listed, but their runtimes are closed
+// and calls to them fail. Enabling reloads the extension, which also brings
+// back one disabled for going over its budgets
+func SetExtensionEnabled(pkg string, enabled bool) (*ExtApi, error) {
+	loc := filepath.Join(ExtPath, pkg+".js")
+	if _, e := os.Stat(loc); e != nil {
+		return nil, fmt.Errorf("extension %s is not installed", pkg)
+	}
+	upgradeLock.Lock()
+	defer upgradeLock.Unlock()
+	if e := db.SetExtensionEnabled(pkg, enabled); e != nil {
+		return nil, e
+	}
+	defer holdWatcher(pkg)()
+	return reload(pkg, loc)
+}
+
+// PinExtension holds an extension at version so updates skip it, an empty
+// version unpins it
+func PinExtension(pkg string, version string) (*ExtApi, error) {
+	val, ok := ApiPkgCache.Map.Load(pkg)
+	if !ok {
+		return nil, fmt.Errorf("extension %s is not installed", pkg)
+	}
+	if e := db.SetExtensionPinnedVersion(pkg, version); e != nil {
+		return nil, e
+	}
+	ApiPkgCache.Modify(pkg, func(api *ExtApi) *ExtApi {
+		api.Ext.Pinned = version
+		return api
+	})
+	return val.(*ExtApi), nil
+}
@@ -0,0 +1,87 @@
+package jsExtension
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledExtensionsAreListedButNeverRun(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved := ExtPath
+	ExtPath = t.TempDir()
+	t.Cleanup(func() {
+		ExtPath = saved
+	})
+	pkg := "test.state.toggle"
+	installTestScript(t, pkg, testScript(pkg, "1.0.0"))
+	t.Cleanup(func() {
+		RemoveExtension(pkg)
+	})
+
+	api, err := SetExtensionEnabled(pkg, false)
+	assert.NoError(t, err)
+	assert.True(t, api.Ext.Disabled)
+	assert.Equal(t, "1.0.0", api.Ext.Version)
+	_, ok := extMemMap.Load(pkg)
+	assert.False(t, ok)
+	_, err = Invoke(t.Context(), pkg, "latest", 1)
+	assert.ErrorContains(t, err, "disabled")
+
+	// The state survives restarts
+	exts := filterExts(ExtPath)
+	if assert.Len(t, exts, 1) {
+		assert.True(t, exts[0].Disabled)
+	}
+
+	api, err = SetExtensionEnabled(pkg, true)
+	assert.NoError(t, err)
+	assert.False(t, api.Ext.Disabled)
+	_, err = Invoke(t.Context(), pkg, "latest", 1)
+	assert.NoError(t, err)
+
+	_, err = SetExtensionEnabled("test.state.missing", false)
+	assert.ErrorContains(t, err, "not installed")
+}
+
+func TestPinnedExtensionsAreNotUpgraded(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved := ExtPath
+	ExtPath = t.TempDir()
+	t.Cleanup(func() {
+		ExtPath = saved
+	})
+	repo := "http://pinned.test/index.json"
+	saveTestRepo(t, repo, "pinned test")
+	pkg := "test.state.pinned"
+	serveTestRepo(t, map[string]string{
+		"/index.json":          `[{"name": "Pinned", "package": "` + pkg + `", "version": "2.0.0"}]`,
+		"/repo/" + pkg + ".js": testScript(pkg, "2.0.0"),
+	})
+	installTestScript(t, pkg, testScript(pkg, "1.0.0"))
+	t.Cleanup(func() {
+		RemoveExtension(pkg)
+	})
+
+	_, err := PinExtension(pkg, "1.0.0")
+	assert.NoError(t, err)
+	updates, _, err := CheckUpdates()
+	assert.NoError(t, err)
+	assert.Empty(t, updates[repo])
+	_, err = UpgradeExtension(repo, pkg)
+	assert.ErrorContains(t, err, "pinned to 1.0.0")
+
+	// Reloads keep the pin
+	api, err := LoadFile(filepath.Join(ExtPath, pkg+".js"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", api.Ext.Pinned)
+
+	_, err = PinExtension(pkg, "")
+	assert.NoError(t, err)
+	update, err := UpgradeExtension(repo, pkg)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.0.0", update.Available)
+}
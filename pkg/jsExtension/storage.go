@@ -0,0 +1,87 @@
+package jsExtension
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/pkg/db"
+)
+
+// storageQuota is how many bytes the live entries of one extension may take
+func storageQuota() int {
+	if config.Global.ExtensionStorageQuota > 0 {
+		return config.Global.ExtensionStorageQuota << 10
+	}
+	return config.DefaultExtensionStorageQuota << 10
+}
+
+// registerStorage defines the natives behind Miru.storage. Values cross them
+// as JSON, the runtimes encode and decode them so they round trip like
+// JSON.parse(JSON.stringify(value)). storageSet takes the seconds the entry
+// lives for, undefined keeps it until it is deleted
+func (api *ExtApi) registerStorage(vm *goja.Runtime) {
+	pkg := api.Ext.Pkg
+	key := func(call goja.FunctionCall) string {
+		api.requireCapability(vm, "storage")
+		k := call.Argument(0)
+		if goja.IsUndefined(k) || goja.IsNull(k) || k.String() == "" {
+			panic(vm.NewTypeError("storage key must be a non-empty string"))
+		}
+		return k.String()
+	}
+
+	api.setFunction(vm, "storageGet", func(call goja.FunctionCall) goja.Value {
+		s, e := db.GetStorage(pkg, key(call))
+		if e != nil {
+			panic(vm.NewGoError(fmt.Errorf("error getting storage: %v", e)))
+		}
+		if s == nil {
+			return goja.Null()
+		}
+		return vm.ToValue(s.Value)
+	})
+
+	api.setFunction(vm, "storageSet", func(call goja.FunctionCall) goja.Value {
+		k := key(call)
+		value := call.Argument(1)
+		if goja.IsUndefined(value) {
+			panic(vm.NewTypeError("storage value must be JSON serializable"))
+		}
+		var expiresAt *time.Time
+		if ttl := call.Argument(2); !goja.IsUndefined(ttl) && !goja.IsNull(ttl) {
+			seconds := ttl.ToFloat()
+			if math.IsNaN(seconds) || math.IsInf(seconds, 0) || seconds <= 0 {
+				panic(vm.NewTypeError("storage ttl must be a positive number of seconds"))
+			}
+			t := time.Now().Add(time.Duration(seconds * float64(time.Second)))
+			expiresAt = &t
+		}
+		if e := db.SetStorage(pkg, k, value.String(), expiresAt, storageQuota()); e != nil {
+			panic(vm.NewGoError(fmt.Errorf("error setting storage: %v", e)))
+		}
+		return goja.Undefined()
+	})
+
+	api.setFunction(vm, "storageDelete", func(call goja.FunctionCall) goja.Value {
+		if e := db.DeleteStorage(pkg, key(call)); e != nil {
+			panic(vm.NewGoError(fmt.Errorf("error deleting storage: %v", e)))
+		}
+		return goja.Undefined()
+	})
+
+	api.setFunction(vm, "storageKeys", func(call goja.FunctionCall) goja.Value {
+		api.requireCapability(vm, "storage")
+		keys, e := db.GetStorageKeys(pkg)
+		if e != nil {
+			panic(vm.NewGoError(fmt.Errorf("error listing storage: %v", e)))
+		}
+		values := make([]any, len(keys))
+		for i, k := range keys {
+			values[i] = k
+		}
+		return vm.NewArray(values...)
+	})
+}
@@ -0,0 +1,91 @@
+package jsExtension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/pkg/db"
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorage(t *testing.T) {
+	useTestDatabase(t)
+	quota := config.Global.ExtensionStorageQuota
+	config.Global.ExtensionStorageQuota = 1
+	t.Cleanup(func() { config.Global.ExtensionStorageQuota = quota })
+
+	loadTestExtension(t, "test.storage", `
+const thrown = async (fn) => { try { await fn(); return ""; } catch (e) { return String(e); } };
+const sleep = (ms) => new Promise((resolve) => setTimeout(resolve, ms));
+async function latest(page) {
+  await Miru.storage.set("token", { value: "abc", scopes: ["read"] });
+  await Miru.storage.set("count", 1);
+  await Miru.storage.set("count", 2);
+  await Miru.storage.set("short", "gone", { ttl: 0.05 });
+  await Miru.storage.set("removed", true);
+  await Miru.storage.delete("removed");
+  const before = await Miru.storage.keys();
+  await sleep(100);
+  const out = {
+    token: await Miru.storage.get("token"),
+    count: await Miru.storage.get("count"),
+    missing: await Miru.storage.get("missing"),
+    expired: await Miru.storage.get("short"),
+    before,
+    after: await Miru.storage.keys(),
+    quota: await thrown(() => Miru.storage.set("big", "x".repeat(1024))),
+    undefinedValue: await thrown(() => Miru.storage.set("u", undefined)),
+    emptyKey: await thrown(() => Miru.storage.get("")),
+    badTTL: await thrown(() => Miru.storage.set("t", 1, { ttl: -1 })),
+  };
+  return [{ title: "storage", url: JSON.stringify(out) }];
+}`)
+	out := fetchResult(t, "test.storage")
+
+	assert.Equal(t, map[string]any{"value": "abc", "scopes": []any{"read"}}, out["token"])
+	assert.Equal(t, 2.0, out["count"])
+	assert.Nil(t, out["missing"])
+	assert.Nil(t, out["expired"])
+	assert.Equal(t, []any{"count", "short", "token"}, out["before"])
+	assert.Equal(t, []any{"count", "token"}, out["after"])
+	assert.Contains(t, out["quota"], "storage quota exceeded")
+	assert.Contains(t, out["undefinedValue"], "JSON serializable")
+	assert.Contains(t, out["emptyKey"], "non-empty string")
+	assert.Contains(t, out["badTTL"], "positive number of seconds")
+
+	// Entries are scoped to the package and dropped with the extension
+	keys, err := db.GetStorageKeys("test.storage.other")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.NoError(t, db.ClearStorage("test.storage"))
+	keys, err = db.GetStorageKeys("test.storage")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestStorageInRuntimes(t *testing.T) {
+	useTestDatabase(t)
+	loadTestExtensionV1(t, "test.storage.v1", "https://example.com", `
+class Test extends Extension {
+  async latest(page) {
+    await this.storage.set("page", page);
+    return [{ title: "v1", url: JSON.stringify({ page: await this.storage.get("page") }) }];
+  }
+}`)
+	assert.Equal(t, map[string]any{"page": 1.0}, fetchResult(t, "test.storage.v1"))
+
+	ext := loadTestExtensionV3(t, "test.storage.v3", `
+/* @manifest {"name": "Storage", "version": "1.0.0", "package": "test.storage.v3", "webSite": "https://example.com", "methods": ["latest", "search"]} */
+async function latest(page) {
+  await Miru.storage.set("page", page);
+  return [];
+}
+async function search(kw, page, filter) {
+  return [];
+}`)
+	assert.Empty(t, ext.Error)
+	_, err := Latest[proto.ExtensionListItem](context.Background(), "test.storage.v3", 1)
+	assert.ErrorContains(t, err, "does not declare the storage capability")
+}
@@ -0,0 +1,206 @@
+package jsExtension
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/miru-project/miru-core/pkg/event"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+// ExtensionUpdate is a newer version of an installed extension found in a repository
+type ExtensionUpdate struct {
+	Repo      string `json:"repo"`
+	Pkg       string `json:"package"`
+	Name      string `json:"name"`
+	Installed string `json:"installed"`
+	Available string `json:"available"`
+}
+
+// upgradeLock serialises upgrades so rollbacks restore what they replaced
+var upgradeLock sync.Mutex
+
+// CheckUpdates compares the installed extensions with the repository indexes
+// and returns the outdated ones per repository. Repositories that failed to
+// fetch are returned in the error map. An updates available event is sent
+// when something is outdated
+func CheckUpdates() (map[string][]ExtensionUpdate, map[string]error, error) {
+	repos, errs, e := FetchExtensionRepo()
+	if e != nil {
+		return nil, nil, e
+	}
+	updates := map[string][]ExtensionUpdate{}
+	var found []ExtensionUpdate
+	for repo, exts := range repos {
+		for _, ext := range exts {
+			update, ok := outdated(repo, ext)
+			if !ok {
+				continue
+			}
+			updates[repo] = append(updates[repo], update)
+			found = append(found, update)
+		}
+	}
+	if len(found) > 0 {
+		event.SendExtensionUpdatesAvailable(found)
+	}
+	return updates, errs, nil
+}
+
+// outdated reports whether ext is newer than the installed version of its package
+func outdated(repo string, ext GithubExtension) (ExtensionUpdate, bool) {
+	update := ExtensionUpdate{Repo: repo, Pkg: ext.Package, Name: ext.Name, Available: ext.Version}
+	val, ok := ApiPkgCache.Map.Load(ext.Package)
+	if !ok {
+		return update, false
+	}
+	installedExt := val.(*ExtApi).Ext
+	update.Installed = installedExt.Version
+	if installedExt.Pinned != "" {
+		return update, false
+	}
+	available, e := semver.NewVersion(ext.Version)
+	if e != nil {
+		log.Println("Invalid version in repository", repo, ext.Package, ext.Version)
+		return update, false
+	}
+	installed, e := semver.NewVersion(update.Installed)
+	if e != nil {
+		// Anything is better than an installed version we can't read
+		return update, true
+	}
+	return update, available.GreaterThan(installed)
+}
+
+// UpgradeExtension installs the version of pkg found in repoUrl. The installed
+// version is restored when the new one fails to load
+func UpgradeExtension(repoUrl string, pkg string) (*ExtensionUpdate, error) {
+	upgradeLock.Lock()
+	defer upgradeLock.Unlock()
+	update, _, e := upgrade(repoUrl, pkg)
+	return update, e
+}
+
+// UpgradeAll upgrades every outdated extension. Atomic upgrades restore all
+// the extensions when one of them fails, otherwise only the failed ones are
+// restored. It returns the applied upgrades and the failures per package
+func UpgradeAll(atomic bool) ([]ExtensionUpdate, map[string]error, error) {
+	updates, _, e := CheckUpdates()
+	if e != nil {
+		return nil, nil, e
+	}
+	upgradeLock.Lock()
+	defer upgradeLock.Unlock()
+
+	// The same package may be outdated in several repositories, take the newest
+	newest := map[string]ExtensionUpdate{}
+	for _, list := range updates {
+		for _, update := range list {
+			if cur, ok := newest[update.Pkg]; ok && !newerVersion(update.Available, cur.Available) {
+				continue
+			}
+			newest[update.Pkg] = update
+		}
+	}
+
+	var applied []ExtensionUpdate
+	var done []*install
+	failed := map[string]error{}
+	for pkg, update := range newest {
+		res, inst, e := upgrade(update.Repo, pkg)
+		if e != nil {
+			failed[pkg] = e
+			if atomic {
+				break
+			}
+			continue
+		}
+		applied = append(applied, *res)
+		done = append(done, inst)
+	}
+	if atomic && len(failed) > 0 {
+		for _, inst := range done {
+			if e := inst.restore(); e != nil {
+				failed[inst.pkg] = e
+			}
+		}
+		return nil, failed, nil
+	}
+	return applied, failed, nil
+}
+
+func newerVersion(a string, b string) bool {
+	va, e := semver.NewVersion(a)
+	if e != nil {
+		return false
+	}
+	vb, e := semver.NewVersion(b)
+	if e != nil {
+		return true
+	}
+	return va.GreaterThan(vb)
+}
+
+// upgrade downloads pkg from repoUrl and installs it, must hold upgradeLock
+func upgrade(repoUrl string, pkg string) (*ExtensionUpdate, *install, error) {
+	entry, e := findInRepo(repoUrl, pkg)
+	if e != nil {
+		return nil, nil, e
+	}
+	if val, ok := ApiPkgCache.Map.Load(pkg); ok {
+		if pinned := val.(*ExtApi).Ext.Pinned; pinned != "" && pinned != entry.Version {
+			return nil, nil, fmt.Errorf("extension %s is pinned to %s, %s has %s", pkg, pinned, repoUrl, entry.Version)
+		}
+	}
+	update, _ := outdated(repoUrl, *entry)
+	content, v, e := downloadPackage(repoUrl, pkg)
+	if e != nil {
+		return nil, nil, e
+	}
+	inst, e := installPackage(pkg, content, v)
+	if e != nil {
+		if inst == nil {
+			return nil, nil, e
+		}
+		if re := inst.restore(); re != nil {
+			return nil, nil, fmt.Errorf("%v, restoring the previous version failed: %v", e, re)
+		}
+		return nil, nil, fmt.Errorf("%v, the previous version was restored", e)
+	}
+	update.Available = inst.api.Ext.Version
+	log.Println("Upgraded extension:", pkg, update.Installed, "->", update.Available)
+	return &update, inst, nil
+}
+
+// StartUpdateChecker checks for updates every interval, and upgrades the
+// outdated extensions when autoUpgrade is set. It does nothing when interval is 0
+func StartUpdateChecker(interval time.Duration, autoUpgrade bool) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if !autoUpgrade {
+				if _, _, e := CheckUpdates(); e != nil {
+					log.Println("Failed to check extension updates:", e)
+				}
+				continue
+			}
+			applied, failed, e := UpgradeAll(false)
+			if e != nil {
+				log.Println("Failed to upgrade extensions:", e)
+				continue
+			}
+			for pkg, e := range failed {
+				log.Println("Failed to upgrade extension", pkg, ":", e)
+			}
+			if len(applied) > 0 {
+				log.Println("Upgraded", len(applied), "extensions")
+			}
+		}
+	}()
+}
@@ -0,0 +1,131 @@
+package jsExtension
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miru-project/miru-core/pkg/event"
+	"github.com/miru-project/miru-core/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+const updateRepo = "http://repo.test/index.json"
+
+func testScript(pkg string, version string) string {
+	return `// @name ` + pkg + `
+// @version ` + version + `
+// @package ` + pkg + `
+// @apiVersion 2
+
+async function latest(page) {
+  return [{ title: "` + version + `", url: "/" }];
+}
+`
+}
+
+// serveTestRepo answers repository requests from files, keyed by the url path
+func serveTestRepo(t *testing.T, files map[string]string) {
+	t.Helper()
+	network.UseCookieJar(t.TempDir())
+	network.SetTransport(func(req *fasthttp.Request, res *fasthttp.Response) error {
+		body, ok := files[string(req.URI().Path())]
+		if !ok {
+			res.SetStatusCode(fasthttp.StatusNotFound)
+			return nil
+		}
+		res.SetBodyString(body)
+		return nil
+	})
+	t.Cleanup(func() {
+		network.SetTransport(nil)
+		fetchedExtensionRepo = nil
+	})
+}
+
+// saveTestRepo adds a repository for the length of the test, the database is
+// shared by the whole package
+func saveTestRepo(t *testing.T, repoUrl string, name string) {
+	t.Helper()
+	assert.NoError(t, SaveExtensionRepo(repoUrl, name))
+	t.Cleanup(func() {
+		RemoveExtensionRepo(repoUrl)
+	})
+}
+
+func installTestScript(t *testing.T, pkg string, script string) {
+	t.Helper()
+	loc := filepath.Join(ExtPath, pkg+".js")
+	assert.NoError(t, os.WriteFile(loc, []byte(script), 0644))
+	if _, err := LoadFile(loc); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpgradeExtensions(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved := ExtPath
+	ExtPath = t.TempDir()
+	t.Cleanup(func() {
+		ExtPath = saved
+	})
+	saveTestRepo(t, updateRepo, "update test")
+
+	serveTestRepo(t, map[string]string{
+		"/index.json": `[
+  {"name": "One", "package": "test.update.one", "version": "1.1.0"},
+  {"name": "Broken", "package": "test.update.broken", "version": "2.0.0"},
+  {"name": "Current", "package": "test.update.current", "version": "1.0.0"}
+]`,
+		"/repo/test.update.one.js":    testScript("test.update.one", "1.1.0"),
+		"/repo/test.update.broken.js": testScript("test.update.broken", "2.0.0") + "}",
+	})
+	installTestScript(t, "test.update.one", testScript("test.update.one", "1.0.0"))
+	installTestScript(t, "test.update.broken", testScript("test.update.broken", "1.0.0"))
+	installTestScript(t, "test.update.current", testScript("test.update.current", "1.0.0"))
+
+	events := event.GlobalBus.Subscribe()
+	defer event.GlobalBus.Unsubscribe(events)
+	updates, repoErrs, err := CheckUpdates()
+	assert.NoError(t, err)
+	assert.Empty(t, repoErrs)
+	if assert.Len(t, updates[updateRepo], 2) {
+		assert.Equal(t, ExtensionUpdate{Repo: updateRepo, Pkg: "test.update.one", Name: "One", Installed: "1.0.0", Available: "1.1.0"}, updates[updateRepo][0])
+		assert.Equal(t, "test.update.broken", updates[updateRepo][1].Pkg)
+	}
+	select {
+	case e := <-events:
+		assert.Equal(t, event.ExtensionUpdatesAvailable, e.Type)
+		assert.Len(t, e.Data, 2)
+	case <-time.After(time.Second):
+		t.Fatal("no updates available event")
+	}
+
+	// One broken upgrade rolls back all of them
+	applied, failed, err := UpgradeAll(true)
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.Contains(t, failed, "test.update.broken")
+	assert.Equal(t, "1.0.0", ApiPkgCache.Load("test.update.one").Ext.Version)
+	assert.Equal(t, "1.0.0", ApiPkgCache.Load("test.update.broken").Ext.Version)
+	assert.Empty(t, ApiPkgCache.Load("test.update.broken").Ext.Error)
+
+	// Otherwise only the broken one is rolled back
+	applied, failed, err = UpgradeAll(false)
+	assert.NoError(t, err)
+	if assert.Len(t, applied, 1) {
+		assert.Equal(t, "1.1.0", applied[0].Available)
+	}
+	assert.ErrorContains(t, failed["test.update.broken"], "does not compile")
+	assert.Equal(t, "1.1.0", ApiPkgCache.Load("test.update.one").Ext.Version)
+	script, err := os.ReadFile(filepath.Join(ExtPath, "test.update.broken.js"))
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(script), "@version 1.0.0"))
+
+	_, err = UpgradeExtension(updateRepo, "test.update.missing")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,323 @@
+package jsExtension
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/miru-project/miru-core/config"
+	log "github.com/miru-project/miru-core/pkg/logger"
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Validation modes of extension results. Lenient logs the problems and keeps
+// the result, strict fails the call
+const (
+	ValidationLenient = "lenient"
+	ValidationStrict  = "strict"
+)
+
+var validationModes = []string{ValidationLenient, ValidationStrict}
+
+// ResultError is returned in strict mode when an extension result does not
+// match the proto model of the method
+type ResultError struct {
+	Pkg      string
+	Method   string
+	Problems []string
+}
+
+func (e *ResultError) Error() string {
+	return fmt.Sprintf("extension %s returned an invalid %s result: %s", e.Pkg, e.Method, strings.Join(e.Problems, "; "))
+}
+
+type linkKind int
+
+const (
+	noLink linkKind = iota
+	// The value must parse as a url, relative urls are resolved by the extension
+	anyLink
+	// The value must be an absolute http(s) or data url
+	absoluteLink
+)
+
+// fieldRule is a check on top of the types of the proto model, required
+// repeated fields must not be empty
+type fieldRule struct {
+	required bool
+	link     linkKind
+}
+
+var resultRules = map[protoreflect.Name]map[protoreflect.Name]fieldRule{
+	"ExtensionListItem": {
+		"title": {required: true},
+		"url":   {required: true, link: anyLink},
+		"cover": {link: absoluteLink},
+	},
+	"ExtensionDetail": {
+		"title": {required: true},
+		"cover": {link: absoluteLink},
+	},
+	"ExtensionEpisodeGroup": {
+		"urls": {required: true},
+	},
+	"ExtensionEpisode": {
+		"name": {required: true},
+		"url":  {required: true, link: anyLink},
+	},
+	"ExtensionWatch": {
+		"groups": {required: true},
+	},
+	"ExtensionMirrorGroup": {
+		"mirrors": {required: true},
+	},
+	"ExtensionMirror": {
+		"url": {required: true, link: anyLink},
+	},
+	"ExtensionBangumiWatch": {
+		"url": {required: true, link: anyLink},
+	},
+	"ExtensionBangumiWatchSubtitle": {
+		"url": {required: true, link: anyLink},
+	},
+	"ExtensionMangaWatch": {
+		"urls": {required: true, link: absoluteLink},
+	},
+	"ExtensionFikushonWatch": {
+		"content": {required: true},
+	},
+}
+
+// resultSchema returns the proto model the result of method must match and
+// whether the result is a list of it, nil for methods without a model
+func resultSchema(ext *Ext, method string) (protoreflect.MessageDescriptor, bool) {
+	switch method {
+	case "latest", "search", "popular":
+		return (&proto.ExtensionListItem{}).ProtoReflect().Descriptor(), true
+	case "detail":
+		return (&proto.ExtensionDetail{}).ProtoReflect().Descriptor(), false
+	case "watch":
+		switch {
+		case ext.ApiVersion == "2" || ext.ApiVersion == "3":
+			return (&proto.ExtensionWatch{}).ProtoReflect().Descriptor(), false
+		case ext.WatchType == "manga":
+			return (&proto.ExtensionMangaWatch{}).ProtoReflect().Descriptor(), false
+		case ext.WatchType == "fikushon":
+			return (&proto.ExtensionFikushonWatch{}).ProtoReflect().Descriptor(), false
+		default:
+			return (&proto.ExtensionBangumiWatch{}).ProtoReflect().Descriptor(), false
+		}
+	}
+	return nil, false
+}
+
+// ValidateResult checks the result of an entry point against the proto model of
+// the method and returns the problems found, prefixed with their field path
+func ValidateResult(ext *Ext, method string, result any) []string {
+	md, list := resultSchema(ext, method)
+	if md == nil {
+		return nil
+	}
+	v := &resultValidator{}
+	if !list {
+		v.message("", result, md)
+		return v.problems
+	}
+	items, ok := result.([]any)
+	if !ok {
+		v.problem("", "expected a list, got %s", typeName(result))
+		return v.problems
+	}
+	for i, item := range items {
+		v.message(fmt.Sprintf("[%d]", i), item, md)
+	}
+	return v.problems
+}
+
+// validationMode is the mode configured for the package, then the one asked
+// for by its manifest, then the default
+func validationMode(ext *Ext) string {
+	if mode, ok := config.Global.ExtensionValidationModes[ext.Pkg]; ok {
+		return mode
+	}
+	if ext.Manifest != nil && ext.Manifest.Validation != "" {
+		return ext.Manifest.Validation
+	}
+	if config.Global.ExtensionValidation != "" {
+		return config.Global.ExtensionValidation
+	}
+	return ValidationLenient
+}
+
+// checkResult validates the result of method, the problems are an error in
+// strict mode and only logged in lenient mode
+func (api *ExtApi) checkResult(method string, result any) error {
+	problems := ValidateResult(api.Ext, method, result)
+	if len(problems) == 0 {
+		return nil
+	}
+	err := &ResultError{Pkg: api.Ext.Pkg, Method: method, Problems: problems}
+	if validationMode(api.Ext) == ValidationStrict {
+		return err
+	}
+	log.Println("Extension returned an invalid result:", err)
+	return nil
+}
+
+type resultValidator struct {
+	problems []string
+}
+
+func (v *resultValidator) problem(path string, format string, args ...any) {
+	if path == "" {
+		path = "result"
+	}
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *resultValidator) message(path string, value any, md protoreflect.MessageDescriptor) {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		v.problem(path, "expected an object, got %s", typeName(value))
+		return
+	}
+	rules := resultRules[md.Name()]
+	seen := map[protoreflect.Name]bool{}
+	for _, k := range sortedKeys(obj) {
+		fieldPath := joinPath(path, k)
+		fd := md.Fields().ByName(protoreflect.Name(k))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(k)
+		}
+		if fd == nil {
+			v.problem(fieldPath, "unknown field")
+			continue
+		}
+		if obj[k] == nil {
+			// null is the same as a missing field
+			continue
+		}
+		seen[fd.Name()] = true
+		v.field(fieldPath, obj[k], fd, rules[fd.Name()])
+	}
+
+	fields := md.Fields()
+	for i := range fields.Len() {
+		name := fields.Get(i).Name()
+		if rules[name].required && !seen[name] {
+			v.problem(joinPath(path, string(name)), "is required")
+		}
+	}
+}
+
+func (v *resultValidator) field(path string, value any, fd protoreflect.FieldDescriptor, rule fieldRule) {
+	switch {
+	case fd.IsMap():
+		obj, ok := value.(map[string]any)
+		if !ok {
+			v.problem(path, "expected an object, got %s", typeName(value))
+			return
+		}
+		for _, k := range sortedKeys(obj) {
+			v.single(joinPath(path, k), obj[k], fd.MapValue(), fieldRule{})
+		}
+	case fd.IsList():
+		items, ok := value.([]any)
+		if !ok {
+			v.problem(path, "expected a list, got %s", typeName(value))
+			return
+		}
+		if rule.required && len(items) == 0 {
+			v.problem(path, "must not be empty")
+		}
+		for i, item := range items {
+			v.single(fmt.Sprintf("%s[%d]", path, i), item, fd, rule)
+		}
+	default:
+		v.single(path, value, fd, rule)
+	}
+}
+
+// single checks one value of a field, the elements of lists and maps included
+func (v *resultValidator) single(path string, value any, fd protoreflect.FieldDescriptor, rule fieldRule) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		v.message(path, value, fd.Message())
+	case protoreflect.StringKind:
+		s, ok := value.(string)
+		if !ok {
+			v.problem(path, "expected a string, got %s", typeName(value))
+			return
+		}
+		if rule.required && strings.TrimSpace(s) == "" {
+			v.problem(path, "must not be empty")
+			return
+		}
+		if s != "" {
+			v.link(path, s, rule.link)
+		}
+	case protoreflect.BoolKind:
+		if _, ok := value.(bool); !ok {
+			v.problem(path, "expected a boolean, got %s", typeName(value))
+		}
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		switch n := value.(type) {
+		case int64:
+			if fd.Kind() == protoreflect.Int32Kind && (n > math.MaxInt32 || n < math.MinInt32) {
+				v.problem(path, "%d does not fit in 32 bits", n)
+			}
+		case float64:
+			v.problem(path, "expected an integer, got %v", n)
+		default:
+			v.problem(path, "expected an integer, got %s", typeName(value))
+		}
+	}
+}
+
+func (v *resultValidator) link(path string, s string, kind linkKind) {
+	if kind == noLink {
+		return
+	}
+	u, e := url.Parse(s)
+	if e != nil {
+		v.problem(path, "%q is not a valid url", s)
+		return
+	}
+	if kind == absoluteLink && u.Scheme != "data" && ((u.Scheme != "http" && u.Scheme != "https") || u.Host == "") {
+		v.problem(path, "%q is not an absolute http(s) url", s)
+	}
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedKeys(obj map[string]any) []string {
+	return slices.Sorted(maps.Keys(obj))
+}
+
+// typeName names the js type of an exported value
+func typeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int64, float64:
+		return "number"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,107 @@
+package jsExtension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/proto/generate/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateResult(t *testing.T) {
+	v2 := &Ext{ApiVersion: "2"}
+	manga := &Ext{ApiVersion: "1", WatchType: "manga"}
+	tests := []struct {
+		name     string
+		ext      *Ext
+		method   string
+		result   any
+		problems []string
+	}{
+		{"valid list", v2, "latest", []any{
+			map[string]any{"title": "A", "url": "/a", "cover": "https://example.com/a.jpg", "headers": map[string]any{"Referer": "https://example.com"}},
+		}, nil},
+		{"missing list fields", v2, "search", []any{
+			map[string]any{"title": "A", "url": "/a"},
+			map[string]any{"title": "", "cover": "/b.jpg"},
+		}, []string{
+			`[1].cover: "/b.jpg" is not an absolute http(s) url`,
+			"[1].title: must not be empty",
+			"[1].url: is required",
+		}},
+		{"not a list", v2, "latest", map[string]any{"title": "A"}, []string{"result: expected a list, got object"}},
+		{"detail episodes", v2, "detail", map[string]any{
+			"title": "A",
+			"episodes": []any{
+				map[string]any{"title": "main", "urls": []any{map[string]any{"name": "1", "url": "/1"}}},
+				map[string]any{"title": "empty", "urls": []any{}},
+				map[string]any{"title": "broken", "urls": []any{
+					map[string]any{"name": "1", "url": "/1"},
+					map[string]any{"name": int64(2), "url": "%zz"},
+				}},
+			},
+			"rating": int64(5),
+		}, []string{
+			"episodes[1].urls: must not be empty",
+			"episodes[2].urls[1].name: expected a string, got number",
+			`episodes[2].urls[1].url: "%zz" is not a valid url`,
+			"rating: unknown field",
+		}},
+		{"null is missing", v2, "detail", map[string]any{"title": nil}, []string{"title: is required"}},
+		{"v2 watch", v2, "watch", map[string]any{
+			"groups":        []any{map[string]any{"title": "a", "mirrors": []any{}}},
+			"default_index": 1.5,
+		}, []string{
+			"default_index: expected an integer, got 1.5",
+			"groups[0].mirrors: must not be empty",
+		}},
+		{"manga watch", manga, "watch", map[string]any{
+			"urls": []any{"https://example.com/1.jpg", "2.jpg"},
+		}, []string{`urls[1]: "2.jpg" is not an absolute http(s) url`}},
+		{"no model", v2, "createFilter", "anything", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.problems, ValidateResult(tt.ext, tt.method, tt.result))
+		})
+	}
+}
+
+func TestStrictValidation(t *testing.T) {
+	loadTestExtension(t, "test.validate.strict", `
+async function latest(page) {
+  return [{ title: "A", url: "/a" }, { title: "B" }];
+}`)
+
+	// Lenient mode keeps the result
+	res, err := Latest[proto.ExtensionListItem](context.Background(), "test.validate.strict", 1)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+
+	saved := config.Global.ExtensionValidationModes
+	config.Global.ExtensionValidationModes = map[string]string{"test.validate.strict": ValidationStrict}
+	t.Cleanup(func() {
+		config.Global.ExtensionValidationModes = saved
+	})
+
+	_, err = Latest[proto.ExtensionListItem](context.Background(), "test.validate.strict", 1)
+	var resultErr *ResultError
+	if assert.ErrorAs(t, err, &resultErr) {
+		assert.Equal(t, []string{"[1].url: is required"}, resultErr.Problems)
+	}
+}
+
+func TestManifestValidationMode(t *testing.T) {
+	ext := &Ext{}
+	err := ext.ParseExtMetadata(`/* @manifest
+{ "name": "Strict", "version": "1.0.0", "package": "test.validate.manifest", "methods": ["detail"], "validation": "strict" }
+*/`, "test.validate.manifest.js")
+	assert.NoError(t, err)
+	assert.Equal(t, ValidationStrict, validationMode(ext))
+
+	err = ext.ParseExtMetadata(`/* @manifest
+{ "name": "Strict", "version": "1.0.0", "package": "test.validate.manifest", "methods": ["detail"], "validation": "pedantic" }
+*/`, "test.validate.manifest.js")
+	assert.ErrorContains(t, err, `validation "pedantic" must be one of lenient, strict`)
+}
@@ -0,0 +1,251 @@
+package jsExtension
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/ent"
+	"github.com/miru-project/miru-core/ent/extensionverification"
+	"github.com/miru-project/miru-core/ent/extensionverificationfailure"
+	"github.com/miru-project/miru-core/pkg/db"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+// Verification statuses. Signed scripts match a trusted key, hashed ones the
+// hash of the index and unverified ones come from an index with neither.
+// Modified is set on load when the script changed since it was verified
+const (
+	VerificationSigned      = "signed"
+	VerificationHashed      = "hashed"
+	VerificationUnverified  = "unverified"
+	VerificationRejected    = "rejected"
+	VerificationQuarantined = "quarantined"
+	VerificationModified    = "modified"
+)
+
+// Policies for scripts that fail verification, quarantined scripts are kept
+// in the quarantine directory for inspection but never loaded
+const (
+	VerifyReject     = "reject"
+	VerifyQuarantine = "quarantine"
+)
+
+// Verification is the outcome of checking a downloaded script against its repository index
+type Verification struct {
+	Status string    `json:"status"`
+	Repo   string    `json:"repo"`
+	Hash   string    `json:"hash"`
+	Key    string    `json:"key,omitempty"`
+	Reason string    `json:"reason,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// VerificationError is returned when a downloaded script does not match its
+// index entry or the keys trusted by the repository
+type VerificationError struct {
+	Pkg    string
+	Repo   string
+	Reason string
+	// Quarantine is where the script was kept, empty when it was rejected
+	Quarantine string
+}
+
+func (e *VerificationError) Error() string {
+	msg := fmt.Sprintf("extension %s from %s failed verification: %s", e.Pkg, e.Repo, e.Reason)
+	if e.Quarantine != "" {
+		msg += ", quarantined to " + e.Quarantine
+	}
+	return msg
+}
+
+func scriptHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func decodeKey(key string) (ed25519.PublicKey, error) {
+	raw, e := base64.StdEncoding.DecodeString(key)
+	if e != nil || len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%q is not a base64 ed25519 public key", key)
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// SetRepoTrustedKeys sets the keys trusted to sign the extensions of a
+// repository. Once a repository has keys its extensions must be signed
+func SetRepoTrustedKeys(repoUrl string, keys []string) error {
+	for _, key := range keys {
+		if _, e := decodeKey(key); e != nil {
+			return e
+		}
+	}
+	return db.SetRepositoryTrustedKeys(repoUrl, keys)
+}
+
+// verifyScript checks content against the `sha256:<hex>` hash and the base64
+// ed25519 signature of its index entry. The signature is required when the
+// repository trusts keys and ignored otherwise
+func verifyScript(entry *GithubExtension, keys []string, content []byte) (*Verification, error) {
+	v := &Verification{Status: VerificationUnverified, Hash: scriptHash(content), Time: time.Now()}
+	if entry.Hash != "" {
+		algo, want, ok := strings.Cut(entry.Hash, ":")
+		if !ok || algo != "sha256" {
+			return v, fmt.Errorf("unsupported hash %q, expected sha256:<hex>", entry.Hash)
+		}
+		if !strings.EqualFold(want, v.Hash) {
+			return v, fmt.Errorf("hash mismatch, the index has %s but the script is sha256:%s", entry.Hash, v.Hash)
+		}
+		v.Status = VerificationHashed
+	}
+	if len(keys) == 0 {
+		return v, nil
+	}
+
+	if entry.Signature == "" {
+		return v, fmt.Errorf("the repository trusts signing keys but the script is not signed")
+	}
+	sig, e := base64.StdEncoding.DecodeString(entry.Signature)
+	if e != nil {
+		return v, fmt.Errorf("the signature is not base64: %v", e)
+	}
+	for _, key := range keys {
+		pub, e := decodeKey(key)
+		if e != nil {
+			log.Println("Skipping trusted key:", e)
+			continue
+		}
+		if ed25519.Verify(pub, content, sig) {
+			v.Status = VerificationSigned
+			v.Key = key
+			return v, nil
+		}
+	}
+	return v, fmt.Errorf("the signature does not match any key trusted by the repository")
+}
+
+func verifyPolicy() string {
+	if config.Global.ExtensionVerifyPolicy == VerifyReject {
+		return VerifyReject
+	}
+	return VerifyQuarantine
+}
+
+// verifyDownload verifies a script downloaded from repoUrl. Scripts that fail
+// are rejected or quarantined depending on the policy, and the failure is
+// recorded apart from the verification of the installed script so it shows
+// up in the extension metadata
+func verifyDownload(repoUrl string, entry *GithubExtension, content []byte) (*Verification, error) {
+	keys, e := db.GetRepositoryTrustedKeys(repoUrl)
+	if e != nil && !ent.IsNotFound(e) {
+		return nil, e
+	}
+	v, e := verifyScript(entry, keys, content)
+	v.Repo = repoUrl
+	if e == nil {
+		return v, nil
+	}
+
+	err := &VerificationError{Pkg: entry.Package, Repo: repoUrl, Reason: e.Error()}
+	v.Status = VerificationRejected
+	v.Reason = err.Reason
+	if verifyPolicy() == VerifyQuarantine {
+		loc := filepath.Join(ExtPath, "quarantine", entry.Package+".js")
+		if e := os.MkdirAll(filepath.Dir(loc), os.ModePerm); e != nil {
+			return nil, e
+		}
+		if e := os.WriteFile(loc, content, 0644); e != nil {
+			return nil, e
+		}
+		err.Quarantine = loc
+		v.Status = VerificationQuarantined
+	}
+	log.Println(err)
+	if e := db.SaveVerificationFailure(entry.Package, v.Repo, extensionverificationfailure.Status(v.Status), v.Hash, v.Reason); e != nil {
+		return nil, e
+	}
+	showVerificationFailure(entry, v)
+	return nil, err
+}
+
+// showVerificationFailure publishes a failed verification on the metadata of
+// the package, a placeholder is listed when the package is not installed
+func showVerificationFailure(entry *GithubExtension, v *Verification) {
+	if _, ok := ApiPkgCache.Map.Load(entry.Package); ok {
+		ApiPkgCache.Modify(entry.Package, func(api *ExtApi) *ExtApi {
+			api.Ext.FailedVerification = v
+			return api
+		})
+		return
+	}
+	ApiPkgCache.Store(entry.Package, &ExtApi{
+		Ext: &Ext{
+			Name:               entry.Name,
+			Version:            entry.Version,
+			Pkg:                entry.Package,
+			Website:            entry.Website,
+			Error:              v.Reason,
+			FailedVerification: v,
+		},
+		asyncCallBack: AsyncCallBack,
+	})
+}
+
+func saveVerification(pkg string, v *Verification) error {
+	return db.SaveVerification(pkg, v.Repo, extensionverification.Status(v.Status), v.Hash, v.Key, v.Reason)
+}
+
+// restoreVerification puts back a record read with db.GetVerification, nil removes it
+func restoreVerification(pkg string, rec *ent.ExtensionVerification) error {
+	if rec == nil {
+		return db.DeleteVerification(pkg)
+	}
+	return db.SaveVerification(pkg, rec.Repo, rec.Status, rec.Hash, rec.Key, rec.Reason)
+}
+
+// loadVerification reads the verification of the installed script and the
+// last download that failed it, scripts edited since they were verified are
+// reported as modified
+func (ext *Ext) loadVerification() {
+	failed, e := db.GetVerificationFailure(ext.Pkg)
+	if e != nil {
+		log.Println("Failed to read the failed verification of", ext.Pkg, ":", e)
+	} else if failed != nil {
+		ext.FailedVerification = &Verification{
+			Status: string(failed.Status),
+			Repo:   failed.Repo,
+			Hash:   failed.Hash,
+			Reason: failed.Reason,
+			Time:   failed.FailedAt,
+		}
+	}
+
+	rec, e := db.GetVerification(ext.Pkg)
+	if e != nil {
+		log.Println("Failed to read the verification of", ext.Pkg, ":", e)
+		return
+	}
+	if rec == nil {
+		return
+	}
+	v := &Verification{
+		Status: string(rec.Status),
+		Repo:   rec.Repo,
+		Hash:   rec.Hash,
+		Key:    rec.Key,
+		Reason: rec.Reason,
+		Time:   rec.VerifiedAt,
+	}
+	if ext.Context != nil && scriptHash([]byte(*ext.Context)) != v.Hash {
+		v.Status = VerificationModified
+		v.Reason = "the script changed after it was verified"
+	}
+	ext.Verification = v
+}
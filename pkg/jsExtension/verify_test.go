@@ -0,0 +1,187 @@
+package jsExtension
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miru-project/miru-core/config"
+	"github.com/miru-project/miru-core/pkg/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func testKey(t *testing.T) (string, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(pub), priv
+}
+
+func TestVerifyScript(t *testing.T) {
+	content := []byte("async function latest() { return []; }")
+	hash := "sha256:" + scriptHash(content)
+	key, priv := testKey(t)
+	otherKey, otherPriv := testKey(t)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, content))
+	forged := base64.StdEncoding.EncodeToString(ed25519.Sign(otherPriv, content))
+
+	tests := []struct {
+		name   string
+		entry  GithubExtension
+		keys   []string
+		status string
+		err    string
+	}{
+		{"no hash", GithubExtension{}, nil, VerificationUnverified, ""},
+		{"hash", GithubExtension{Hash: hash}, nil, VerificationHashed, ""},
+		{"hash mismatch", GithubExtension{Hash: "sha256:00"}, nil, "", "hash mismatch"},
+		{"unknown hash", GithubExtension{Hash: "md5:00"}, nil, "", "unsupported hash"},
+		{"signature without keys", GithubExtension{Hash: hash, Signature: forged}, nil, VerificationHashed, ""},
+		{"signed", GithubExtension{Signature: signature}, []string{otherKey, key}, VerificationSigned, ""},
+		{"unsigned", GithubExtension{Hash: hash}, []string{key}, "", "not signed"},
+		{"forged", GithubExtension{Signature: forged}, []string{key}, "", "does not match any key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := verifyScript(&tt.entry, tt.keys, content)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, v.Status)
+		})
+	}
+}
+
+func TestDownloadVerifiesScripts(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved := ExtPath
+	ExtPath = t.TempDir()
+	t.Cleanup(func() {
+		ExtPath = saved
+	})
+	repo := "http://signed.test/index.json"
+	saveTestRepo(t, repo, "signed test")
+	key, priv := testKey(t)
+	assert.ErrorContains(t, SetRepoTrustedKeys(repo, []string{"bm90IGEga2V5"}), "not a base64 ed25519 public key")
+	assert.NoError(t, SetRepoTrustedKeys(repo, []string{key}))
+
+	good := testScript("test.verify.good", "1.0.0")
+	tampered := testScript("test.verify.tampered", "1.0.0")
+	sign := func(script string) string {
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(script)))
+	}
+	serveTestRepo(t, map[string]string{
+		"/index.json": `[
+  {"name": "Good", "package": "test.verify.good", "version": "1.0.0", "signature": "` + sign(good) + `"},
+  {"name": "Tampered", "package": "test.verify.tampered", "version": "1.0.0", "signature": "` + sign(tampered+"//") + `"}
+]`,
+		"/repo/test.verify.good.js":     good,
+		"/repo/test.verify.tampered.js": tampered,
+	})
+
+	assert.NoError(t, DownloadExtension(repo, "test.verify.good"))
+	api, err := LoadFile(filepath.Join(ExtPath, "test.verify.good.js"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, api.Ext.Verification) {
+		assert.Equal(t, VerificationSigned, api.Ext.Verification.Status)
+		assert.Equal(t, key, api.Ext.Verification.Key)
+	}
+
+	// Edits after the download are reported
+	loc := filepath.Join(ExtPath, "test.verify.good.js")
+	assert.NoError(t, os.WriteFile(loc, []byte(good+"\n// edited"), 0644))
+	api, err = LoadFile(loc)
+	assert.NoError(t, err)
+	assert.Equal(t, VerificationModified, api.Ext.Verification.Status)
+
+	err = DownloadExtension(repo, "test.verify.tampered")
+	var verifyErr *VerificationError
+	if assert.ErrorAs(t, err, &verifyErr) {
+		assert.FileExists(t, verifyErr.Quarantine)
+	}
+	assert.NoFileExists(t, filepath.Join(ExtPath, "test.verify.tampered.js"))
+	placeholder := ApiPkgCache.Load("test.verify.tampered")
+	assert.Nil(t, placeholder.Ext.Verification)
+	assert.Equal(t, VerificationQuarantined, placeholder.Ext.FailedVerification.Status)
+	assert.Contains(t, placeholder.Ext.Error, "does not match any key")
+	_, err = Invoke(t.Context(), "test.verify.tampered", "latest", 1)
+	assert.ErrorContains(t, err, "not loaded")
+
+	savedPolicy := config.Global.ExtensionVerifyPolicy
+	config.Global.ExtensionVerifyPolicy = VerifyReject
+	t.Cleanup(func() {
+		config.Global.ExtensionVerifyPolicy = savedPolicy
+	})
+	assert.NoError(t, os.RemoveAll(filepath.Dir(verifyErr.Quarantine)))
+	err = DownloadExtension(repo, "test.verify.tampered")
+	if assert.ErrorAs(t, err, &verifyErr) {
+		assert.Empty(t, verifyErr.Quarantine)
+	}
+	assert.NoDirExists(t, filepath.Join(ExtPath, "quarantine"))
+	assert.Equal(t, VerificationRejected, ApiPkgCache.Load("test.verify.tampered").Ext.FailedVerification.Status)
+}
+
+func TestFailedDownloadKeepsInstalledVerification(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved := ExtPath
+	ExtPath = t.TempDir()
+	t.Cleanup(func() {
+		ExtPath = saved
+	})
+	repo := "http://signed-upgrade.test/index.json"
+	saveTestRepo(t, repo, "signed upgrade test")
+	key, priv := testKey(t)
+	assert.NoError(t, SetRepoTrustedKeys(repo, []string{key}))
+	sign := func(script string) string {
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(script)))
+	}
+	pkg := "test.verify.upgrade"
+	v1, v2 := testScript(pkg, "1.0.0"), testScript(pkg, "2.0.0")
+	index := func(version string, signature string) string {
+		return `[{"name": "Upgrade", "package": "` + pkg + `", "version": "` + version + `", "signature": "` + signature + `"}]`
+	}
+	files := map[string]string{"/index.json": index("1.0.0", sign(v1)), "/repo/" + pkg + ".js": v1}
+	serveTestRepo(t, files)
+	t.Cleanup(func() {
+		RemoveExtension(pkg)
+	})
+	assert.NoError(t, DownloadExtension(repo, pkg))
+
+	// A newer script failing verification leaves the installed one verified
+	files["/index.json"] = index("2.0.0", sign(v1))
+	files["/repo/"+pkg+".js"] = v2
+	fetchedExtensionRepo = nil
+	var verifyErr *VerificationError
+	assert.ErrorAs(t, DownloadExtension(repo, pkg), &verifyErr)
+	api, err := LoadFile(filepath.Join(ExtPath, pkg+".js"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", api.Ext.Version)
+	if assert.NotNil(t, api.Ext.Verification) {
+		assert.Equal(t, VerificationSigned, api.Ext.Verification.Status)
+	}
+	if assert.NotNil(t, api.Ext.FailedVerification) {
+		assert.Equal(t, VerificationQuarantined, api.Ext.FailedVerification.Status)
+		assert.Equal(t, scriptHash([]byte(v2)), api.Ext.FailedVerification.Hash)
+	}
+
+	// Installing a script clears the failure
+	files["/index.json"] = index("2.0.0", sign(v2))
+	fetchedExtensionRepo = nil
+	assert.NoError(t, DownloadExtension(repo, pkg))
+	api = ApiPkgCache.Load(pkg)
+	assert.Equal(t, "2.0.0", api.Ext.Version)
+	assert.Equal(t, VerificationSigned, api.Ext.Verification.Status)
+	assert.Nil(t, api.Ext.FailedVerification)
+	failed, err := db.GetVerificationFailure(pkg)
+	assert.NoError(t, err)
+	assert.Nil(t, failed)
+}
@@ -0,0 +1,143 @@
+package jsExtension
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	log "github.com/miru-project/miru-core/pkg/logger"
+)
+
+// reloadDebounce is how long a script has to stay untouched before it is
+// reloaded, editors write a file in several steps
+var reloadDebounce = 300 * time.Millisecond
+
+// dirWatcher reloads the scripts of a directory when they change
+type dirWatcher struct {
+	dir     string
+	watcher *fsnotify.Watcher
+	mu      sync.Mutex
+	pending map[string]*time.Timer
+	closed  bool
+}
+
+// Watch the extension directory for changes
+func WatchDir(dir string) {
+	if _, err := watchDir(dir); err != nil {
+		log.Fatal("Failed to watch directory: ", err)
+	}
+	log.Println("Watching directory:", dir)
+}
+
+func watchDir(dir string) (*dirWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	w := &dirWatcher{dir: dir, watcher: watcher, pending: map[string]*time.Timer{}}
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	go w.run()
+	return w, nil
+}
+
+func (w *dirWatcher) run() {
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			// Staging, backups and editor swap files are not extensions
+			if filepath.Ext(event.Name) != ".js" || filepath.Dir(event.Name) != filepath.Clean(w.dir) {
+				continue
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			w.schedule(event.Name)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Println("Error:", err)
+		}
+	}
+}
+
+// schedule (re)starts the reload timer of a file, so a burst of events
+// results in a single reload once the file settled
+func (w *dirWatcher) schedule(loc string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+	if timer, ok := w.pending[loc]; ok {
+		timer.Reset(reloadDebounce)
+		return
+	}
+	w.pending[loc] = time.AfterFunc(reloadDebounce, func() {
+		w.mu.Lock()
+		delete(w.pending, loc)
+		closed := w.closed
+		w.mu.Unlock()
+		if !closed {
+			reloadFile(loc)
+		}
+	})
+}
+
+func (w *dirWatcher) close() error {
+	w.mu.Lock()
+	w.closed = true
+	for loc, timer := range w.pending {
+		timer.Stop()
+		delete(w.pending, loc)
+	}
+	w.mu.Unlock()
+	return w.watcher.Close()
+}
+
+// reloadFile brings the loaded extension in line with the file at loc. Files
+// renamed away by an atomic save are back by the time it runs, so only
+// extensions whose file is still missing are removed
+func reloadFile(loc string) {
+	pkg := strings.TrimSuffix(filepath.Base(loc), ".js")
+	upgradeLock.Lock()
+	defer upgradeLock.Unlock()
+	// Installs load the extension themselves
+	if isInstalling(pkg) {
+		return
+	}
+
+	if _, e := os.Stat(loc); errors.Is(e, os.ErrNotExist) {
+		log.Println("Removed file:", loc)
+		if pool, ok := extMemMap.LoadAndDelete(pkg); ok {
+			pool.(*runtimePool).close()
+		}
+		ApiPkgCache.Remove(pkg)
+		return
+	}
+
+	log.Println("Modified file:", loc)
+	ext := &Ext{Name: filepath.Base(loc)}
+	if e := ext.filterExt(loc); e != nil {
+		log.Println("File is not a valid extension:", loc)
+		// The previous version is gone, keep it listed with the reason
+		if pool, ok := extMemMap.LoadAndDelete(pkg); ok {
+			pool.(*runtimePool).close()
+		}
+		ApiPkgCache.Store(pkg, &ExtApi{Ext: &Ext{Name: ext.Name, Pkg: pkg, Error: e.Error()}, asyncCallBack: AsyncCallBack})
+		return
+	}
+	if _, e := reload(pkg, loc); e != nil {
+		log.Println("Failed to reload extension", pkg, ":", e)
+	}
+}
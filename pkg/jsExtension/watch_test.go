@@ -0,0 +1,76 @@
+package jsExtension
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// loadedVersion is the version of pkg that has runtimes to serve calls
+func loadedVersion(pkg string) string {
+	pool, ok := extMemMap.Load(pkg)
+	if !ok {
+		return ""
+	}
+	return pool.(*runtimePool).api.Ext.Version
+}
+
+func TestWatchDirReloadsSettledFiles(t *testing.T) {
+	useTestDatabase(t)
+	compileTestRuntimes(t)
+	saved, savedDebounce := ExtPath, reloadDebounce
+	ExtPath = t.TempDir()
+	reloadDebounce = 50 * time.Millisecond
+	t.Cleanup(func() {
+		ExtPath, reloadDebounce = saved, savedDebounce
+	})
+	w, err := watchDir(ExtPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.close()
+
+	pkg := "test.watch.reload"
+	loc := filepath.Join(ExtPath, pkg+".js")
+	script := testScript(pkg, "1.0.0")
+	// A slow write in pieces is only loaded once it is complete
+	assert.NoError(t, os.WriteFile(loc, []byte(script[:len(script)/2]), 0644))
+	time.Sleep(reloadDebounce / 2)
+	assert.NoError(t, os.WriteFile(loc, []byte(script), 0644))
+	assert.Eventually(t, func() bool { return loadedVersion(pkg) == "1.0.0" }, 2*time.Second, 10*time.Millisecond)
+	assert.Empty(t, ApiPkgCache.Load(pkg).Ext.Error)
+	old, _ := extMemMap.Load(pkg)
+
+	// Atomic saves rename the file away and put a new one in its place
+	assert.NoError(t, os.Rename(loc, loc+"~"))
+	assert.NoError(t, os.WriteFile(loc+".tmp", []byte(testScript(pkg, "1.1.0")), 0644))
+	assert.NoError(t, os.Rename(loc+".tmp", loc))
+	assert.Eventually(t, func() bool { return loadedVersion(pkg) == "1.1.0" }, 2*time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		pool := old.(*runtimePool)
+		pool.lock.Lock()
+		defer pool.lock.Unlock()
+		return pool.closed
+	}, 2*time.Second, 10*time.Millisecond)
+	_, err = Invoke(t.Context(), pkg, "latest", 1)
+	assert.NoError(t, err)
+
+	// Broken files replace the running version with their error
+	assert.NoError(t, os.WriteFile(loc, []byte(testScript(pkg, "1.2.0")+"}"), 0644))
+	assert.Eventually(t, func() bool { return ApiPkgCache.Load(pkg).Ext.Error != "" }, 2*time.Second, 10*time.Millisecond)
+	_, ok := extMemMap.Load(pkg)
+	assert.False(t, ok)
+
+	assert.NoError(t, os.WriteFile(loc, []byte(testScript(pkg, "1.3.0")), 0644))
+	assert.Eventually(t, func() bool { return loadedVersion(pkg) == "1.3.0" }, 2*time.Second, 10*time.Millisecond)
+	assert.Empty(t, ApiPkgCache.Load(pkg).Ext.Error)
+
+	assert.NoError(t, os.Remove(loc))
+	assert.Eventually(t, func() bool {
+		_, ok := ApiPkgCache.Map.Load(pkg)
+		return !ok
+	}, 2*time.Second, 10*time.Millisecond)
+}
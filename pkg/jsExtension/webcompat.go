@@ -0,0 +1,406 @@
+package jsExtension
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/dop251/goja"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// The web-compat layer gives the runtimes the globals extensions written for
+// browsers or the old Flutter runtime expect: btoa and atob, TextEncoder and
+// TextDecoder, timers returning numeric ids, structuredClone and
+// queueMicrotask. It is installed before the base runtime so both the base
+// runtimes and the top level code of extensions can use it
+
+// newDOMException builds an error with the name of a DOMException, scripts
+// check e.name like they would in a browser
+func newDOMException(vm *goja.Runtime, name string, message string) *goja.Object {
+	e, _ := vm.New(vm.Get("Error"), vm.ToValue(message))
+	e.Set("name", name)
+	return e
+}
+
+// enableWebCompat installs the web-compat globals, exceptions of callbacks
+// run by the loop are written to the log of pkg
+func enableWebCompat(vm *goja.Runtime, pkg string) {
+	vm.Set("btoa", func(data string) string {
+		encoded, e := btoa(data)
+		if e != nil {
+			panic(newDOMException(vm, "InvalidCharacterError", e.Error()))
+		}
+		return encoded
+	})
+	vm.Set("atob", func(data string) string {
+		decoded, e := atob(data)
+		if e != nil {
+			panic(newDOMException(vm, "InvalidCharacterError", e.Error()))
+		}
+		return decoded
+	})
+	vm.Set("TextEncoder", textEncoderCtor(vm))
+	vm.Set("TextDecoder", textDecoderCtor(vm))
+	vm.Set("structuredClone", func(v goja.Value) goja.Value {
+		return structuredClone(vm, v, map[*goja.Object]goja.Value{})
+	})
+	vm.Set("queueMicrotask", func(call goja.FunctionCall) goja.Value {
+		fn, ok := goja.AssertFunction(call.Argument(0))
+		if !ok {
+			panic(vm.NewTypeError("the callback of queueMicrotask must be a function"))
+		}
+		promise, resolve, _ := vm.NewPromise()
+		resolve(goja.Undefined())
+		then, _ := goja.AssertFunction(vm.ToValue(promise).ToObject(vm).Get("then"))
+		then(vm.ToValue(promise), vm.ToValue(func() {
+			if _, e := fn(goja.Undefined()); e != nil {
+				writeLog(pkg, LogLevelException, e.Error())
+			}
+		}))
+		return goja.Undefined()
+	})
+	enableTimers(vm, pkg)
+}
+
+// btoa encodes a binary string, every character must fit in a byte
+func btoa(data string) (string, error) {
+	raw := make([]byte, 0, len(data))
+	for _, r := range data {
+		if r > 0xff {
+			return "", errors.New("the string to be encoded contains characters outside of the Latin1 range")
+		}
+		raw = append(raw, byte(r))
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// atob decodes base64 into a binary string the way browsers do, ignoring
+// whitespace and accepting missing padding
+func atob(data string) (string, error) {
+	data = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\n', '\f', '\r':
+			return -1
+		}
+		return r
+	}, data)
+	if len(data)%4 == 0 {
+		data = strings.TrimSuffix(strings.TrimSuffix(data, "="), "=")
+	}
+	invalid := errors.New("the string to be decoded is not correctly encoded")
+	if len(data)%4 == 1 || strings.ContainsRune(data, '=') {
+		return "", invalid
+	}
+	raw, e := base64.RawStdEncoding.DecodeString(data)
+	if e != nil {
+		return "", invalid
+	}
+	var sb strings.Builder
+	for _, b := range raw {
+		sb.WriteRune(rune(b))
+	}
+	return sb.String(), nil
+}
+
+// viewBytes returns the bytes under an ArrayBuffer or a view on one, writes
+// to them are seen by the script
+func viewBytes(vm *goja.Runtime, v goja.Value) ([]byte, bool) {
+	obj, ok := v.(*goja.Object)
+	if !ok {
+		return nil, false
+	}
+	if ab, ok := obj.Export().(goja.ArrayBuffer); ok {
+		return ab.Bytes(), true
+	}
+	isView, _ := goja.AssertFunction(vm.Get("ArrayBuffer").ToObject(vm).Get("isView"))
+	if view, _ := isView(nil, v); view == nil || !view.ToBoolean() {
+		return nil, false
+	}
+	ab, ok := obj.Get("buffer").Export().(goja.ArrayBuffer)
+	if !ok {
+		return nil, false
+	}
+	offset := obj.Get("byteOffset").ToInteger()
+	return ab.Bytes()[offset : offset+obj.Get("byteLength").ToInteger()], true
+}
+
+func newUint8Array(vm *goja.Runtime, data []byte) goja.Value {
+	arr, e := vm.New(vm.Get("Uint8Array"), vm.ToValue(vm.NewArrayBuffer(data)))
+	if e != nil {
+		panic(e)
+	}
+	return arr
+}
+
+func textEncoderCtor(vm *goja.Runtime) func(goja.ConstructorCall) *goja.Object {
+	return func(call goja.ConstructorCall) *goja.Object {
+		self := call.This
+		self.Set("encoding", "utf-8")
+		self.Set("encode", func(input goja.Value) goja.Value {
+			if goja.IsUndefined(input) {
+				return newUint8Array(vm, []byte{})
+			}
+			return newUint8Array(vm, []byte(input.String()))
+		})
+		// encodeInto writes the characters that fit in dest, read counts
+		// UTF-16 code units like the length of js strings
+		self.Set("encodeInto", func(input string, dest goja.Value) map[string]int {
+			out, ok := viewBytes(vm, dest)
+			if !ok {
+				panic(vm.NewTypeError("the destination of encodeInto must be a Uint8Array"))
+			}
+			read, written := 0, 0
+			for _, r := range input {
+				n := utf8.RuneLen(r)
+				if n < 0 {
+					r, n = utf8.RuneError, 3
+				}
+				if written+n > len(out) {
+					break
+				}
+				utf8.EncodeRune(out[written:], r)
+				written += n
+				read += len(utf16.Encode([]rune{r}))
+			}
+			return map[string]int{"read": read, "written": written}
+		})
+		return self
+	}
+}
+
+func textDecoderCtor(vm *goja.Runtime) func(goja.ConstructorCall) *goja.Object {
+	return func(call goja.ConstructorCall) *goja.Object {
+		label := "utf-8"
+		if v := call.Argument(0); !goja.IsUndefined(v) {
+			label = strings.TrimSpace(v.String())
+		}
+		enc, e := htmlindex.Get(label)
+		if e != nil {
+			rangeErr, _ := vm.New(vm.Get("RangeError"), vm.ToValue("the encoding label "+label+" is not supported"))
+			panic(rangeErr)
+		}
+		name, _ := htmlindex.Name(enc)
+		var fatal, ignoreBOM bool
+		if opts, ok := call.Argument(1).(*goja.Object); ok {
+			fatal = opts.Get("fatal") != nil && opts.Get("fatal").ToBoolean()
+			ignoreBOM = opts.Get("ignoreBOM") != nil && opts.Get("ignoreBOM").ToBoolean()
+		}
+
+		self := call.This
+		self.Set("encoding", name)
+		self.Set("fatal", fatal)
+		self.Set("ignoreBOM", ignoreBOM)
+		self.Set("decode", func(input goja.Value) string {
+			if goja.IsUndefined(input) {
+				return ""
+			}
+			data, ok := viewBytes(vm, input)
+			if !ok {
+				panic(vm.NewTypeError("the input of decode must be an ArrayBuffer or a view on one"))
+			}
+			if name != "utf-8" {
+				out, e := enc.NewDecoder().Bytes(data)
+				if e != nil {
+					panic(vm.NewTypeError("the data is not valid %s", name))
+				}
+				return string(out)
+			}
+			if !ignoreBOM {
+				data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+			}
+			if !utf8.Valid(data) {
+				if fatal {
+					panic(vm.NewTypeError("the data is not valid utf-8"))
+				}
+				return strings.ToValidUTF8(string(data), "�")
+			}
+			return string(data)
+		})
+		return self
+	}
+}
+
+// structuredClone copies v deeply like the structured clone algorithm:
+// plain objects and arrays, Date, RegExp, Map, Set, ArrayBuffer and its views
+// and errors are copied keeping shared and circular references, functions
+// and symbols throw a DataCloneError
+func structuredClone(vm *goja.Runtime, v goja.Value, seen map[*goja.Object]goja.Value) goja.Value {
+	if _, ok := v.(*goja.Symbol); ok {
+		panic(newDOMException(vm, "DataCloneError", "a symbol could not be cloned"))
+	}
+	obj, ok := v.(*goja.Object)
+	if !ok {
+		return v
+	}
+	if clone, ok := seen[obj]; ok {
+		return clone
+	}
+	if _, ok := goja.AssertFunction(obj); ok {
+		panic(newDOMException(vm, "DataCloneError", "a function could not be cloned"))
+	}
+	construct := func(ctor string, args ...goja.Value) *goja.Object {
+		clone, e := vm.New(vm.Get(ctor), args...)
+		if e != nil {
+			panic(e)
+		}
+		return clone
+	}
+	forEach := func(fn func(call goja.FunctionCall) goja.Value) {
+		each, _ := goja.AssertFunction(obj.Get("forEach"))
+		if _, e := each(obj, vm.ToValue(fn)); e != nil {
+			panic(e)
+		}
+	}
+
+	if ab, ok := obj.Export().(goja.ArrayBuffer); ok {
+		clone := vm.ToValue(vm.NewArrayBuffer(slices.Clone(ab.Bytes())))
+		seen[obj] = clone
+		return clone
+	}
+	if _, ok := viewBytes(vm, obj); ok {
+		ctor := obj.Get("constructor")
+		buffer := structuredClone(vm, obj.Get("buffer"), seen)
+		length := obj.Get("length")
+		if length == nil || goja.IsUndefined(length) {
+			length = obj.Get("byteLength")
+		}
+		clone, e := vm.New(ctor, buffer, obj.Get("byteOffset"), length)
+		if e != nil {
+			panic(e)
+		}
+		seen[obj] = clone
+		return clone
+	}
+
+	class := obj.ClassName()
+	for _, ctor := range []string{"Map", "Set"} {
+		if vm.InstanceOf(obj, vm.Get(ctor).ToObject(vm)) {
+			class = ctor
+		}
+	}
+	var clone *goja.Object
+	switch class {
+	case "Date":
+		clone = construct("Date", obj)
+	case "RegExp":
+		clone = construct("RegExp", obj.Get("source"), obj.Get("flags"))
+	case "Boolean", "Number", "String":
+		valueOf, _ := goja.AssertFunction(obj.Get("valueOf"))
+		primitive, _ := valueOf(obj)
+		clone = construct("Object", primitive)
+	case "Error":
+		clone = construct("Error", obj.Get("message"))
+		clone.Set("name", obj.Get("name"))
+		seen[obj] = clone
+		if stack := obj.Get("stack"); stack != nil {
+			clone.Set("stack", stack)
+		}
+		return clone
+	case "Map":
+		clone = construct("Map")
+		seen[obj] = clone
+		set, _ := goja.AssertFunction(clone.Get("set"))
+		forEach(func(call goja.FunctionCall) goja.Value {
+			set(clone, structuredClone(vm, call.Argument(1), seen), structuredClone(vm, call.Argument(0), seen))
+			return goja.Undefined()
+		})
+		return clone
+	case "Set":
+		clone = construct("Set")
+		seen[obj] = clone
+		add, _ := goja.AssertFunction(clone.Get("add"))
+		forEach(func(call goja.FunctionCall) goja.Value {
+			add(clone, structuredClone(vm, call.Argument(0), seen))
+			return goja.Undefined()
+		})
+		return clone
+	case "Array":
+		clone = vm.NewArray()
+	case "Object":
+		clone = vm.NewObject()
+	default:
+		panic(newDOMException(vm, "DataCloneError", class+" could not be cloned"))
+	}
+	seen[obj] = clone
+	for _, key := range obj.Keys() {
+		clone.Set(key, structuredClone(vm, obj.Get(key), seen))
+	}
+	return clone
+}
+
+// timer is a timeout or an interval scheduled on the loop
+type timer struct {
+	handle   goja.Value
+	interval bool
+}
+
+// enableTimers wraps the timers of the event loop so they return numeric ids
+// and call their callbacks with the global object as this like browsers do.
+// The exceptions of the callbacks are written to the log, the loop drops them
+func enableTimers(vm *goja.Runtime, pkg string) {
+	schedule := map[bool]goja.Callable{}
+	clear := map[bool]goja.Callable{}
+	for interval, name := range map[bool]string{false: "Timeout", true: "Interval"} {
+		set, ok := goja.AssertFunction(vm.Get("set" + name))
+		if !ok {
+			return
+		}
+		schedule[interval] = set
+		clear[interval], _ = goja.AssertFunction(vm.Get("clear" + name))
+	}
+
+	timers := map[int64]*timer{}
+	var next int64
+	set := func(interval bool) func(call goja.FunctionCall) goja.Value {
+		return func(call goja.FunctionCall) goja.Value {
+			fn, ok := goja.AssertFunction(call.Argument(0))
+			if !ok {
+				panic(vm.NewTypeError("the callback of a timer must be a function"))
+			}
+			delay := max(call.Argument(1).ToInteger(), 0)
+			var args []goja.Value
+			if len(call.Arguments) > 2 {
+				args = slices.Clone(call.Arguments[2:])
+			}
+			next++
+			id := next
+			callback := func() {
+				if !interval {
+					delete(timers, id)
+				}
+				if _, e := fn(vm.GlobalObject(), args...); e != nil {
+					writeLog(pkg, LogLevelException, e.Error())
+				}
+			}
+			handle, e := schedule[interval](nil, vm.ToValue(callback), vm.ToValue(delay))
+			if e != nil {
+				panic(e)
+			}
+			timers[id] = &timer{handle: handle, interval: interval}
+			return vm.ToValue(id)
+		}
+	}
+	// clearTimeout and clearInterval share the ids like in browsers
+	cancel := func(id goja.Value) {
+		if id == nil || goja.IsUndefined(id) || goja.IsNull(id) {
+			return
+		}
+		t, ok := timers[id.ToInteger()]
+		if !ok {
+			return
+		}
+		delete(timers, id.ToInteger())
+		if _, e := clear[t.interval](nil, t.handle); e != nil {
+			panic(e)
+		}
+	}
+	vm.Set("setTimeout", set(false))
+	vm.Set("setInterval", set(true))
+	vm.Set("clearTimeout", cancel)
+	vm.Set("clearInterval", cancel)
+}
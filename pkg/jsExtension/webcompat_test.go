@@ -0,0 +1,172 @@
+package jsExtension
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase64(t *testing.T) {
+	tests := []struct {
+		decoded string
+		encoded string
+	}{
+		{"", ""},
+		{"f", "Zg=="},
+		{"fo", "Zm8="},
+		{"foo", "Zm9v"},
+		{"é\x00ÿ", "6QD/"},
+	}
+	for _, tt := range tests {
+		encoded, err := btoa(tt.decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.encoded, encoded)
+		decoded, err := atob(tt.encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.decoded, decoded)
+	}
+
+	// Decoding is forgiving about whitespace and padding like browsers
+	for _, input := range []string{"Zm8", " Zm\n8= ", "Zm8="} {
+		decoded, err := atob(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, "fo", decoded, input)
+	}
+	for _, input := range []string{"Z", "Zm8==", "Zm=8", "Zm8*"} {
+		_, err := atob(input)
+		assert.Error(t, err, input)
+	}
+	_, err := btoa("€")
+	assert.ErrorContains(t, err, "Latin1")
+}
+
+// runWebCompat runs script in a runtime with the web-compat layer until the
+// loop is idle and returns the JSON the script passed to done
+func runWebCompat(t *testing.T, script string) map[string]any {
+	t.Helper()
+	loop := eventloop.NewEventLoop()
+	var out string
+	loop.Run(func(vm *goja.Runtime) {
+		enableWebCompat(vm, "test.webcompat")
+		vm.Set("done", func(s string) {
+			out = s
+		})
+		if _, err := vm.RunString(script); err != nil {
+			t.Fatal(err)
+		}
+	})
+	res := map[string]any{}
+	if !assert.NoError(t, json.Unmarshal([]byte(out), &res), out) {
+		t.FailNow()
+	}
+	return res
+}
+
+func TestWebCompatGlobals(t *testing.T) {
+	out := runWebCompat(t, `
+const thrown = (fn) => { try { fn(); return ""; } catch (e) { return e.name; } };
+const bytes = new TextEncoder().encode("aé😀");
+const into = new Uint8Array(4);
+const written = new TextEncoder().encodeInto("aé😀", into);
+const original = { n: 1, date: new Date(0), re: /a+/gi, map: new Map([["k", { v: 1 }]]), set: new Set([1, 2]), bytes: new Uint8Array([1, 2, 3]).subarray(1), list: [1, { x: 2 }] };
+original.self = original;
+const clone = structuredClone(original);
+done(JSON.stringify({
+  btoa: btoa("é"),
+  atob: atob("6Q"),
+  btoaError: thrown(() => btoa("€")),
+  atobError: thrown(() => atob("*")),
+  encoded: Array.from(bytes),
+  into: written,
+  decoded: new TextDecoder().decode(bytes),
+  bom: new TextDecoder().decode(new Uint8Array([0xef, 0xbb, 0xbf, 0x61])),
+  replaced: new TextDecoder().decode(new Uint8Array([0x61, 0xff])),
+  fatal: thrown(() => new TextDecoder("utf-8", { fatal: true }).decode(new Uint8Array([0xff]))),
+  latin1: new TextDecoder("latin1").decode(new Uint8Array([0xe9])),
+  encoding: new TextDecoder("latin1").encoding,
+  unknownLabel: thrown(() => new TextDecoder("nope")),
+  cloneCircular: clone.self === clone,
+  cloneDistinct: clone !== original && clone.list[1] !== original.list[1],
+  cloneDate: clone.date instanceof Date && clone.date.getTime() === 0,
+  cloneRegExp: clone.re.source + "/" + clone.re.flags,
+  cloneMap: clone.map.get("k").v,
+  cloneSet: Array.from(clone.set),
+  cloneBytes: Array.from(clone.bytes),
+  cloneFunction: thrown(() => structuredClone({ f() {} })),
+}));`)
+
+	assert.Equal(t, "6Q==", out["btoa"])
+	assert.Equal(t, "é", out["atob"])
+	assert.Equal(t, "InvalidCharacterError", out["btoaError"])
+	assert.Equal(t, "InvalidCharacterError", out["atobError"])
+	assert.Equal(t, []any{97.0, 195.0, 169.0, 240.0, 159.0, 152.0, 128.0}, out["encoded"])
+	assert.Equal(t, map[string]any{"read": 2.0, "written": 3.0}, out["into"])
+	assert.Equal(t, "aé😀", out["decoded"])
+	assert.Equal(t, "a", out["bom"])
+	assert.Equal(t, "a�", out["replaced"])
+	assert.Equal(t, "TypeError", out["fatal"])
+	assert.Equal(t, "é", out["latin1"])
+	assert.Equal(t, "windows-1252", out["encoding"])
+	assert.Equal(t, "RangeError", out["unknownLabel"])
+	assert.Equal(t, true, out["cloneCircular"])
+	assert.Equal(t, true, out["cloneDistinct"])
+	assert.Equal(t, true, out["cloneDate"])
+	assert.Equal(t, "a+/gi", out["cloneRegExp"])
+	assert.Equal(t, 1.0, out["cloneMap"])
+	assert.Equal(t, []any{1.0, 2.0}, out["cloneSet"])
+	assert.Equal(t, []any{2.0, 3.0}, out["cloneBytes"])
+	assert.Equal(t, "DataCloneError", out["cloneFunction"])
+}
+
+func TestWebCompatTimers(t *testing.T) {
+	out := runWebCompat(t, `
+const order = [];
+const cleared = setTimeout(() => order.push("cleared"), 0);
+clearTimeout(cleared);
+let ticks = 0;
+const interval = setInterval(() => {
+  if (++ticks === 3) clearInterval(interval);
+}, 1);
+setTimeout(function (a, b) { order.push("timeout " + a + b + " " + (this === globalThis)); }, 5, 1, 2);
+queueMicrotask(() => order.push("microtask"));
+Promise.resolve().then(() => order.push("promise"));
+order.push("sync");
+setTimeout(() => done(JSON.stringify({ order, ticks, id: typeof cleared })), 20);`)
+
+	assert.Equal(t, []any{"sync", "microtask", "promise", "timeout 12 true"}, out["order"])
+	assert.Equal(t, 3.0, out["ticks"])
+	assert.Equal(t, "number", out["id"])
+}
+
+func TestWebCompatInRuntimes(t *testing.T) {
+	loadTestExtensionV1(t, "test.webcompat.v1", "https://example.com", `
+const encoded = btoa("top level");
+class Test extends Extension {
+  async latest(page) {
+    await new Promise((resolve) => setTimeout(resolve, 5));
+    const text = new TextDecoder().decode(new TextEncoder().encode(atob(encoded)));
+    return [{ title: text, url: "/" + page }];
+  }
+}`)
+	res, err := Latest[map[string]any](context.Background(), "test.webcompat.v1", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "top level", (*res[0])["title"])
+	}
+
+	loadTestExtension(t, "test.webcompat.v2", `
+async function latest(page) {
+  await new Promise((resolve) => queueMicrotask(resolve));
+  const item = structuredClone({ title: btoa("v2"), url: "/" });
+  return [item];
+}`)
+	res, err = Latest[map[string]any](context.Background(), "test.webcompat.v2", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "djI=", (*res[0])["title"])
+	}
+}
@@ -0,0 +1,135 @@
+package jsExtension
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/antchfx/xpath"
+	"github.com/dop251/goja"
+	"golang.org/x/net/html"
+)
+
+// xpathMatch is a node or an attribute selected by an XPath expression
+type xpathMatch struct {
+	node *html.Node
+	// value of attribute matches and of expressions returning a string,
+	// number or boolean
+	value  string
+	isAttr bool
+}
+
+func (m xpathMatch) text() string {
+	if m.node == nil {
+		return m.value
+	}
+	return htmlquery.InnerText(m.node)
+}
+
+func (m xpathMatch) html(self bool) string {
+	if m.node == nil {
+		return m.value
+	}
+	return htmlquery.OutputHTML(m.node, self)
+}
+
+// xpathEvaluator runs the XPath queries of one runtime. The XPathNode getters
+// query the same content over and over, so the last parsed document is kept
+type xpathEvaluator struct {
+	content string
+	doc     *html.Node
+}
+
+func (x *xpathEvaluator) document(content string) (*html.Node, error) {
+	if x.doc != nil && x.content == content {
+		return x.doc, nil
+	}
+	doc, e := htmlquery.Parse(strings.NewReader(content))
+	if e != nil {
+		return nil, fmt.Errorf("failed to parse html: %v", e)
+	}
+	x.content, x.doc = content, doc
+	return doc, nil
+}
+
+// matches evaluates selector on the html content
+func (x *xpathEvaluator) matches(content string, selector string) ([]xpathMatch, error) {
+	expr, e := xpath.Compile(selector)
+	if e != nil {
+		return nil, fmt.Errorf("invalid xpath %q: %v", selector, e)
+	}
+	doc, e := x.document(content)
+	if e != nil {
+		return nil, e
+	}
+	var matches []xpathMatch
+	switch res := expr.Evaluate(htmlquery.CreateXPathNavigator(doc)).(type) {
+	case *xpath.NodeIterator:
+		for res.MoveNext() {
+			nav := res.Current().(*htmlquery.NodeNavigator)
+			if nav.NodeType() == xpath.AttributeNode {
+				matches = append(matches, xpathMatch{value: nav.Value(), isAttr: true})
+				continue
+			}
+			matches = append(matches, xpathMatch{node: nav.Current()})
+		}
+	default:
+		matches = append(matches, xpathMatch{value: fmt.Sprint(res)})
+	}
+	return matches, nil
+}
+
+// query returns what fun asks for of the matches of selector: the text,
+// attribute, outer or inner html of the first match, or the texts, attributes
+// or outer html of all of them. Single results are null without a match
+func (x *xpathEvaluator) query(content string, selector string, fun string) (any, error) {
+	matches, e := x.matches(content, selector)
+	if e != nil {
+		return nil, e
+	}
+	all := []any{}
+	for _, m := range matches {
+		switch fun {
+		case "texts":
+			all = append(all, m.text())
+		case "attrs":
+			if m.isAttr {
+				all = append(all, m.value)
+			}
+		case "allHTML":
+			all = append(all, m.html(true))
+		}
+	}
+
+	switch fun {
+	case "texts", "attrs", "allHTML":
+		return all, nil
+	case "text", "attr", "outerHTML", "innerHTML":
+	default:
+		return nil, fmt.Errorf("unknown xpath result %q", fun)
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	first := matches[0]
+	switch fun {
+	case "text":
+		return first.text(), nil
+	case "attr":
+		if !first.isAttr {
+			return nil, nil
+		}
+		return first.value, nil
+	case "outerHTML":
+		return first.html(true), nil
+	default:
+		return first.html(false), nil
+	}
+}
+
+// enableXPath exposes xpathQuery(content, selector, fun) to the runtime, the
+// XPathNode class of the base runtimes is built on it
+func enableXPath(vm *goja.Runtime) {
+	x := &xpathEvaluator{}
+	vm.Set("xpathQuery", x.query)
+}
@@ -0,0 +1,81 @@
+package jsExtension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const xpathPage = `<html><body>
+<ul id="list">
+  <li class="item"><a href="/one" title="First">One</a></li>
+  <li class="item"><a href="/two">Two <b>bold</b></a></li>
+</ul>
+<p>no links</p>
+</body></html>`
+
+func TestXPathQuery(t *testing.T) {
+	tests := []struct {
+		selector string
+		fun      string
+		want     any
+	}{
+		{"//a", "text", "One"},
+		{"//a", "texts", []any{"One", "Two bold"}},
+		{"//li[2]/a", "innerHTML", "Two <b>bold</b>"},
+		{"//li[1]/a", "outerHTML", `<a href="/one" title="First">One</a>`},
+		{"//a/@href", "attr", "/one"},
+		{"//a/@href", "attrs", []any{"/one", "/two"}},
+		{"//a/@title", "text", "First"},
+		{"//a", "attr", nil},
+		{"//a", "allHTML", []any{`<a href="/one" title="First">One</a>`, "<a href=\"/two\">Two <b>bold</b></a>"}},
+		{"//table", "text", nil},
+		{"//table", "texts", []any{}},
+		{"count(//li)", "text", "2"},
+		{"//p/text()", "text", "no links"},
+	}
+	x := &xpathEvaluator{}
+	for _, tt := range tests {
+		t.Run(tt.selector+" "+tt.fun, func(t *testing.T) {
+			got, err := x.query(xpathPage, tt.selector, tt.fun)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := x.query(xpathPage, "//a[", "text")
+	assert.ErrorContains(t, err, "invalid xpath")
+	_, err = x.query(xpathPage, "//a", "value")
+	assert.ErrorContains(t, err, "unknown xpath result")
+}
+
+func TestXPathNodeInRuntimes(t *testing.T) {
+	loadTestExtension(t, "test.xpath.v2", `
+async function latest(page) {
+  const html = '<div><a href="/a">A</a><a href="/b">B</a></div>';
+  const node = Miru.queryXPath(html, "//a/@href");
+  return [{ title: Miru.queryXPath(html, "//a").text, url: node.attr, links: node.attrs }];
+}`)
+	res, err := Latest[map[string]any](context.Background(), "test.xpath.v2", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "A", (*res[0])["title"])
+		assert.Equal(t, "/a", (*res[0])["url"])
+		assert.Equal(t, []any{"/a", "/b"}, (*res[0])["links"])
+	}
+
+	loadTestExtensionV1(t, "test.xpath.v1", "https://example.com", `
+class Test extends Extension {
+  async latest(page) {
+    const html = '<div><a href="/a">A</a></div>';
+    return [{ title: await this.queryXPath(html, "//a").text, url: this.queryXPath(html, "//a/@href").attr }];
+  }
+}`)
+	res, err = Latest[map[string]any](context.Background(), "test.xpath.v1", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "A", (*res[0])["title"])
+		assert.Equal(t, "/a", (*res[0])["url"])
+	}
+}
@@ -57,7 +57,12 @@ func initCookieJar() {
 		log.Println("Running on Android, using cookie location:", dir)
 	}
 
-	e = os.MkdirAll(dir, 0755)
+	UseCookieJar(dir)
+}
+
+// UseCookieJar stores cookies in dir instead of the default location
+func UseCookieJar(dir string) {
+	e := os.MkdirAll(dir, 0755)
 	if e != nil {
 		panic(e)
 	}
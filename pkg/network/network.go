@@ -18,6 +18,8 @@ var defaultClient *fasthttp.Client
 type Response[T StringOrBytes] struct {
 	Res  *fasthttp.Response
 	Body T
+	// URL of the response, the last one when redirects were followed
+	URL string
 }
 
 // Request makes an HTTP request and returns the response as type T.
@@ -33,7 +35,11 @@ type Response[T StringOrBytes] struct {
 //	The response body as type T (string or []byte), and an error if any occurred.
 func Request[T StringOrBytes](url string, option *RequestOptions, readPreference func(*fasthttp.Response) ([]byte, error)) (Response[T], error) {
 
-	if option.TlsSpoofConfig.Body != "" {
+	if option.Context != nil && option.Context.Err() != nil {
+		return Response[T]{}, option.Context.Err()
+	}
+
+	if option.TlsSpoofConfig.Body != "" && transport == nil {
 		o, e := requestWithCycleTLS[T](url, option)
 		return o, e
 	}
@@ -70,6 +76,7 @@ func requestWithCycleTLS[T StringOrBytes](requrl string, option *RequestOptions)
 	return Response[T]{
 		Res:  &fasthttp.Response{},
 		Body: any(res.Body).(T),
+		URL:  requrl,
 	}, nil
 }
 
@@ -145,29 +152,40 @@ func saveFasthttpCookies(u *url.URL, res *fasthttp.Response) {
 func request[T StringOrBytes](reqUrl string, option *RequestOptions, readPreference func(*fasthttp.Response) ([]byte, error)) (Response[T], error) {
 
 	req := fasthttp.AcquireRequest()
-	res := fasthttp.AcquireResponse()
 	defer fasthttp.ReleaseRequest(req)
-	defer fasthttp.ReleaseResponse(res)
-
-	client, err := prepareRequest(req, reqUrl, option)
-	if err != nil {
-		return Response[T]{Res: res}, err
-	}
+	// The response is handed to the caller, so it doesn't come from the pool
+	res := &fasthttp.Response{}
 
-	if option.Timeout > 0 {
-		err = client.DoTimeout(req, res, time.Duration(option.Timeout)*time.Millisecond)
-	} else {
-		err = client.Do(req, res)
+	if err := send(req, res, reqUrl, option); err != nil {
+		return Response[T]{Res: res, URL: reqUrl}, err
 	}
 
-	if err != nil {
-		return Response[T]{Res: res}, err
+	// Follow redirects
+	for redirects := 0; redirects < option.MaxRedirects && IsRedirect(res.StatusCode()); redirects++ {
+		u, _ := url.Parse(reqUrl)
+		location, err := u.Parse(string(res.Header.Peek("Location")))
+		if err != nil || len(res.Header.Peek("Location")) == 0 {
+			break
+		}
+		if option.CheckRedirect != nil {
+			if err := option.CheckRedirect(location.String()); err != nil {
+				return Response[T]{Res: res, URL: reqUrl}, err
+			}
+		}
+		saveFasthttpCookies(u, res)
+		option = redirectOptions(option, res.StatusCode())
+		reqUrl = location.String()
+		req.Reset()
+		res.Reset()
+		if err := send(req, res, reqUrl, option); err != nil {
+			return Response[T]{Res: res, URL: reqUrl}, err
+		}
 	}
 
 	// Read the response body
 	body, err := readPreference(res)
 	if err != nil {
-		return Response[T]{Res: res}, err
+		return Response[T]{Res: res, URL: reqUrl}, err
 	}
 
 	u, _ := url.Parse(reqUrl)
@@ -185,12 +203,107 @@ func request[T StringOrBytes](reqUrl string, option *RequestOptions, readPrefere
 	return Response[T]{
 		Res:  res,
 		Body: result,
+		URL:  reqUrl,
 	}, nil
 }
 
+// send prepares req for reqUrl and performs it
+func send(req *fasthttp.Request, res *fasthttp.Response, reqUrl string, option *RequestOptions) error {
+	client, err := prepareRequest(req, reqUrl, option)
+	if err != nil {
+		return err
+	}
+
+	// Read once, the goroutine of doWithContext may outlive the request
+	transport := transport
+	do := func(req *fasthttp.Request, res *fasthttp.Response) error {
+		if transport != nil {
+			return transport(req, res)
+		}
+		if deadline, ok := option.Context.Deadline(); ok && option.Timeout <= 0 {
+			return client.DoDeadline(req, res, deadline)
+		} else if option.Timeout > 0 {
+			return client.DoTimeout(req, res, time.Duration(option.Timeout)*time.Millisecond)
+		}
+		return client.Do(req, res)
+	}
+	if option.Context != nil {
+		return doWithContext(option.Context, do, req, res)
+	}
+	if transport != nil {
+		return transport(req, res)
+	} else if option.Timeout > 0 {
+		return client.DoTimeout(req, res, time.Duration(option.Timeout)*time.Millisecond)
+	}
+	return client.Do(req, res)
+}
+
+// IsRedirect reports whether status asks the client to follow the Location header
+func IsRedirect(status int) bool {
+	switch status {
+	case 301, 302, 303, 307, 308:
+		return true
+	}
+	return false
+}
+
+// redirectOptions returns the options of the request following a redirect
+// with status. Like browsers, a 303 and a POST answered with 301 or 302 are
+// followed with a GET without body
+func redirectOptions(option *RequestOptions, status int) *RequestOptions {
+	method := checkRequestMethod(option.Method)
+	if !(status == 303 && method != "HEAD") && !((status == 301 || status == 302) && method == "POST") {
+		return option
+	}
+	next := *option
+	next.Method = "GET"
+	next.RequestBody = ""
+	next.RequestBodyRaw = nil
+	next.Headers = map[string]string{}
+	for k, v := range option.Headers {
+		switch strings.ToLower(k) {
+		case "content-type", "content-length":
+		default:
+			next.Headers[k] = v
+		}
+	}
+	return &next
+}
+
+// doWithContext performs the request with do on copies of req and res so that it can
+// return as soon as ctx is done, the copies are released once do gives them back
+func doWithContext(ctx context.Context, do func(*fasthttp.Request, *fasthttp.Response) error, req *fasthttp.Request, res *fasthttp.Response) error {
+	creq := fasthttp.AcquireRequest()
+	cres := fasthttp.AcquireResponse()
+	req.CopyTo(creq)
+
+	release := func() {
+		fasthttp.ReleaseRequest(creq)
+		fasthttp.ReleaseResponse(cres)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- do(creq, cres)
+	}()
+
+	select {
+	case err := <-done:
+		cres.CopyTo(res)
+		release()
+		return err
+	case <-ctx.Done():
+		go func() {
+			<-done
+			release()
+		}()
+		return ctx.Err()
+	}
+}
+
 func checkRequestMethod(method string) string {
 	switch method {
-	case "GET", "POST", "PUT", "DELETE", "PATCH":
+	case "GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "OPTIONS":
 		return method
 	default:
 		return "GET"
@@ -228,6 +341,14 @@ type RequestOptions struct {
 	RequestBodyRaw []byte            `json:"request_body_raw"`
 	Timeout        int               `json:"timeout"`
 	TlsSpoofConfig cycletls.Options  `json:"tls_spoof_config"`
+	// MaxRedirects is the number of redirects followed, 0 returns the
+	// redirect response. Callers set it with CheckRedirect, scripts can't
+	MaxRedirects int `json:"-"`
+	// Context aborts the request when it is done, nil means never
+	Context context.Context `json:"-"`
+	// CheckRedirect is called with the url of each redirect before it is
+	// followed, an error stops the request
+	CheckRedirect func(url string) error `json:"-"`
 }
 
 func dnsResolve() {
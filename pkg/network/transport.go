@@ -0,0 +1,17 @@
+package network
+
+import (
+	"github.com/valyala/fasthttp"
+)
+
+// Transport sends a prepared request in place of the http client, the
+// extension harness uses it to replay recorded responses
+type Transport func(req *fasthttp.Request, res *fasthttp.Response) error
+
+var transport Transport
+
+// SetTransport routes every request through t, nil restores the http client.
+// It must be set before extensions make requests
+func SetTransport(t Transport) {
+	transport = t
+}
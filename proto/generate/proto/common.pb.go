@@ -36,8 +36,19 @@ type ExtensionMeta struct {
 	Api           string                 `protobuf:"bytes,11,opt,name=api,proto3" json:"api,omitempty"`
 	Error         string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
 	Type          string                 `protobuf:"bytes,13,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Verification  *ExtensionVerification `protobuf:"bytes,14,opt,name=verification,proto3" json:"verification,omitempty"`
+	Disabled      bool                   `protobuf:"varint,15,opt,name=disabled,proto3" json:"disabled,omitempty"` // listed but never run
+	PinnedVersion string                 `protobuf:"bytes,16,opt,name=pinned_version,json=pinnedVersion,proto3" json:"pinned_version,omitempty"`
+	// Methods the extension implements: latest, search, detail, watch, mirror,
+	// filters, popular, tags and checkUpdate
+	Methods []string `protobuf:"bytes,17,rep,name=methods,proto3" json:"methods,omitempty"`
+	// Last download of the extension that failed verification and was not
+	// installed, verification stays the one of the installed script
+	FailedVerification *ExtensionVerification `protobuf:"bytes,18,opt,name=failed_verification,json=failedVerification,proto3" json:"failed_verification,omitempty"`
+	// Hosts the extension was blocked from accessing, granting them allows it
+	BlockedDomains []string `protobuf:"bytes,19,rep,name=blocked_domains,json=blockedDomains,proto3" json:"blocked_domains,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ExtensionMeta) Reset() {
@@ -161,6 +172,134 @@ func (x *ExtensionMeta) GetType() string {
 	return ""
 }
 
+func (x *ExtensionMeta) GetVerification() *ExtensionVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *ExtensionMeta) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ExtensionMeta) GetPinnedVersion() string {
+	if x != nil {
+		return x.PinnedVersion
+	}
+	return ""
+}
+
+func (x *ExtensionMeta) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *ExtensionMeta) GetFailedVerification() *ExtensionVerification {
+	if x != nil {
+		return x.FailedVerification
+	}
+	return nil
+}
+
+func (x *ExtensionMeta) GetBlockedDomains() []string {
+	if x != nil {
+		return x.BlockedDomains
+	}
+	return nil
+}
+
+// Outcome of verifying a downloaded script against its repository index
+type ExtensionVerification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// signed, hashed, unverified, rejected, quarantined or modified
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RepoUrl       string `protobuf:"bytes,2,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Hash          string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"` // sha256 in hex
+	Key           string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Time          string `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"` // ISO8601
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtensionVerification) Reset() {
+	*x = ExtensionVerification{}
+	mi := &file_proto_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionVerification) ProtoMessage() {}
+
+func (x *ExtensionVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionVerification.ProtoReflect.Descriptor instead.
+func (*ExtensionVerification) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExtensionVerification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExtensionVerification) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *ExtensionVerification) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ExtensionVerification) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExtensionVerification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ExtensionVerification) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
 type DownloadProgress struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Progress           int32                  `protobuf:"varint,1,opt,name=progress,proto3" json:"progress,omitempty"`
@@ -179,7 +318,7 @@ type DownloadProgress struct {
 
 func (x *DownloadProgress) Reset() {
 	*x = DownloadProgress{}
-	mi := &file_proto_common_proto_msgTypes[1]
+	mi := &file_proto_common_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +330,7 @@ func (x *DownloadProgress) String() string {
 func (*DownloadProgress) ProtoMessage() {}
 
 func (x *DownloadProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[1]
+	mi := &file_proto_common_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +343,7 @@ func (x *DownloadProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadProgress.ProtoReflect.Descriptor instead.
 func (*DownloadProgress) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{1}
+	return file_proto_common_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DownloadProgress) GetProgress() int32 {
@@ -287,7 +426,7 @@ type TorrentStats struct {
 
 func (x *TorrentStats) Reset() {
 	*x = TorrentStats{}
-	mi := &file_proto_common_proto_msgTypes[2]
+	mi := &file_proto_common_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +438,7 @@ func (x *TorrentStats) String() string {
 func (*TorrentStats) ProtoMessage() {}
 
 func (x *TorrentStats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[2]
+	mi := &file_proto_common_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +451,7 @@ func (x *TorrentStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TorrentStats.ProtoReflect.Descriptor instead.
 func (*TorrentStats) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{2}
+	return file_proto_common_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TorrentStats) GetTotalDown() int64 {
@@ -340,7 +479,7 @@ type AvailableHlsVariant struct {
 
 func (x *AvailableHlsVariant) Reset() {
 	*x = AvailableHlsVariant{}
-	mi := &file_proto_common_proto_msgTypes[3]
+	mi := &file_proto_common_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +491,7 @@ func (x *AvailableHlsVariant) String() string {
 func (*AvailableHlsVariant) ProtoMessage() {}
 
 func (x *AvailableHlsVariant) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[3]
+	mi := &file_proto_common_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +504,7 @@ func (x *AvailableHlsVariant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AvailableHlsVariant.ProtoReflect.Descriptor instead.
 func (*AvailableHlsVariant) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{3}
+	return file_proto_common_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AvailableHlsVariant) GetResolution() string {
@@ -411,7 +550,7 @@ type Download struct {
 
 func (x *Download) Reset() {
 	*x = Download{}
-	mi := &file_proto_common_proto_msgTypes[4]
+	mi := &file_proto_common_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -423,7 +562,7 @@ func (x *Download) String() string {
 func (*Download) ProtoMessage() {}
 
 func (x *Download) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[4]
+	mi := &file_proto_common_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -436,7 +575,7 @@ func (x *Download) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Download.ProtoReflect.Descriptor instead.
 func (*Download) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{4}
+	return file_proto_common_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Download) GetId() int32 {
@@ -542,7 +681,7 @@ type TorrentResult struct {
 
 func (x *TorrentResult) Reset() {
 	*x = TorrentResult{}
-	mi := &file_proto_common_proto_msgTypes[5]
+	mi := &file_proto_common_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +693,7 @@ func (x *TorrentResult) String() string {
 func (*TorrentResult) ProtoMessage() {}
 
 func (x *TorrentResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[5]
+	mi := &file_proto_common_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +706,7 @@ func (x *TorrentResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TorrentResult.ProtoReflect.Descriptor instead.
 func (*TorrentResult) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{5}
+	return file_proto_common_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TorrentResult) GetInfoHash() string {
@@ -595,7 +734,7 @@ var File_proto_common_proto protoreflect.FileDescriptor
 
 const file_proto_common_proto_rawDesc = "" +
 	"\n" +
-	"\x12proto/common.proto\x12\x04miru\"\xbd\x02\n" +
+	"\x12proto/common.proto\x12\x04miru\"\xd2\x04\n" +
 	"\rExtensionMeta\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
@@ -610,7 +749,20 @@ const file_proto_common_proto_rawDesc = "" +
 	" \x03(\tR\x04tags\x12\x10\n" +
 	"\x03api\x18\v \x01(\tR\x03api\x12\x14\n" +
 	"\x05error\x18\f \x01(\tR\x05error\x12\x12\n" +
-	"\x04type\x18\r \x01(\tR\x04type\"\x9d\x02\n" +
+	"\x04type\x18\r \x01(\tR\x04type\x12?\n" +
+	"\fverification\x18\x0e \x01(\v2\x1b.miru.ExtensionVerificationR\fverification\x12\x1a\n" +
+	"\bdisabled\x18\x0f \x01(\bR\bdisabled\x12%\n" +
+	"\x0epinned_version\x18\x10 \x01(\tR\rpinnedVersion\x12\x18\n" +
+	"\amethods\x18\x11 \x03(\tR\amethods\x12L\n" +
+	"\x13failed_verification\x18\x12 \x01(\v2\x1b.miru.ExtensionVerificationR\x12failedVerification\x12'\n" +
+	"\x0fblocked_domains\x18\x13 \x03(\tR\x0eblockedDomains\"\x9c\x01\n" +
+	"\x15ExtensionVerification\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
+	"\brepo_url\x18\x02 \x01(\tR\arepoUrl\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\tR\x04time\"\x9d\x02\n" +
 	"\x10DownloadProgress\x12\x1a\n" +
 	"\bprogress\x18\x01 \x01(\x05R\bprogress\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x12\x14\n" +
@@ -671,23 +823,26 @@ func file_proto_common_proto_rawDescGZIP() []byte {
 	return file_proto_common_proto_rawDescData
 }
 
-var file_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_proto_common_proto_goTypes = []any{
-	(*ExtensionMeta)(nil),       // 0: miru.ExtensionMeta
-	(*DownloadProgress)(nil),    // 1: miru.DownloadProgress
-	(*TorrentStats)(nil),        // 2: miru.TorrentStats
-	(*AvailableHlsVariant)(nil), // 3: miru.AvailableHlsVariant
-	(*Download)(nil),            // 4: miru.Download
-	(*TorrentResult)(nil),       // 5: miru.TorrentResult
-	nil,                         // 6: miru.Download.HeadersEntry
+	(*ExtensionMeta)(nil),         // 0: miru.ExtensionMeta
+	(*ExtensionVerification)(nil), // 1: miru.ExtensionVerification
+	(*DownloadProgress)(nil),      // 2: miru.DownloadProgress
+	(*TorrentStats)(nil),          // 3: miru.TorrentStats
+	(*AvailableHlsVariant)(nil),   // 4: miru.AvailableHlsVariant
+	(*Download)(nil),              // 5: miru.Download
+	(*TorrentResult)(nil),         // 6: miru.TorrentResult
+	nil,                           // 7: miru.Download.HeadersEntry
 }
 var file_proto_common_proto_depIdxs = []int32{
-	6, // 0: miru.Download.headers:type_name -> miru.Download.HeadersEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: miru.ExtensionMeta.verification:type_name -> miru.ExtensionVerification
+	1, // 1: miru.ExtensionMeta.failed_verification:type_name -> miru.ExtensionVerification
+	7, // 2: miru.Download.headers:type_name -> miru.Download.HeadersEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_proto_common_proto_init() }
@@ -701,7 +856,7 @@ func file_proto_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_common_proto_rawDesc), len(file_proto_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
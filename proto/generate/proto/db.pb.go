@@ -1296,6 +1296,296 @@ func (x *GetFavoriteGroupsByFavoriteResponse) GetGroups() []*FavoriteGroup {
 	return nil
 }
 
+// An unset interval makes the group use the default
+type SetFavoriteGroupCheckIntervalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Minutes       *int32                 `protobuf:"varint,2,opt,name=minutes,proto3,oneof" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFavoriteGroupCheckIntervalRequest) Reset() {
+	*x = SetFavoriteGroupCheckIntervalRequest{}
+	mi := &file_proto_db_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFavoriteGroupCheckIntervalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteGroupCheckIntervalRequest) ProtoMessage() {}
+
+func (x *SetFavoriteGroupCheckIntervalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_db_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteGroupCheckIntervalRequest.ProtoReflect.Descriptor instead.
+func (*SetFavoriteGroupCheckIntervalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_db_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetFavoriteGroupCheckIntervalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetFavoriteGroupCheckIntervalRequest) GetMinutes() int32 {
+	if x != nil && x.Minutes != nil {
+		return *x.Minutes
+	}
+	return 0
+}
+
+type SetFavoriteGroupCheckIntervalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFavoriteGroupCheckIntervalResponse) Reset() {
+	*x = SetFavoriteGroupCheckIntervalResponse{}
+	mi := &file_proto_db_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFavoriteGroupCheckIntervalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteGroupCheckIntervalResponse) ProtoMessage() {}
+
+func (x *SetFavoriteGroupCheckIntervalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_db_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteGroupCheckIntervalResponse.ProtoReflect.Descriptor instead.
+func (*SetFavoriteGroupCheckIntervalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_db_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetFavoriteGroupCheckIntervalResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Episode updates, empty filters match every favorite
+type GetEpisodeUpdatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Package       string                 `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	DetailUrl     string                 `protobuf:"bytes,2,opt,name=detail_url,json=detailUrl,proto3" json:"detail_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeUpdatesRequest) Reset() {
+	*x = GetEpisodeUpdatesRequest{}
+	mi := &file_proto_db_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeUpdatesRequest) ProtoMessage() {}
+
+func (x *GetEpisodeUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_db_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodeUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_db_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetEpisodeUpdatesRequest) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *GetEpisodeUpdatesRequest) GetDetailUrl() string {
+	if x != nil {
+		return x.DetailUrl
+	}
+	return ""
+}
+
+type GetEpisodeUpdatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*EpisodeUpdate       `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeUpdatesResponse) Reset() {
+	*x = GetEpisodeUpdatesResponse{}
+	mi := &file_proto_db_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeUpdatesResponse) ProtoMessage() {}
+
+func (x *GetEpisodeUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_db_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodeUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_db_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetEpisodeUpdatesResponse) GetEpisodes() []*EpisodeUpdate {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type ClearEpisodeUpdatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Package       string                 `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	DetailUrl     string                 `protobuf:"bytes,2,opt,name=detail_url,json=detailUrl,proto3" json:"detail_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearEpisodeUpdatesRequest) Reset() {
+	*x = ClearEpisodeUpdatesRequest{}
+	mi := &file_proto_db_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearEpisodeUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearEpisodeUpdatesRequest) ProtoMessage() {}
+
+func (x *ClearEpisodeUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_db_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearEpisodeUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*ClearEpisodeUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_db_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClearEpisodeUpdatesRequest) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *ClearEpisodeUpdatesRequest) GetDetailUrl() string {
+	if x != nil {
+		return x.DetailUrl
+	}
+	return ""
+}
+
+type ClearEpisodeUpdatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearEpisodeUpdatesResponse) Reset() {
+	*x = ClearEpisodeUpdatesResponse{}
+	mi := &file_proto_db_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearEpisodeUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearEpisodeUpdatesResponse) ProtoMessage() {}
+
+func (x *ClearEpisodeUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_db_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearEpisodeUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*ClearEpisodeUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_db_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ClearEpisodeUpdatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // History
 type GetHistoriesByTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1308,7 +1598,7 @@ type GetHistoriesByTypeRequest struct {
 
 func (x *GetHistoriesByTypeRequest) Reset() {
 	*x = GetHistoriesByTypeRequest{}
-	mi := &file_proto_db_proto_msgTypes[26]
+	mi := &file_proto_db_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1320,7 +1610,7 @@ func (x *GetHistoriesByTypeRequest) String() string {
 func (*GetHistoriesByTypeRequest) ProtoMessage() {}
 
 func (x *GetHistoriesByTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[26]
+	mi := &file_proto_db_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +1623,7 @@ func (x *GetHistoriesByTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoriesByTypeRequest.ProtoReflect.Descriptor instead.
 func (*GetHistoriesByTypeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{26}
+	return file_proto_db_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetHistoriesByTypeRequest) GetType() string {
@@ -1366,7 +1656,7 @@ type GetHistoriesByTypeResponse struct {
 
 func (x *GetHistoriesByTypeResponse) Reset() {
 	*x = GetHistoriesByTypeResponse{}
-	mi := &file_proto_db_proto_msgTypes[27]
+	mi := &file_proto_db_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1378,7 +1668,7 @@ func (x *GetHistoriesByTypeResponse) String() string {
 func (*GetHistoriesByTypeResponse) ProtoMessage() {}
 
 func (x *GetHistoriesByTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[27]
+	mi := &file_proto_db_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1681,7 @@ func (x *GetHistoriesByTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoriesByTypeResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoriesByTypeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{27}
+	return file_proto_db_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetHistoriesByTypeResponse) GetHistories() []*History {
@@ -1410,7 +1700,7 @@ type PutHistoryRequest struct {
 
 func (x *PutHistoryRequest) Reset() {
 	*x = PutHistoryRequest{}
-	mi := &file_proto_db_proto_msgTypes[28]
+	mi := &file_proto_db_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1422,7 +1712,7 @@ func (x *PutHistoryRequest) String() string {
 func (*PutHistoryRequest) ProtoMessage() {}
 
 func (x *PutHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[28]
+	mi := &file_proto_db_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +1725,7 @@ func (x *PutHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutHistoryRequest.ProtoReflect.Descriptor instead.
 func (*PutHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{28}
+	return file_proto_db_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *PutHistoryRequest) GetHistory() *History {
@@ -1454,7 +1744,7 @@ type PutHistoryResponse struct {
 
 func (x *PutHistoryResponse) Reset() {
 	*x = PutHistoryResponse{}
-	mi := &file_proto_db_proto_msgTypes[29]
+	mi := &file_proto_db_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1466,7 +1756,7 @@ func (x *PutHistoryResponse) String() string {
 func (*PutHistoryResponse) ProtoMessage() {}
 
 func (x *PutHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[29]
+	mi := &file_proto_db_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,7 +1769,7 @@ func (x *PutHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutHistoryResponse.ProtoReflect.Descriptor instead.
 func (*PutHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{29}
+	return file_proto_db_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PutHistoryResponse) GetHistory() *History {
@@ -1499,7 +1789,7 @@ type GetHistoryByPackageAndDetailUrlRequest struct {
 
 func (x *GetHistoryByPackageAndDetailUrlRequest) Reset() {
 	*x = GetHistoryByPackageAndDetailUrlRequest{}
-	mi := &file_proto_db_proto_msgTypes[30]
+	mi := &file_proto_db_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1511,7 +1801,7 @@ func (x *GetHistoryByPackageAndDetailUrlRequest) String() string {
 func (*GetHistoryByPackageAndDetailUrlRequest) ProtoMessage() {}
 
 func (x *GetHistoryByPackageAndDetailUrlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[30]
+	mi := &file_proto_db_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +1814,7 @@ func (x *GetHistoryByPackageAndDetailUrlRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetHistoryByPackageAndDetailUrlRequest.ProtoReflect.Descriptor instead.
 func (*GetHistoryByPackageAndDetailUrlRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{30}
+	return file_proto_db_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetHistoryByPackageAndDetailUrlRequest) GetPackage() string {
@@ -1550,7 +1840,7 @@ type GetHistoryByPackageAndDetailUrlResponse struct {
 
 func (x *GetHistoryByPackageAndDetailUrlResponse) Reset() {
 	*x = GetHistoryByPackageAndDetailUrlResponse{}
-	mi := &file_proto_db_proto_msgTypes[31]
+	mi := &file_proto_db_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1562,7 +1852,7 @@ func (x *GetHistoryByPackageAndDetailUrlResponse) String() string {
 func (*GetHistoryByPackageAndDetailUrlResponse) ProtoMessage() {}
 
 func (x *GetHistoryByPackageAndDetailUrlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[31]
+	mi := &file_proto_db_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,7 +1865,7 @@ func (x *GetHistoryByPackageAndDetailUrlResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetHistoryByPackageAndDetailUrlResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoryByPackageAndDetailUrlResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{31}
+	return file_proto_db_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetHistoryByPackageAndDetailUrlResponse) GetHistory() []*History {
@@ -1595,7 +1885,7 @@ type DeleteHistoryByPackageAndUrlRequest struct {
 
 func (x *DeleteHistoryByPackageAndUrlRequest) Reset() {
 	*x = DeleteHistoryByPackageAndUrlRequest{}
-	mi := &file_proto_db_proto_msgTypes[32]
+	mi := &file_proto_db_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1607,7 +1897,7 @@ func (x *DeleteHistoryByPackageAndUrlRequest) String() string {
 func (*DeleteHistoryByPackageAndUrlRequest) ProtoMessage() {}
 
 func (x *DeleteHistoryByPackageAndUrlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[32]
+	mi := &file_proto_db_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +1910,7 @@ func (x *DeleteHistoryByPackageAndUrlRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use DeleteHistoryByPackageAndUrlRequest.ProtoReflect.Descriptor instead.
 func (*DeleteHistoryByPackageAndUrlRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{32}
+	return file_proto_db_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DeleteHistoryByPackageAndUrlRequest) GetPackage() string {
@@ -1646,7 +1936,7 @@ type DeleteHistoryByPackageAndUrlResponse struct {
 
 func (x *DeleteHistoryByPackageAndUrlResponse) Reset() {
 	*x = DeleteHistoryByPackageAndUrlResponse{}
-	mi := &file_proto_db_proto_msgTypes[33]
+	mi := &file_proto_db_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1658,7 +1948,7 @@ func (x *DeleteHistoryByPackageAndUrlResponse) String() string {
 func (*DeleteHistoryByPackageAndUrlResponse) ProtoMessage() {}
 
 func (x *DeleteHistoryByPackageAndUrlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[33]
+	mi := &file_proto_db_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,7 +1961,7 @@ func (x *DeleteHistoryByPackageAndUrlResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DeleteHistoryByPackageAndUrlResponse.ProtoReflect.Descriptor instead.
 func (*DeleteHistoryByPackageAndUrlResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{33}
+	return file_proto_db_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeleteHistoryByPackageAndUrlResponse) GetMessage() string {
@@ -1689,7 +1979,7 @@ type DeleteAllHistoryRequest struct {
 
 func (x *DeleteAllHistoryRequest) Reset() {
 	*x = DeleteAllHistoryRequest{}
-	mi := &file_proto_db_proto_msgTypes[34]
+	mi := &file_proto_db_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1701,7 +1991,7 @@ func (x *DeleteAllHistoryRequest) String() string {
 func (*DeleteAllHistoryRequest) ProtoMessage() {}
 
 func (x *DeleteAllHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[34]
+	mi := &file_proto_db_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +2004,7 @@ func (x *DeleteAllHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAllHistoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAllHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{34}
+	return file_proto_db_proto_rawDescGZIP(), []int{40}
 }
 
 type DeleteAllHistoryResponse struct {
@@ -1726,7 +2016,7 @@ type DeleteAllHistoryResponse struct {
 
 func (x *DeleteAllHistoryResponse) Reset() {
 	*x = DeleteAllHistoryResponse{}
-	mi := &file_proto_db_proto_msgTypes[35]
+	mi := &file_proto_db_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1738,7 +2028,7 @@ func (x *DeleteAllHistoryResponse) String() string {
 func (*DeleteAllHistoryResponse) ProtoMessage() {}
 
 func (x *DeleteAllHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[35]
+	mi := &file_proto_db_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,7 +2041,7 @@ func (x *DeleteAllHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAllHistoryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAllHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{35}
+	return file_proto_db_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DeleteAllHistoryResponse) GetMessage() string {
@@ -1773,7 +2063,7 @@ type GetHistorysFilteredRequest struct {
 
 func (x *GetHistorysFilteredRequest) Reset() {
 	*x = GetHistorysFilteredRequest{}
-	mi := &file_proto_db_proto_msgTypes[36]
+	mi := &file_proto_db_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1785,7 +2075,7 @@ func (x *GetHistorysFilteredRequest) String() string {
 func (*GetHistorysFilteredRequest) ProtoMessage() {}
 
 func (x *GetHistorysFilteredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[36]
+	mi := &file_proto_db_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1798,7 +2088,7 @@ func (x *GetHistorysFilteredRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistorysFilteredRequest.ProtoReflect.Descriptor instead.
 func (*GetHistorysFilteredRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{36}
+	return file_proto_db_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetHistorysFilteredRequest) GetType() string {
@@ -1838,7 +2128,7 @@ type GetHistorysFilteredResponse struct {
 
 func (x *GetHistorysFilteredResponse) Reset() {
 	*x = GetHistorysFilteredResponse{}
-	mi := &file_proto_db_proto_msgTypes[37]
+	mi := &file_proto_db_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1850,7 +2140,7 @@ func (x *GetHistorysFilteredResponse) String() string {
 func (*GetHistorysFilteredResponse) ProtoMessage() {}
 
 func (x *GetHistorysFilteredResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[37]
+	mi := &file_proto_db_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1863,7 +2153,7 @@ func (x *GetHistorysFilteredResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistorysFilteredResponse.ProtoReflect.Descriptor instead.
 func (*GetHistorysFilteredResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{37}
+	return file_proto_db_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetHistorysFilteredResponse) GetHistories() []*History {
@@ -1884,7 +2174,7 @@ type GetTrackRequest struct {
 
 func (x *GetTrackRequest) Reset() {
 	*x = GetTrackRequest{}
-	mi := &file_proto_db_proto_msgTypes[38]
+	mi := &file_proto_db_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1896,7 +2186,7 @@ func (x *GetTrackRequest) String() string {
 func (*GetTrackRequest) ProtoMessage() {}
 
 func (x *GetTrackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[38]
+	mi := &file_proto_db_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1909,7 +2199,7 @@ func (x *GetTrackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTrackRequest.ProtoReflect.Descriptor instead.
 func (*GetTrackRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{38}
+	return file_proto_db_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetTrackRequest) GetTrackingId() string {
@@ -1935,7 +2225,7 @@ type GetTrackResponse struct {
 
 func (x *GetTrackResponse) Reset() {
 	*x = GetTrackResponse{}
-	mi := &file_proto_db_proto_msgTypes[39]
+	mi := &file_proto_db_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1947,7 +2237,7 @@ func (x *GetTrackResponse) String() string {
 func (*GetTrackResponse) ProtoMessage() {}
 
 func (x *GetTrackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[39]
+	mi := &file_proto_db_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1960,7 +2250,7 @@ func (x *GetTrackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTrackResponse.ProtoReflect.Descriptor instead.
 func (*GetTrackResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{39}
+	return file_proto_db_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetTrackResponse) GetTrack() *Track {
@@ -1982,7 +2272,7 @@ type PutTrackRequest struct {
 
 func (x *PutTrackRequest) Reset() {
 	*x = PutTrackRequest{}
-	mi := &file_proto_db_proto_msgTypes[40]
+	mi := &file_proto_db_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1994,7 +2284,7 @@ func (x *PutTrackRequest) String() string {
 func (*PutTrackRequest) ProtoMessage() {}
 
 func (x *PutTrackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[40]
+	mi := &file_proto_db_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2007,7 +2297,7 @@ func (x *PutTrackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutTrackRequest.ProtoReflect.Descriptor instead.
 func (*PutTrackRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{40}
+	return file_proto_db_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *PutTrackRequest) GetTrackingId() string {
@@ -2047,7 +2337,7 @@ type PutTrackResponse struct {
 
 func (x *PutTrackResponse) Reset() {
 	*x = PutTrackResponse{}
-	mi := &file_proto_db_proto_msgTypes[41]
+	mi := &file_proto_db_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2059,7 +2349,7 @@ func (x *PutTrackResponse) String() string {
 func (*PutTrackResponse) ProtoMessage() {}
 
 func (x *PutTrackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[41]
+	mi := &file_proto_db_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2072,7 +2362,7 @@ func (x *PutTrackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutTrackResponse.ProtoReflect.Descriptor instead.
 func (*PutTrackResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{41}
+	return file_proto_db_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *PutTrackResponse) GetTrack() *Track {
@@ -2094,7 +2384,7 @@ type UpsertTrackerRequest struct {
 
 func (x *UpsertTrackerRequest) Reset() {
 	*x = UpsertTrackerRequest{}
-	mi := &file_proto_db_proto_msgTypes[42]
+	mi := &file_proto_db_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2106,7 +2396,7 @@ func (x *UpsertTrackerRequest) String() string {
 func (*UpsertTrackerRequest) ProtoMessage() {}
 
 func (x *UpsertTrackerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[42]
+	mi := &file_proto_db_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2119,7 +2409,7 @@ func (x *UpsertTrackerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertTrackerRequest.ProtoReflect.Descriptor instead.
 func (*UpsertTrackerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{42}
+	return file_proto_db_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UpsertTrackerRequest) GetPackage() string {
@@ -2152,7 +2442,7 @@ type UpsertTrackerResponse struct {
 
 func (x *UpsertTrackerResponse) Reset() {
 	*x = UpsertTrackerResponse{}
-	mi := &file_proto_db_proto_msgTypes[43]
+	mi := &file_proto_db_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2164,7 +2454,7 @@ func (x *UpsertTrackerResponse) String() string {
 func (*UpsertTrackerResponse) ProtoMessage() {}
 
 func (x *UpsertTrackerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[43]
+	mi := &file_proto_db_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2177,7 +2467,7 @@ func (x *UpsertTrackerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertTrackerResponse.ProtoReflect.Descriptor instead.
 func (*UpsertTrackerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{43}
+	return file_proto_db_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *UpsertTrackerResponse) GetTracker() *Tracker {
@@ -2198,7 +2488,7 @@ type DeleteTrackerRequest struct {
 
 func (x *DeleteTrackerRequest) Reset() {
 	*x = DeleteTrackerRequest{}
-	mi := &file_proto_db_proto_msgTypes[44]
+	mi := &file_proto_db_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2210,7 +2500,7 @@ func (x *DeleteTrackerRequest) String() string {
 func (*DeleteTrackerRequest) ProtoMessage() {}
 
 func (x *DeleteTrackerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[44]
+	mi := &file_proto_db_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +2513,7 @@ func (x *DeleteTrackerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTrackerRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTrackerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{44}
+	return file_proto_db_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *DeleteTrackerRequest) GetPackage() string {
@@ -2256,7 +2546,7 @@ type DeleteTrackerResponse struct {
 
 func (x *DeleteTrackerResponse) Reset() {
 	*x = DeleteTrackerResponse{}
-	mi := &file_proto_db_proto_msgTypes[45]
+	mi := &file_proto_db_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2268,7 +2558,7 @@ func (x *DeleteTrackerResponse) String() string {
 func (*DeleteTrackerResponse) ProtoMessage() {}
 
 func (x *DeleteTrackerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[45]
+	mi := &file_proto_db_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,7 +2571,7 @@ func (x *DeleteTrackerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTrackerResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTrackerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{45}
+	return file_proto_db_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *DeleteTrackerResponse) GetMessage() string {
@@ -2301,7 +2591,7 @@ type DeleteTrackerByTrackerIdRequest struct {
 
 func (x *DeleteTrackerByTrackerIdRequest) Reset() {
 	*x = DeleteTrackerByTrackerIdRequest{}
-	mi := &file_proto_db_proto_msgTypes[46]
+	mi := &file_proto_db_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2313,7 +2603,7 @@ func (x *DeleteTrackerByTrackerIdRequest) String() string {
 func (*DeleteTrackerByTrackerIdRequest) ProtoMessage() {}
 
 func (x *DeleteTrackerByTrackerIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[46]
+	mi := &file_proto_db_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,7 +2616,7 @@ func (x *DeleteTrackerByTrackerIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTrackerByTrackerIdRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTrackerByTrackerIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{46}
+	return file_proto_db_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *DeleteTrackerByTrackerIdRequest) GetTrackerId() string {
@@ -2352,7 +2642,7 @@ type DeleteTrackerByTrackerIdResponse struct {
 
 func (x *DeleteTrackerByTrackerIdResponse) Reset() {
 	*x = DeleteTrackerByTrackerIdResponse{}
-	mi := &file_proto_db_proto_msgTypes[47]
+	mi := &file_proto_db_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2364,7 +2654,7 @@ func (x *DeleteTrackerByTrackerIdResponse) String() string {
 func (*DeleteTrackerByTrackerIdResponse) ProtoMessage() {}
 
 func (x *DeleteTrackerByTrackerIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_proto_msgTypes[47]
+	mi := &file_proto_db_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2377,7 +2667,7 @@ func (x *DeleteTrackerByTrackerIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTrackerByTrackerIdResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTrackerByTrackerIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_db_proto_rawDescGZIP(), []int{47}
+	return file_proto_db_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *DeleteTrackerByTrackerIdResponse) GetMessage() string {
@@ -2472,7 +2762,26 @@ const file_proto_db_proto_rawDesc = "" +
 	"\apackage\x18\x01 \x01(\tR\apackage\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\"R\n" +
 	"#GetFavoriteGroupsByFavoriteResponse\x12+\n" +
-	"\x06groups\x18\x01 \x03(\v2\x13.miru.FavoriteGroupR\x06groups\"`\n" +
+	"\x06groups\x18\x01 \x03(\v2\x13.miru.FavoriteGroupR\x06groups\"e\n" +
+	"$SetFavoriteGroupCheckIntervalRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\aminutes\x18\x02 \x01(\x05H\x00R\aminutes\x88\x01\x01B\n" +
+	"\n" +
+	"\b_minutes\"A\n" +
+	"%SetFavoriteGroupCheckIntervalResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"S\n" +
+	"\x18GetEpisodeUpdatesRequest\x12\x18\n" +
+	"\apackage\x18\x01 \x01(\tR\apackage\x12\x1d\n" +
+	"\n" +
+	"detail_url\x18\x02 \x01(\tR\tdetailUrl\"L\n" +
+	"\x19GetEpisodeUpdatesResponse\x12/\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x13.miru.EpisodeUpdateR\bepisodes\"U\n" +
+	"\x1aClearEpisodeUpdatesRequest\x12\x18\n" +
+	"\apackage\x18\x01 \x01(\tR\apackage\x12\x1d\n" +
+	"\n" +
+	"detail_url\x18\x02 \x01(\tR\tdetailUrl\"7\n" +
+	"\x1bClearEpisodeUpdatesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"`\n" +
 	"\x19GetHistoriesByTypeRequest\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
@@ -2539,7 +2848,7 @@ const file_proto_db_proto_rawDesc = "" +
 	"tracker_id\x18\x01 \x01(\tR\ttrackerId\x12\x1a\n" +
 	"\bprovider\x18\x02 \x01(\tR\bprovider\"<\n" +
 	" DeleteTrackerByTrackerIdResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xaa\x10\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xd6\x12\n" +
 	"\tDbService\x12<\n" +
 	"\tGetDetail\x12\x16.miru.GetDetailRequest\x1a\x17.miru.GetDetailResponse\x12E\n" +
 	"\fUpsertDetail\x12\x19.miru.UpsertDetailRequest\x1a\x1a.miru.UpsertDetailResponse\x12H\n" +
@@ -2556,7 +2865,10 @@ const file_proto_db_proto_rawDesc = "" +
 	"\x10PutFavoriteGroup\x12\x1d.miru.PutFavoriteGroupRequest\x1a\x1e.miru.PutFavoriteGroupResponse\x12Z\n" +
 	"\x13RenameFavoriteGroup\x12 .miru.RenameFavoriteGroupRequest\x1a!.miru.RenameFavoriteGroupResponse\x12Z\n" +
 	"\x13DeleteFavoriteGroup\x12 .miru.DeleteFavoriteGroupRequest\x1a!.miru.DeleteFavoriteGroupResponse\x12r\n" +
-	"\x1bGetFavoriteGroupsByFavorite\x12(.miru.GetFavoriteGroupsByFavoriteRequest\x1a).miru.GetFavoriteGroupsByFavoriteResponse\x12W\n" +
+	"\x1bGetFavoriteGroupsByFavorite\x12(.miru.GetFavoriteGroupsByFavoriteRequest\x1a).miru.GetFavoriteGroupsByFavoriteResponse\x12x\n" +
+	"\x1dSetFavoriteGroupCheckInterval\x12*.miru.SetFavoriteGroupCheckIntervalRequest\x1a+.miru.SetFavoriteGroupCheckIntervalResponse\x12T\n" +
+	"\x11GetEpisodeUpdates\x12\x1e.miru.GetEpisodeUpdatesRequest\x1a\x1f.miru.GetEpisodeUpdatesResponse\x12Z\n" +
+	"\x13ClearEpisodeUpdates\x12 .miru.ClearEpisodeUpdatesRequest\x1a!.miru.ClearEpisodeUpdatesResponse\x12W\n" +
 	"\x12GetHistoriesByType\x12\x1f.miru.GetHistoriesByTypeRequest\x1a .miru.GetHistoriesByTypeResponse\x12~\n" +
 	"\x1fGetHistoryByPackageAndDetailUrl\x12,.miru.GetHistoryByPackageAndDetailUrlRequest\x1a-.miru.GetHistoryByPackageAndDetailUrlResponse\x12?\n" +
 	"\n" +
@@ -2579,7 +2891,7 @@ func file_proto_db_proto_rawDescGZIP() []byte {
 	return file_proto_db_proto_rawDescData
 }
 
-var file_proto_db_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_proto_db_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_proto_db_proto_goTypes = []any{
 	(*GetDetailRequest)(nil),                        // 0: miru.GetDetailRequest
 	(*GetDetailResponse)(nil),                       // 1: miru.GetDetailResponse
@@ -2607,110 +2919,124 @@ var file_proto_db_proto_goTypes = []any{
 	(*DeleteFavoriteGroupResponse)(nil),             // 23: miru.DeleteFavoriteGroupResponse
 	(*GetFavoriteGroupsByFavoriteRequest)(nil),      // 24: miru.GetFavoriteGroupsByFavoriteRequest
 	(*GetFavoriteGroupsByFavoriteResponse)(nil),     // 25: miru.GetFavoriteGroupsByFavoriteResponse
-	(*GetHistoriesByTypeRequest)(nil),               // 26: miru.GetHistoriesByTypeRequest
-	(*GetHistoriesByTypeResponse)(nil),              // 27: miru.GetHistoriesByTypeResponse
-	(*PutHistoryRequest)(nil),                       // 28: miru.PutHistoryRequest
-	(*PutHistoryResponse)(nil),                      // 29: miru.PutHistoryResponse
-	(*GetHistoryByPackageAndDetailUrlRequest)(nil),  // 30: miru.GetHistoryByPackageAndDetailUrlRequest
-	(*GetHistoryByPackageAndDetailUrlResponse)(nil), // 31: miru.GetHistoryByPackageAndDetailUrlResponse
-	(*DeleteHistoryByPackageAndUrlRequest)(nil),     // 32: miru.DeleteHistoryByPackageAndUrlRequest
-	(*DeleteHistoryByPackageAndUrlResponse)(nil),    // 33: miru.DeleteHistoryByPackageAndUrlResponse
-	(*DeleteAllHistoryRequest)(nil),                 // 34: miru.DeleteAllHistoryRequest
-	(*DeleteAllHistoryResponse)(nil),                // 35: miru.DeleteAllHistoryResponse
-	(*GetHistorysFilteredRequest)(nil),              // 36: miru.GetHistorysFilteredRequest
-	(*GetHistorysFilteredResponse)(nil),             // 37: miru.GetHistorysFilteredResponse
-	(*GetTrackRequest)(nil),                         // 38: miru.GetTrackRequest
-	(*GetTrackResponse)(nil),                        // 39: miru.GetTrackResponse
-	(*PutTrackRequest)(nil),                         // 40: miru.PutTrackRequest
-	(*PutTrackResponse)(nil),                        // 41: miru.PutTrackResponse
-	(*UpsertTrackerRequest)(nil),                    // 42: miru.UpsertTrackerRequest
-	(*UpsertTrackerResponse)(nil),                   // 43: miru.UpsertTrackerResponse
-	(*DeleteTrackerRequest)(nil),                    // 44: miru.DeleteTrackerRequest
-	(*DeleteTrackerResponse)(nil),                   // 45: miru.DeleteTrackerResponse
-	(*DeleteTrackerByTrackerIdRequest)(nil),         // 46: miru.DeleteTrackerByTrackerIdRequest
-	(*DeleteTrackerByTrackerIdResponse)(nil),        // 47: miru.DeleteTrackerByTrackerIdResponse
-	nil,                                             // 48: miru.UpsertDetailRequest.TrackIdsEntry
-	(*Detail)(nil),                                  // 49: miru.Detail
-	(*Favorite)(nil),                                // 50: miru.Favorite
-	(*FavoriteGroup)(nil),                           // 51: miru.FavoriteGroup
-	(*History)(nil),                                 // 52: miru.History
-	(*Track)(nil),                                   // 53: miru.Track
-	(*Tracker)(nil),                                 // 54: miru.Tracker
+	(*SetFavoriteGroupCheckIntervalRequest)(nil),    // 26: miru.SetFavoriteGroupCheckIntervalRequest
+	(*SetFavoriteGroupCheckIntervalResponse)(nil),   // 27: miru.SetFavoriteGroupCheckIntervalResponse
+	(*GetEpisodeUpdatesRequest)(nil),                // 28: miru.GetEpisodeUpdatesRequest
+	(*GetEpisodeUpdatesResponse)(nil),               // 29: miru.GetEpisodeUpdatesResponse
+	(*ClearEpisodeUpdatesRequest)(nil),              // 30: miru.ClearEpisodeUpdatesRequest
+	(*ClearEpisodeUpdatesResponse)(nil),             // 31: miru.ClearEpisodeUpdatesResponse
+	(*GetHistoriesByTypeRequest)(nil),               // 32: miru.GetHistoriesByTypeRequest
+	(*GetHistoriesByTypeResponse)(nil),              // 33: miru.GetHistoriesByTypeResponse
+	(*PutHistoryRequest)(nil),                       // 34: miru.PutHistoryRequest
+	(*PutHistoryResponse)(nil),                      // 35: miru.PutHistoryResponse
+	(*GetHistoryByPackageAndDetailUrlRequest)(nil),  // 36: miru.GetHistoryByPackageAndDetailUrlRequest
+	(*GetHistoryByPackageAndDetailUrlResponse)(nil), // 37: miru.GetHistoryByPackageAndDetailUrlResponse
+	(*DeleteHistoryByPackageAndUrlRequest)(nil),     // 38: miru.DeleteHistoryByPackageAndUrlRequest
+	(*DeleteHistoryByPackageAndUrlResponse)(nil),    // 39: miru.DeleteHistoryByPackageAndUrlResponse
+	(*DeleteAllHistoryRequest)(nil),                 // 40: miru.DeleteAllHistoryRequest
+	(*DeleteAllHistoryResponse)(nil),                // 41: miru.DeleteAllHistoryResponse
+	(*GetHistorysFilteredRequest)(nil),              // 42: miru.GetHistorysFilteredRequest
+	(*GetHistorysFilteredResponse)(nil),             // 43: miru.GetHistorysFilteredResponse
+	(*GetTrackRequest)(nil),                         // 44: miru.GetTrackRequest
+	(*GetTrackResponse)(nil),                        // 45: miru.GetTrackResponse
+	(*PutTrackRequest)(nil),                         // 46: miru.PutTrackRequest
+	(*PutTrackResponse)(nil),                        // 47: miru.PutTrackResponse
+	(*UpsertTrackerRequest)(nil),                    // 48: miru.UpsertTrackerRequest
+	(*UpsertTrackerResponse)(nil),                   // 49: miru.UpsertTrackerResponse
+	(*DeleteTrackerRequest)(nil),                    // 50: miru.DeleteTrackerRequest
+	(*DeleteTrackerResponse)(nil),                   // 51: miru.DeleteTrackerResponse
+	(*DeleteTrackerByTrackerIdRequest)(nil),         // 52: miru.DeleteTrackerByTrackerIdRequest
+	(*DeleteTrackerByTrackerIdResponse)(nil),        // 53: miru.DeleteTrackerByTrackerIdResponse
+	nil,                                             // 54: miru.UpsertDetailRequest.TrackIdsEntry
+	(*Detail)(nil),                                  // 55: miru.Detail
+	(*Favorite)(nil),                                // 56: miru.Favorite
+	(*FavoriteGroup)(nil),                           // 57: miru.FavoriteGroup
+	(*EpisodeUpdate)(nil),                           // 58: miru.EpisodeUpdate
+	(*History)(nil),                                 // 59: miru.History
+	(*Track)(nil),                                   // 60: miru.Track
+	(*Tracker)(nil),                                 // 61: miru.Tracker
 }
 var file_proto_db_proto_depIdxs = []int32{
-	49, // 0: miru.GetDetailResponse.detail:type_name -> miru.Detail
-	48, // 1: miru.UpsertDetailRequest.track_ids:type_name -> miru.UpsertDetailRequest.TrackIdsEntry
-	49, // 2: miru.UpsertDetailResponse.detail:type_name -> miru.Detail
-	50, // 3: miru.GetAllFavoriteResponse.favorites:type_name -> miru.Favorite
-	50, // 4: miru.GetFavoriteByPackageAndUrlResponse.favorite:type_name -> miru.Favorite
-	51, // 5: miru.PutFavoriteByIndexRequest.groups:type_name -> miru.FavoriteGroup
-	50, // 6: miru.PutFavoriteResponse.favorite:type_name -> miru.Favorite
-	51, // 7: miru.GetFavoriteGroupsByIdResponse.groups:type_name -> miru.FavoriteGroup
-	51, // 8: miru.GetAllFavoriteGroupResponse.groups:type_name -> miru.FavoriteGroup
-	51, // 9: miru.PutFavoriteGroupResponse.group:type_name -> miru.FavoriteGroup
-	51, // 10: miru.GetFavoriteGroupsByFavoriteResponse.groups:type_name -> miru.FavoriteGroup
-	52, // 11: miru.GetHistoriesByTypeResponse.histories:type_name -> miru.History
-	52, // 12: miru.PutHistoryRequest.history:type_name -> miru.History
-	52, // 13: miru.PutHistoryResponse.history:type_name -> miru.History
-	52, // 14: miru.GetHistoryByPackageAndDetailUrlResponse.history:type_name -> miru.History
-	52, // 15: miru.GetHistorysFilteredResponse.histories:type_name -> miru.History
-	53, // 16: miru.GetTrackResponse.track:type_name -> miru.Track
-	53, // 17: miru.PutTrackResponse.track:type_name -> miru.Track
-	54, // 18: miru.UpsertTrackerRequest.tracker:type_name -> miru.Tracker
-	54, // 19: miru.UpsertTrackerResponse.tracker:type_name -> miru.Tracker
-	0,  // 20: miru.DbService.GetDetail:input_type -> miru.GetDetailRequest
-	2,  // 21: miru.DbService.UpsertDetail:input_type -> miru.UpsertDetailRequest
-	42, // 22: miru.DbService.UpsertTracker:input_type -> miru.UpsertTrackerRequest
-	44, // 23: miru.DbService.DeleteTracker:input_type -> miru.DeleteTrackerRequest
-	46, // 24: miru.DbService.DeleteTrackerByTrackerId:input_type -> miru.DeleteTrackerByTrackerIdRequest
-	4,  // 25: miru.DbService.GetAllFavorite:input_type -> miru.GetAllFavoriteRequest
-	6,  // 26: miru.DbService.GetFavoriteByPackageAndUrl:input_type -> miru.GetFavoriteByPackageAndUrlRequest
-	8,  // 27: miru.DbService.PutFavoriteByIndex:input_type -> miru.PutFavoriteByIndexRequest
-	10, // 28: miru.DbService.PutFavorite:input_type -> miru.PutFavoriteRequest
-	12, // 29: miru.DbService.DeleteFavorite:input_type -> miru.DeleteFavoriteRequest
-	14, // 30: miru.DbService.GetFavoriteGroupsById:input_type -> miru.GetFavoriteGroupsByIdRequest
-	16, // 31: miru.DbService.GetAllFavoriteGroup:input_type -> miru.GetAllFavoriteGroupRequest
-	18, // 32: miru.DbService.PutFavoriteGroup:input_type -> miru.PutFavoriteGroupRequest
-	20, // 33: miru.DbService.RenameFavoriteGroup:input_type -> miru.RenameFavoriteGroupRequest
-	22, // 34: miru.DbService.DeleteFavoriteGroup:input_type -> miru.DeleteFavoriteGroupRequest
-	24, // 35: miru.DbService.GetFavoriteGroupsByFavorite:input_type -> miru.GetFavoriteGroupsByFavoriteRequest
-	26, // 36: miru.DbService.GetHistoriesByType:input_type -> miru.GetHistoriesByTypeRequest
-	30, // 37: miru.DbService.GetHistoryByPackageAndDetailUrl:input_type -> miru.GetHistoryByPackageAndDetailUrlRequest
-	28, // 38: miru.DbService.PutHistory:input_type -> miru.PutHistoryRequest
-	32, // 39: miru.DbService.DeleteHistoryByPackageAndUrl:input_type -> miru.DeleteHistoryByPackageAndUrlRequest
-	34, // 40: miru.DbService.DeleteAllHistory:input_type -> miru.DeleteAllHistoryRequest
-	36, // 41: miru.DbService.GetHistorysFiltered:input_type -> miru.GetHistorysFilteredRequest
-	38, // 42: miru.DbService.GetTrack:input_type -> miru.GetTrackRequest
-	40, // 43: miru.DbService.PutTrack:input_type -> miru.PutTrackRequest
-	1,  // 44: miru.DbService.GetDetail:output_type -> miru.GetDetailResponse
-	3,  // 45: miru.DbService.UpsertDetail:output_type -> miru.UpsertDetailResponse
-	43, // 46: miru.DbService.UpsertTracker:output_type -> miru.UpsertTrackerResponse
-	45, // 47: miru.DbService.DeleteTracker:output_type -> miru.DeleteTrackerResponse
-	47, // 48: miru.DbService.DeleteTrackerByTrackerId:output_type -> miru.DeleteTrackerByTrackerIdResponse
-	5,  // 49: miru.DbService.GetAllFavorite:output_type -> miru.GetAllFavoriteResponse
-	7,  // 50: miru.DbService.GetFavoriteByPackageAndUrl:output_type -> miru.GetFavoriteByPackageAndUrlResponse
-	9,  // 51: miru.DbService.PutFavoriteByIndex:output_type -> miru.PutFavoriteByIndexResponse
-	11, // 52: miru.DbService.PutFavorite:output_type -> miru.PutFavoriteResponse
-	13, // 53: miru.DbService.DeleteFavorite:output_type -> miru.DeleteFavoriteResponse
-	15, // 54: miru.DbService.GetFavoriteGroupsById:output_type -> miru.GetFavoriteGroupsByIdResponse
-	17, // 55: miru.DbService.GetAllFavoriteGroup:output_type -> miru.GetAllFavoriteGroupResponse
-	19, // 56: miru.DbService.PutFavoriteGroup:output_type -> miru.PutFavoriteGroupResponse
-	21, // 57: miru.DbService.RenameFavoriteGroup:output_type -> miru.RenameFavoriteGroupResponse
-	23, // 58: miru.DbService.DeleteFavoriteGroup:output_type -> miru.DeleteFavoriteGroupResponse
-	25, // 59: miru.DbService.GetFavoriteGroupsByFavorite:output_type -> miru.GetFavoriteGroupsByFavoriteResponse
-	27, // 60: miru.DbService.GetHistoriesByType:output_type -> miru.GetHistoriesByTypeResponse
-	31, // 61: miru.DbService.GetHistoryByPackageAndDetailUrl:output_type -> miru.GetHistoryByPackageAndDetailUrlResponse
-	29, // 62: miru.DbService.PutHistory:output_type -> miru.PutHistoryResponse
-	33, // 63: miru.DbService.DeleteHistoryByPackageAndUrl:output_type -> miru.DeleteHistoryByPackageAndUrlResponse
-	35, // 64: miru.DbService.DeleteAllHistory:output_type -> miru.DeleteAllHistoryResponse
-	37, // 65: miru.DbService.GetHistorysFiltered:output_type -> miru.GetHistorysFilteredResponse
-	39, // 66: miru.DbService.GetTrack:output_type -> miru.GetTrackResponse
-	41, // 67: miru.DbService.PutTrack:output_type -> miru.PutTrackResponse
-	44, // [44:68] is the sub-list for method output_type
-	20, // [20:44] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	55, // 0: miru.GetDetailResponse.detail:type_name -> miru.Detail
+	54, // 1: miru.UpsertDetailRequest.track_ids:type_name -> miru.UpsertDetailRequest.TrackIdsEntry
+	55, // 2: miru.UpsertDetailResponse.detail:type_name -> miru.Detail
+	56, // 3: miru.GetAllFavoriteResponse.favorites:type_name -> miru.Favorite
+	56, // 4: miru.GetFavoriteByPackageAndUrlResponse.favorite:type_name -> miru.Favorite
+	57, // 5: miru.PutFavoriteByIndexRequest.groups:type_name -> miru.FavoriteGroup
+	56, // 6: miru.PutFavoriteResponse.favorite:type_name -> miru.Favorite
+	57, // 7: miru.GetFavoriteGroupsByIdResponse.groups:type_name -> miru.FavoriteGroup
+	57, // 8: miru.GetAllFavoriteGroupResponse.groups:type_name -> miru.FavoriteGroup
+	57, // 9: miru.PutFavoriteGroupResponse.group:type_name -> miru.FavoriteGroup
+	57, // 10: miru.GetFavoriteGroupsByFavoriteResponse.groups:type_name -> miru.FavoriteGroup
+	58, // 11: miru.GetEpisodeUpdatesResponse.episodes:type_name -> miru.EpisodeUpdate
+	59, // 12: miru.GetHistoriesByTypeResponse.histories:type_name -> miru.History
+	59, // 13: miru.PutHistoryRequest.history:type_name -> miru.History
+	59, // 14: miru.PutHistoryResponse.history:type_name -> miru.History
+	59, // 15: miru.GetHistoryByPackageAndDetailUrlResponse.history:type_name -> miru.History
+	59, // 16: miru.GetHistorysFilteredResponse.histories:type_name -> miru.History
+	60, // 17: miru.GetTrackResponse.track:type_name -> miru.Track
+	60, // 18: miru.PutTrackResponse.track:type_name -> miru.Track
+	61, // 19: miru.UpsertTrackerRequest.tracker:type_name -> miru.Tracker
+	61, // 20: miru.UpsertTrackerResponse.tracker:type_name -> miru.Tracker
+	0,  // 21: miru.DbService.GetDetail:input_type -> miru.GetDetailRequest
+	2,  // 22: miru.DbService.UpsertDetail:input_type -> miru.UpsertDetailRequest
+	48, // 23: miru.DbService.UpsertTracker:input_type -> miru.UpsertTrackerRequest
+	50, // 24: miru.DbService.DeleteTracker:input_type -> miru.DeleteTrackerRequest
+	52, // 25: miru.DbService.DeleteTrackerByTrackerId:input_type -> miru.DeleteTrackerByTrackerIdRequest
+	4,  // 26: miru.DbService.GetAllFavorite:input_type -> miru.GetAllFavoriteRequest
+	6,  // 27: miru.DbService.GetFavoriteByPackageAndUrl:input_type -> miru.GetFavoriteByPackageAndUrlRequest
+	8,  // 28: miru.DbService.PutFavoriteByIndex:input_type -> miru.PutFavoriteByIndexRequest
+	10, // 29: miru.DbService.PutFavorite:input_type -> miru.PutFavoriteRequest
+	12, // 30: miru.DbService.DeleteFavorite:input_type -> miru.DeleteFavoriteRequest
+	14, // 31: miru.DbService.GetFavoriteGroupsById:input_type -> miru.GetFavoriteGroupsByIdRequest
+	16, // 32: miru.DbService.GetAllFavoriteGroup:input_type -> miru.GetAllFavoriteGroupRequest
+	18, // 33: miru.DbService.PutFavoriteGroup:input_type -> miru.PutFavoriteGroupRequest
+	20, // 34: miru.DbService.RenameFavoriteGroup:input_type -> miru.RenameFavoriteGroupRequest
+	22, // 35: miru.DbService.DeleteFavoriteGroup:input_type -> miru.DeleteFavoriteGroupRequest
+	24, // 36: miru.DbService.GetFavoriteGroupsByFavorite:input_type -> miru.GetFavoriteGroupsByFavoriteRequest
+	26, // 37: miru.DbService.SetFavoriteGroupCheckInterval:input_type -> miru.SetFavoriteGroupCheckIntervalRequest
+	28, // 38: miru.DbService.GetEpisodeUpdates:input_type -> miru.GetEpisodeUpdatesRequest
+	30, // 39: miru.DbService.ClearEpisodeUpdates:input_type -> miru.ClearEpisodeUpdatesRequest
+	32, // 40: miru.DbService.GetHistoriesByType:input_type -> miru.GetHistoriesByTypeRequest
+	36, // 41: miru.DbService.GetHistoryByPackageAndDetailUrl:input_type -> miru.GetHistoryByPackageAndDetailUrlRequest
+	34, // 42: miru.DbService.PutHistory:input_type -> miru.PutHistoryRequest
+	38, // 43: miru.DbService.DeleteHistoryByPackageAndUrl:input_type -> miru.DeleteHistoryByPackageAndUrlRequest
+	40, // 44: miru.DbService.DeleteAllHistory:input_type -> miru.DeleteAllHistoryRequest
+	42, // 45: miru.DbService.GetHistorysFiltered:input_type -> miru.GetHistorysFilteredRequest
+	44, // 46: miru.DbService.GetTrack:input_type -> miru.GetTrackRequest
+	46, // 47: miru.DbService.PutTrack:input_type -> miru.PutTrackRequest
+	1,  // 48: miru.DbService.GetDetail:output_type -> miru.GetDetailResponse
+	3,  // 49: miru.DbService.UpsertDetail:output_type -> miru.UpsertDetailResponse
+	49, // 50: miru.DbService.UpsertTracker:output_type -> miru.UpsertTrackerResponse
+	51, // 51: miru.DbService.DeleteTracker:output_type -> miru.DeleteTrackerResponse
+	53, // 52: miru.DbService.DeleteTrackerByTrackerId:output_type -> miru.DeleteTrackerByTrackerIdResponse
+	5,  // 53: miru.DbService.GetAllFavorite:output_type -> miru.GetAllFavoriteResponse
+	7,  // 54: miru.DbService.GetFavoriteByPackageAndUrl:output_type -> miru.GetFavoriteByPackageAndUrlResponse
+	9,  // 55: miru.DbService.PutFavoriteByIndex:output_type -> miru.PutFavoriteByIndexResponse
+	11, // 56: miru.DbService.PutFavorite:output_type -> miru.PutFavoriteResponse
+	13, // 57: miru.DbService.DeleteFavorite:output_type -> miru.DeleteFavoriteResponse
+	15, // 58: miru.DbService.GetFavoriteGroupsById:output_type -> miru.GetFavoriteGroupsByIdResponse
+	17, // 59: miru.DbService.GetAllFavoriteGroup:output_type -> miru.GetAllFavoriteGroupResponse
+	19, // 60: miru.DbService.PutFavoriteGroup:output_type -> miru.PutFavoriteGroupResponse
+	21, // 61: miru.DbService.RenameFavoriteGroup:output_type -> miru.RenameFavoriteGroupResponse
+	23, // 62: miru.DbService.DeleteFavoriteGroup:output_type -> miru.DeleteFavoriteGroupResponse
+	25, // 63: miru.DbService.GetFavoriteGroupsByFavorite:output_type -> miru.GetFavoriteGroupsByFavoriteResponse
+	27, // 64: miru.DbService.SetFavoriteGroupCheckInterval:output_type -> miru.SetFavoriteGroupCheckIntervalResponse
+	29, // 65: miru.DbService.GetEpisodeUpdates:output_type -> miru.GetEpisodeUpdatesResponse
+	31, // 66: miru.DbService.ClearEpisodeUpdates:output_type -> miru.ClearEpisodeUpdatesResponse
+	33, // 67: miru.DbService.GetHistoriesByType:output_type -> miru.GetHistoriesByTypeResponse
+	37, // 68: miru.DbService.GetHistoryByPackageAndDetailUrl:output_type -> miru.GetHistoryByPackageAndDetailUrlResponse
+	35, // 69: miru.DbService.PutHistory:output_type -> miru.PutHistoryResponse
+	39, // 70: miru.DbService.DeleteHistoryByPackageAndUrl:output_type -> miru.DeleteHistoryByPackageAndUrlResponse
+	41, // 71: miru.DbService.DeleteAllHistory:output_type -> miru.DeleteAllHistoryResponse
+	43, // 72: miru.DbService.GetHistorysFiltered:output_type -> miru.GetHistorysFilteredResponse
+	45, // 73: miru.DbService.GetTrack:output_type -> miru.GetTrackResponse
+	47, // 74: miru.DbService.PutTrack:output_type -> miru.PutTrackResponse
+	48, // [48:75] is the sub-list for method output_type
+	21, // [21:48] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_proto_db_proto_init() }
@@ -2720,13 +3046,14 @@ func file_proto_db_proto_init() {
 	}
 	file_proto_db_model_proto_init()
 	file_proto_db_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_db_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_db_proto_rawDesc), len(file_proto_db_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   49,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
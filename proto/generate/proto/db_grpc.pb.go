@@ -35,6 +35,9 @@ const (
 	DbService_RenameFavoriteGroup_FullMethodName             = "/miru.DbService/RenameFavoriteGroup"
 	DbService_DeleteFavoriteGroup_FullMethodName             = "/miru.DbService/DeleteFavoriteGroup"
 	DbService_GetFavoriteGroupsByFavorite_FullMethodName     = "/miru.DbService/GetFavoriteGroupsByFavorite"
+	DbService_SetFavoriteGroupCheckInterval_FullMethodName   = "/miru.DbService/SetFavoriteGroupCheckInterval"
+	DbService_GetEpisodeUpdates_FullMethodName               = "/miru.DbService/GetEpisodeUpdates"
+	DbService_ClearEpisodeUpdates_FullMethodName             = "/miru.DbService/ClearEpisodeUpdates"
 	DbService_GetHistoriesByType_FullMethodName              = "/miru.DbService/GetHistoriesByType"
 	DbService_GetHistoryByPackageAndDetailUrl_FullMethodName = "/miru.DbService/GetHistoryByPackageAndDetailUrl"
 	DbService_PutHistory_FullMethodName                      = "/miru.DbService/PutHistory"
@@ -69,6 +72,10 @@ type DbServiceClient interface {
 	RenameFavoriteGroup(ctx context.Context, in *RenameFavoriteGroupRequest, opts ...grpc.CallOption) (*RenameFavoriteGroupResponse, error)
 	DeleteFavoriteGroup(ctx context.Context, in *DeleteFavoriteGroupRequest, opts ...grpc.CallOption) (*DeleteFavoriteGroupResponse, error)
 	GetFavoriteGroupsByFavorite(ctx context.Context, in *GetFavoriteGroupsByFavoriteRequest, opts ...grpc.CallOption) (*GetFavoriteGroupsByFavoriteResponse, error)
+	SetFavoriteGroupCheckInterval(ctx context.Context, in *SetFavoriteGroupCheckIntervalRequest, opts ...grpc.CallOption) (*SetFavoriteGroupCheckIntervalResponse, error)
+	// Episode Update
+	GetEpisodeUpdates(ctx context.Context, in *GetEpisodeUpdatesRequest, opts ...grpc.CallOption) (*GetEpisodeUpdatesResponse, error)
+	ClearEpisodeUpdates(ctx context.Context, in *ClearEpisodeUpdatesRequest, opts ...grpc.CallOption) (*ClearEpisodeUpdatesResponse, error)
 	// History
 	GetHistoriesByType(ctx context.Context, in *GetHistoriesByTypeRequest, opts ...grpc.CallOption) (*GetHistoriesByTypeResponse, error)
 	GetHistoryByPackageAndDetailUrl(ctx context.Context, in *GetHistoryByPackageAndDetailUrlRequest, opts ...grpc.CallOption) (*GetHistoryByPackageAndDetailUrlResponse, error)
@@ -249,6 +256,36 @@ func (c *dbServiceClient) GetFavoriteGroupsByFavorite(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *dbServiceClient) SetFavoriteGroupCheckInterval(ctx context.Context, in *SetFavoriteGroupCheckIntervalRequest, opts ...grpc.CallOption) (*SetFavoriteGroupCheckIntervalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFavoriteGroupCheckIntervalResponse)
+	err := c.cc.Invoke(ctx, DbService_SetFavoriteGroupCheckInterval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dbServiceClient) GetEpisodeUpdates(ctx context.Context, in *GetEpisodeUpdatesRequest, opts ...grpc.CallOption) (*GetEpisodeUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEpisodeUpdatesResponse)
+	err := c.cc.Invoke(ctx, DbService_GetEpisodeUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dbServiceClient) ClearEpisodeUpdates(ctx context.Context, in *ClearEpisodeUpdatesRequest, opts ...grpc.CallOption) (*ClearEpisodeUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearEpisodeUpdatesResponse)
+	err := c.cc.Invoke(ctx, DbService_ClearEpisodeUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dbServiceClient) GetHistoriesByType(ctx context.Context, in *GetHistoriesByTypeRequest, opts ...grpc.CallOption) (*GetHistoriesByTypeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetHistoriesByTypeResponse)
@@ -353,6 +390,10 @@ type DbServiceServer interface {
 	RenameFavoriteGroup(context.Context, *RenameFavoriteGroupRequest) (*RenameFavoriteGroupResponse, error)
 	DeleteFavoriteGroup(context.Context, *DeleteFavoriteGroupRequest) (*DeleteFavoriteGroupResponse, error)
 	GetFavoriteGroupsByFavorite(context.Context, *GetFavoriteGroupsByFavoriteRequest) (*GetFavoriteGroupsByFavoriteResponse, error)
+	SetFavoriteGroupCheckInterval(context.Context, *SetFavoriteGroupCheckIntervalRequest) (*SetFavoriteGroupCheckIntervalResponse, error)
+	// Episode Update
+	GetEpisodeUpdates(context.Context, *GetEpisodeUpdatesRequest) (*GetEpisodeUpdatesResponse, error)
+	ClearEpisodeUpdates(context.Context, *ClearEpisodeUpdatesRequest) (*ClearEpisodeUpdatesResponse, error)
 	// History
 	GetHistoriesByType(context.Context, *GetHistoriesByTypeRequest) (*GetHistoriesByTypeResponse, error)
 	GetHistoryByPackageAndDetailUrl(context.Context, *GetHistoryByPackageAndDetailUrlRequest) (*GetHistoryByPackageAndDetailUrlResponse, error)
@@ -421,6 +462,15 @@ func (UnimplementedDbServiceServer) DeleteFavoriteGroup(context.Context, *Delete
 func (UnimplementedDbServiceServer) GetFavoriteGroupsByFavorite(context.Context, *GetFavoriteGroupsByFavoriteRequest) (*GetFavoriteGroupsByFavoriteResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFavoriteGroupsByFavorite not implemented")
 }
+func (UnimplementedDbServiceServer) SetFavoriteGroupCheckInterval(context.Context, *SetFavoriteGroupCheckIntervalRequest) (*SetFavoriteGroupCheckIntervalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFavoriteGroupCheckInterval not implemented")
+}
+func (UnimplementedDbServiceServer) GetEpisodeUpdates(context.Context, *GetEpisodeUpdatesRequest) (*GetEpisodeUpdatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEpisodeUpdates not implemented")
+}
+func (UnimplementedDbServiceServer) ClearEpisodeUpdates(context.Context, *ClearEpisodeUpdatesRequest) (*ClearEpisodeUpdatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearEpisodeUpdates not implemented")
+}
 func (UnimplementedDbServiceServer) GetHistoriesByType(context.Context, *GetHistoriesByTypeRequest) (*GetHistoriesByTypeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetHistoriesByType not implemented")
 }
@@ -754,6 +804,60 @@ func _DbService_GetFavoriteGroupsByFavorite_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DbService_SetFavoriteGroupCheckInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavoriteGroupCheckIntervalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DbServiceServer).SetFavoriteGroupCheckInterval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DbService_SetFavoriteGroupCheckInterval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DbServiceServer).SetFavoriteGroupCheckInterval(ctx, req.(*SetFavoriteGroupCheckIntervalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DbService_GetEpisodeUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpisodeUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DbServiceServer).GetEpisodeUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DbService_GetEpisodeUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DbServiceServer).GetEpisodeUpdates(ctx, req.(*GetEpisodeUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DbService_ClearEpisodeUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearEpisodeUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DbServiceServer).ClearEpisodeUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DbService_ClearEpisodeUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DbServiceServer).ClearEpisodeUpdates(ctx, req.(*ClearEpisodeUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DbService_GetHistoriesByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetHistoriesByTypeRequest)
 	if err := dec(in); err != nil {
@@ -969,6 +1073,18 @@ var DbService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFavoriteGroupsByFavorite",
 			Handler:    _DbService_GetFavoriteGroupsByFavorite_Handler,
 		},
+		{
+			MethodName: "SetFavoriteGroupCheckInterval",
+			Handler:    _DbService_SetFavoriteGroupCheckInterval_Handler,
+		},
+		{
+			MethodName: "GetEpisodeUpdates",
+			Handler:    _DbService_GetEpisodeUpdates_Handler,
+		},
+		{
+			MethodName: "ClearEpisodeUpdates",
+			Handler:    _DbService_ClearEpisodeUpdates_Handler,
+		},
 		{
 			MethodName: "GetHistoriesByType",
 			Handler:    _DbService_GetHistoriesByType_Handler,
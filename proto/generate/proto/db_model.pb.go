@@ -350,11 +350,14 @@ func (x *Favorite) GetDate() string {
 }
 
 type FavoriteGroup struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"` // ISO8601
-	Favorites     []*Favorite            `protobuf:"bytes,4,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Date      string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"` // ISO8601
+	Favorites []*Favorite            `protobuf:"bytes,4,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	// Minutes between checks for new episodes, 0 never checks and unset uses
+	// the default
+	CheckInterval *int32 `protobuf:"varint,5,opt,name=check_interval,json=checkInterval,proto3,oneof" json:"check_interval,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -417,6 +420,114 @@ func (x *FavoriteGroup) GetFavorites() []*Favorite {
 	return nil
 }
 
+func (x *FavoriteGroup) GetCheckInterval() int32 {
+	if x != nil && x.CheckInterval != nil {
+		return *x.CheckInterval
+	}
+	return 0
+}
+
+// An episode of a favorite found by the favorite checker
+type EpisodeUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Package       string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	DetailUrl     string                 `protobuf:"bytes,3,opt,name=detail_url,json=detailUrl,proto3" json:"detail_url,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	GroupTitle    string                 `protobuf:"bytes,5,opt,name=group_title,json=groupTitle,proto3" json:"group_title,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	Date          string                 `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"` // ISO8601
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EpisodeUpdate) Reset() {
+	*x = EpisodeUpdate{}
+	mi := &file_proto_db_model_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpisodeUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpisodeUpdate) ProtoMessage() {}
+
+func (x *EpisodeUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_db_model_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpisodeUpdate.ProtoReflect.Descriptor instead.
+func (*EpisodeUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_db_model_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EpisodeUpdate) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EpisodeUpdate) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *EpisodeUpdate) GetDetailUrl() string {
+	if x != nil {
+		return x.DetailUrl
+	}
+	return ""
+}
+
+func (x *EpisodeUpdate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EpisodeUpdate) GetGroupTitle() string {
+	if x != nil {
+		return x.GroupTitle
+	}
+	return ""
+}
+
+func (x *EpisodeUpdate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EpisodeUpdate) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EpisodeUpdate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
 // History
 type History struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -439,7 +550,7 @@ type History struct {
 
 func (x *History) Reset() {
 	*x = History{}
-	mi := &file_proto_db_model_proto_msgTypes[4]
+	mi := &file_proto_db_model_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -451,7 +562,7 @@ func (x *History) String() string {
 func (*History) ProtoMessage() {}
 
 func (x *History) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_model_proto_msgTypes[4]
+	mi := &file_proto_db_model_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +575,7 @@ func (x *History) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use History.ProtoReflect.Descriptor instead.
 func (*History) Descriptor() ([]byte, []int) {
-	return file_proto_db_model_proto_rawDescGZIP(), []int{4}
+	return file_proto_db_model_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *History) GetId() int32 {
@@ -572,7 +683,7 @@ type Track struct {
 
 func (x *Track) Reset() {
 	*x = Track{}
-	mi := &file_proto_db_model_proto_msgTypes[5]
+	mi := &file_proto_db_model_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +695,7 @@ func (x *Track) String() string {
 func (*Track) ProtoMessage() {}
 
 func (x *Track) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_db_model_proto_msgTypes[5]
+	mi := &file_proto_db_model_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +708,7 @@ func (x *Track) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Track.ProtoReflect.Descriptor instead.
 func (*Track) Descriptor() ([]byte, []int) {
-	return file_proto_db_model_proto_rawDescGZIP(), []int{5}
+	return file_proto_db_model_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Track) GetId() int32 {
@@ -689,12 +800,25 @@ const file_proto_db_model_proto_rawDesc = "" +
 	"\x04type\x18\x04 \x01(\tR\x04type\x12\x14\n" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12\x14\n" +
 	"\x05cover\x18\x06 \x01(\tR\x05cover\x12\x12\n" +
-	"\x04date\x18\a \x01(\tR\x04date\"u\n" +
+	"\x04date\x18\a \x01(\tR\x04date\"\xb4\x01\n" +
 	"\rFavoriteGroup\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04date\x18\x03 \x01(\tR\x04date\x12,\n" +
-	"\tfavorites\x18\x04 \x03(\v2\x0e.miru.FavoriteR\tfavorites\"\xe9\x02\n" +
+	"\tfavorites\x18\x04 \x03(\v2\x0e.miru.FavoriteR\tfavorites\x12*\n" +
+	"\x0echeck_interval\x18\x05 \x01(\x05H\x00R\rcheckInterval\x88\x01\x01B\x11\n" +
+	"\x0f_check_interval\"\xc9\x01\n" +
+	"\rEpisodeUpdate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\apackage\x18\x02 \x01(\tR\apackage\x12\x1d\n" +
+	"\n" +
+	"detail_url\x18\x03 \x01(\tR\tdetailUrl\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1f\n" +
+	"\vgroup_title\x18\x05 \x01(\tR\n" +
+	"groupTitle\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12\x12\n" +
+	"\x04date\x18\b \x01(\tR\x04date\"\xe9\x02\n" +
 	"\aHistory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\apackage\x18\x02 \x01(\tR\apackage\x12\x10\n" +
@@ -733,18 +857,19 @@ func file_proto_db_model_proto_rawDescGZIP() []byte {
 	return file_proto_db_model_proto_rawDescData
 }
 
-var file_proto_db_model_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_db_model_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_proto_db_model_proto_goTypes = []any{
 	(*Detail)(nil),        // 0: miru.Detail
 	(*Tracker)(nil),       // 1: miru.Tracker
 	(*Favorite)(nil),      // 2: miru.Favorite
 	(*FavoriteGroup)(nil), // 3: miru.FavoriteGroup
-	(*History)(nil),       // 4: miru.History
-	(*Track)(nil),         // 5: miru.Track
-	nil,                   // 6: miru.Detail.TrackIdsEntry
+	(*EpisodeUpdate)(nil), // 4: miru.EpisodeUpdate
+	(*History)(nil),       // 5: miru.History
+	(*Track)(nil),         // 6: miru.Track
+	nil,                   // 7: miru.Detail.TrackIdsEntry
 }
 var file_proto_db_model_proto_depIdxs = []int32{
-	6, // 0: miru.Detail.track_ids:type_name -> miru.Detail.TrackIdsEntry
+	7, // 0: miru.Detail.track_ids:type_name -> miru.Detail.TrackIdsEntry
 	1, // 1: miru.Detail.trackers:type_name -> miru.Tracker
 	2, // 2: miru.FavoriteGroup.favorites:type_name -> miru.Favorite
 	3, // [3:3] is the sub-list for method output_type
@@ -761,13 +886,14 @@ func file_proto_db_model_proto_init() {
 	}
 	file_proto_db_model_proto_msgTypes[0].OneofWrappers = []any{}
 	file_proto_db_model_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_db_model_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_db_model_proto_rawDesc), len(file_proto_db_model_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
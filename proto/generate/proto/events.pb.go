@@ -64,6 +64,8 @@ type WatchEventsResponse struct {
 	//	*WatchEventsResponse_DownloadEvent
 	//	*WatchEventsResponse_ExtensionEvent
 	//	*WatchEventsResponse_HistoryEvent
+	//	*WatchEventsResponse_ExtensionUpdatesEvent
+	//	*WatchEventsResponse_NewEpisodesEvent
 	Event         isWatchEventsResponse_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -133,6 +135,24 @@ func (x *WatchEventsResponse) GetHistoryEvent() *HistoryEvent {
 	return nil
 }
 
+func (x *WatchEventsResponse) GetExtensionUpdatesEvent() *ExtensionUpdatesEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_ExtensionUpdatesEvent); ok {
+			return x.ExtensionUpdatesEvent
+		}
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetNewEpisodesEvent() *NewEpisodesEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_NewEpisodesEvent); ok {
+			return x.NewEpisodesEvent
+		}
+	}
+	return nil
+}
+
 type isWatchEventsResponse_Event interface {
 	isWatchEventsResponse_Event()
 }
@@ -149,12 +169,24 @@ type WatchEventsResponse_HistoryEvent struct {
 	HistoryEvent *HistoryEvent `protobuf:"bytes,3,opt,name=history_event,json=historyEvent,proto3,oneof"`
 }
 
+type WatchEventsResponse_ExtensionUpdatesEvent struct {
+	ExtensionUpdatesEvent *ExtensionUpdatesEvent `protobuf:"bytes,4,opt,name=extension_updates_event,json=extensionUpdatesEvent,proto3,oneof"`
+}
+
+type WatchEventsResponse_NewEpisodesEvent struct {
+	NewEpisodesEvent *NewEpisodesEvent `protobuf:"bytes,5,opt,name=new_episodes_event,json=newEpisodesEvent,proto3,oneof"`
+}
+
 func (*WatchEventsResponse_DownloadEvent) isWatchEventsResponse_Event() {}
 
 func (*WatchEventsResponse_ExtensionEvent) isWatchEventsResponse_Event() {}
 
 func (*WatchEventsResponse_HistoryEvent) isWatchEventsResponse_Event() {}
 
+func (*WatchEventsResponse_ExtensionUpdatesEvent) isWatchEventsResponse_Event() {}
+
+func (*WatchEventsResponse_NewEpisodesEvent) isWatchEventsResponse_Event() {}
+
 type DownloadEvent struct {
 	state          protoimpl.MessageState      `protogen:"open.v1"`
 	DownloadStatus map[int32]*DownloadProgress `protobuf:"bytes,1,rep,name=download_status,json=downloadStatus,proto3" json:"download_status,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -287,16 +319,106 @@ func (x *HistoryEvent) GetHistory() []*History {
 	return nil
 }
 
+type ExtensionUpdatesEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updates       []*ExtensionUpdateInfo `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtensionUpdatesEvent) Reset() {
+	*x = ExtensionUpdatesEvent{}
+	mi := &file_proto_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionUpdatesEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionUpdatesEvent) ProtoMessage() {}
+
+func (x *ExtensionUpdatesEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionUpdatesEvent.ProtoReflect.Descriptor instead.
+func (*ExtensionUpdatesEvent) Descriptor() ([]byte, []int) {
+	return file_proto_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExtensionUpdatesEvent) GetUpdates() []*ExtensionUpdateInfo {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type NewEpisodesEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*EpisodeUpdate       `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewEpisodesEvent) Reset() {
+	*x = NewEpisodesEvent{}
+	mi := &file_proto_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewEpisodesEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewEpisodesEvent) ProtoMessage() {}
+
+func (x *NewEpisodesEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewEpisodesEvent.ProtoReflect.Descriptor instead.
+func (*NewEpisodesEvent) Descriptor() ([]byte, []int) {
+	return file_proto_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NewEpisodesEvent) GetEpisodes() []*EpisodeUpdate {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
 var File_proto_events_proto protoreflect.FileDescriptor
 
 const file_proto_events_proto_rawDesc = "" +
 	"\n" +
-	"\x12proto/events.proto\x12\x04miru\x1a\x12proto/common.proto\x1a\x14proto/db_model.proto\"\x14\n" +
-	"\x12WatchEventsRequest\"\xd8\x01\n" +
+	"\x12proto/events.proto\x12\x04miru\x1a\x12proto/common.proto\x1a\x14proto/db_model.proto\x1a\x15proto/extension.proto\"\x14\n" +
+	"\x12WatchEventsRequest\"\xf7\x02\n" +
 	"\x13WatchEventsResponse\x12<\n" +
 	"\x0edownload_event\x18\x01 \x01(\v2\x13.miru.DownloadEventH\x00R\rdownloadEvent\x12?\n" +
 	"\x0fextension_event\x18\x02 \x01(\v2\x14.miru.ExtensionEventH\x00R\x0eextensionEvent\x129\n" +
-	"\rhistory_event\x18\x03 \x01(\v2\x12.miru.HistoryEventH\x00R\fhistoryEventB\a\n" +
+	"\rhistory_event\x18\x03 \x01(\v2\x12.miru.HistoryEventH\x00R\fhistoryEvent\x12U\n" +
+	"\x17extension_updates_event\x18\x04 \x01(\v2\x1b.miru.ExtensionUpdatesEventH\x00R\x15extensionUpdatesEvent\x12F\n" +
+	"\x12new_episodes_event\x18\x05 \x01(\v2\x16.miru.NewEpisodesEventH\x00R\x10newEpisodesEventB\a\n" +
 	"\x05event\"\xbc\x01\n" +
 	"\rDownloadEvent\x12P\n" +
 	"\x0fdownload_status\x18\x01 \x03(\v2'.miru.DownloadEvent.DownloadStatusEntryR\x0edownloadStatus\x1aY\n" +
@@ -306,7 +428,11 @@ const file_proto_events_proto_rawDesc = "" +
 	"\x0eExtensionEvent\x12:\n" +
 	"\x0eextension_meta\x18\x01 \x03(\v2\x13.miru.ExtensionMetaR\rextensionMeta\"7\n" +
 	"\fHistoryEvent\x12'\n" +
-	"\ahistory\x18\x01 \x03(\v2\r.miru.HistoryR\ahistory2T\n" +
+	"\ahistory\x18\x01 \x03(\v2\r.miru.HistoryR\ahistory\"L\n" +
+	"\x15ExtensionUpdatesEvent\x123\n" +
+	"\aupdates\x18\x01 \x03(\v2\x19.miru.ExtensionUpdateInfoR\aupdates\"C\n" +
+	"\x10NewEpisodesEvent\x12/\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x13.miru.EpisodeUpdateR\bepisodes2T\n" +
 	"\fEventService\x12D\n" +
 	"\vWatchEvents\x12\x18.miru.WatchEventsRequest\x1a\x19.miru.WatchEventsResponse0\x01B)Z'github.com/miru-project/miru-core/protob\x06proto3"
 
@@ -322,33 +448,41 @@ func file_proto_events_proto_rawDescGZIP() []byte {
 	return file_proto_events_proto_rawDescData
 }
 
-var file_proto_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_proto_events_proto_goTypes = []any{
-	(*WatchEventsRequest)(nil),  // 0: miru.WatchEventsRequest
-	(*WatchEventsResponse)(nil), // 1: miru.WatchEventsResponse
-	(*DownloadEvent)(nil),       // 2: miru.DownloadEvent
-	(*ExtensionEvent)(nil),      // 3: miru.ExtensionEvent
-	(*HistoryEvent)(nil),        // 4: miru.HistoryEvent
-	nil,                         // 5: miru.DownloadEvent.DownloadStatusEntry
-	(*ExtensionMeta)(nil),       // 6: miru.ExtensionMeta
-	(*History)(nil),             // 7: miru.History
-	(*DownloadProgress)(nil),    // 8: miru.DownloadProgress
+	(*WatchEventsRequest)(nil),    // 0: miru.WatchEventsRequest
+	(*WatchEventsResponse)(nil),   // 1: miru.WatchEventsResponse
+	(*DownloadEvent)(nil),         // 2: miru.DownloadEvent
+	(*ExtensionEvent)(nil),        // 3: miru.ExtensionEvent
+	(*HistoryEvent)(nil),          // 4: miru.HistoryEvent
+	(*ExtensionUpdatesEvent)(nil), // 5: miru.ExtensionUpdatesEvent
+	(*NewEpisodesEvent)(nil),      // 6: miru.NewEpisodesEvent
+	nil,                           // 7: miru.DownloadEvent.DownloadStatusEntry
+	(*ExtensionMeta)(nil),         // 8: miru.ExtensionMeta
+	(*History)(nil),               // 9: miru.History
+	(*ExtensionUpdateInfo)(nil),   // 10: miru.ExtensionUpdateInfo
+	(*EpisodeUpdate)(nil),         // 11: miru.EpisodeUpdate
+	(*DownloadProgress)(nil),      // 12: miru.DownloadProgress
 }
 var file_proto_events_proto_depIdxs = []int32{
-	2, // 0: miru.WatchEventsResponse.download_event:type_name -> miru.DownloadEvent
-	3, // 1: miru.WatchEventsResponse.extension_event:type_name -> miru.ExtensionEvent
-	4, // 2: miru.WatchEventsResponse.history_event:type_name -> miru.HistoryEvent
-	5, // 3: miru.DownloadEvent.download_status:type_name -> miru.DownloadEvent.DownloadStatusEntry
-	6, // 4: miru.ExtensionEvent.extension_meta:type_name -> miru.ExtensionMeta
-	7, // 5: miru.HistoryEvent.history:type_name -> miru.History
-	8, // 6: miru.DownloadEvent.DownloadStatusEntry.value:type_name -> miru.DownloadProgress
-	0, // 7: miru.EventService.WatchEvents:input_type -> miru.WatchEventsRequest
-	1, // 8: miru.EventService.WatchEvents:output_type -> miru.WatchEventsResponse
-	8, // [8:9] is the sub-list for method output_type
-	7, // [7:8] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2,  // 0: miru.WatchEventsResponse.download_event:type_name -> miru.DownloadEvent
+	3,  // 1: miru.WatchEventsResponse.extension_event:type_name -> miru.ExtensionEvent
+	4,  // 2: miru.WatchEventsResponse.history_event:type_name -> miru.HistoryEvent
+	5,  // 3: miru.WatchEventsResponse.extension_updates_event:type_name -> miru.ExtensionUpdatesEvent
+	6,  // 4: miru.WatchEventsResponse.new_episodes_event:type_name -> miru.NewEpisodesEvent
+	7,  // 5: miru.DownloadEvent.download_status:type_name -> miru.DownloadEvent.DownloadStatusEntry
+	8,  // 6: miru.ExtensionEvent.extension_meta:type_name -> miru.ExtensionMeta
+	9,  // 7: miru.HistoryEvent.history:type_name -> miru.History
+	10, // 8: miru.ExtensionUpdatesEvent.updates:type_name -> miru.ExtensionUpdateInfo
+	11, // 9: miru.NewEpisodesEvent.episodes:type_name -> miru.EpisodeUpdate
+	12, // 10: miru.DownloadEvent.DownloadStatusEntry.value:type_name -> miru.DownloadProgress
+	0,  // 11: miru.EventService.WatchEvents:input_type -> miru.WatchEventsRequest
+	1,  // 12: miru.EventService.WatchEvents:output_type -> miru.WatchEventsResponse
+	12, // [12:13] is the sub-list for method output_type
+	11, // [11:12] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_proto_events_proto_init() }
@@ -358,10 +492,13 @@ func file_proto_events_proto_init() {
 	}
 	file_proto_common_proto_init()
 	file_proto_db_model_proto_init()
+	file_proto_extension_proto_init()
 	file_proto_events_proto_msgTypes[1].OneofWrappers = []any{
 		(*WatchEventsResponse_DownloadEvent)(nil),
 		(*WatchEventsResponse_ExtensionEvent)(nil),
 		(*WatchEventsResponse_HistoryEvent)(nil),
+		(*WatchEventsResponse_ExtensionUpdatesEvent)(nil),
+		(*WatchEventsResponse_NewEpisodesEvent)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -369,7 +506,7 @@ func file_proto_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_events_proto_rawDesc), len(file_proto_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -325,6 +325,295 @@ func (x *LatestResponse) GetItems() []*ExtensionListItem {
 	return nil
 }
 
+type PopularRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PopularRequest) Reset() {
+	*x = PopularRequest{}
+	mi := &file_proto_extension_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopularRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopularRequest) ProtoMessage() {}
+
+func (x *PopularRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopularRequest.ProtoReflect.Descriptor instead.
+func (*PopularRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PopularRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *PopularRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type PopularResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ExtensionListItem   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PopularResponse) Reset() {
+	*x = PopularResponse{}
+	mi := &file_proto_extension_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopularResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopularResponse) ProtoMessage() {}
+
+func (x *PopularResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopularResponse.ProtoReflect.Descriptor instead.
+func (*PopularResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PopularResponse) GetItems() []*ExtensionListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type TagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsRequest) Reset() {
+	*x = TagsRequest{}
+	mi := &file_proto_extension_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsRequest) ProtoMessage() {}
+
+func (x *TagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsRequest.ProtoReflect.Descriptor instead.
+func (*TagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TagsRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *TagsRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type TagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsResponse) Reset() {
+	*x = TagsResponse{}
+	mi := &file_proto_extension_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsResponse) ProtoMessage() {}
+
+func (x *TagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsResponse.ProtoReflect.Descriptor instead.
+func (*TagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// What is new about the item at url, e.g. its latest episode
+type CheckUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUpdateRequest) Reset() {
+	*x = CheckUpdateRequest{}
+	mi := &file_proto_extension_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUpdateRequest) ProtoMessage() {}
+
+func (x *CheckUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CheckUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckUpdateRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *CheckUpdateRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CheckUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Update        string                 `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUpdateResponse) Reset() {
+	*x = CheckUpdateResponse{}
+	mi := &file_proto_extension_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUpdateResponse) ProtoMessage() {}
+
+func (x *CheckUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUpdateResponse.ProtoReflect.Descriptor instead.
+func (*CheckUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CheckUpdateResponse) GetUpdate() string {
+	if x != nil {
+		return x.Update
+	}
+	return ""
+}
+
 type DetailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
@@ -335,7 +624,7 @@ type DetailRequest struct {
 
 func (x *DetailRequest) Reset() {
 	*x = DetailRequest{}
-	mi := &file_proto_extension_proto_msgTypes[6]
+	mi := &file_proto_extension_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +636,7 @@ func (x *DetailRequest) String() string {
 func (*DetailRequest) ProtoMessage() {}
 
 func (x *DetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[6]
+	mi := &file_proto_extension_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +649,7 @@ func (x *DetailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetailRequest.ProtoReflect.Descriptor instead.
 func (*DetailRequest) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{6}
+	return file_proto_extension_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DetailRequest) GetPkg() string {
@@ -386,7 +675,7 @@ type DetailResponse struct {
 
 func (x *DetailResponse) Reset() {
 	*x = DetailResponse{}
-	mi := &file_proto_extension_proto_msgTypes[7]
+	mi := &file_proto_extension_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +687,7 @@ func (x *DetailResponse) String() string {
 func (*DetailResponse) ProtoMessage() {}
 
 func (x *DetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[7]
+	mi := &file_proto_extension_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +700,7 @@ func (x *DetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetailResponse.ProtoReflect.Descriptor instead.
 func (*DetailResponse) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{7}
+	return file_proto_extension_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DetailResponse) GetData() *ExtensionDetail {
@@ -431,7 +720,7 @@ type WatchRequest struct {
 
 func (x *WatchRequest) Reset() {
 	*x = WatchRequest{}
-	mi := &file_proto_extension_proto_msgTypes[8]
+	mi := &file_proto_extension_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -443,7 +732,7 @@ func (x *WatchRequest) String() string {
 func (*WatchRequest) ProtoMessage() {}
 
 func (x *WatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[8]
+	mi := &file_proto_extension_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +745,7 @@ func (x *WatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{8}
+	return file_proto_extension_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WatchRequest) GetPkg() string {
@@ -483,7 +772,7 @@ type MirrorRequest struct {
 
 func (x *MirrorRequest) Reset() {
 	*x = MirrorRequest{}
-	mi := &file_proto_extension_proto_msgTypes[9]
+	mi := &file_proto_extension_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +784,7 @@ func (x *MirrorRequest) String() string {
 func (*MirrorRequest) ProtoMessage() {}
 
 func (x *MirrorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[9]
+	mi := &file_proto_extension_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +797,7 @@ func (x *MirrorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MirrorRequest.ProtoReflect.Descriptor instead.
 func (*MirrorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{9}
+	return file_proto_extension_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MirrorRequest) GetPkg() string {
@@ -540,7 +829,7 @@ type MirrorResponse struct {
 
 func (x *MirrorResponse) Reset() {
 	*x = MirrorResponse{}
-	mi := &file_proto_extension_proto_msgTypes[10]
+	mi := &file_proto_extension_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +841,7 @@ func (x *MirrorResponse) String() string {
 func (*MirrorResponse) ProtoMessage() {}
 
 func (x *MirrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[10]
+	mi := &file_proto_extension_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +854,7 @@ func (x *MirrorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MirrorResponse.ProtoReflect.Descriptor instead.
 func (*MirrorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{10}
+	return file_proto_extension_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MirrorResponse) GetData() isMirrorResponse_Data {
@@ -639,35 +928,1132 @@ func (*MirrorResponse_Fikushon) isMirrorResponse_Data() {}
 
 func (*MirrorResponse_Raw) isMirrorResponse_Data() {}
 
-type WatchResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Data:
-	//
-	//	*WatchResponse_Bangumi
-	//	*WatchResponse_Manga
-	//	*WatchResponse_Fikushon
-	//	*WatchResponse_Watch
-	//	*WatchResponse_Raw
-	Data          isWatchResponse_Data `protobuf_oneof:"data"`
+type WatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*WatchResponse_Bangumi
+	//	*WatchResponse_Manga
+	//	*WatchResponse_Fikushon
+	//	*WatchResponse_Watch
+	//	*WatchResponse_Raw
+	Data          isWatchResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchResponse) Reset() {
+	*x = WatchResponse{}
+	mi := &file_proto_extension_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResponse) ProtoMessage() {}
+
+func (x *WatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResponse.ProtoReflect.Descriptor instead.
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchResponse) GetData() isWatchResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *WatchResponse) GetBangumi() *ExtensionBangumiWatch {
+	if x != nil {
+		if x, ok := x.Data.(*WatchResponse_Bangumi); ok {
+			return x.Bangumi
+		}
+	}
+	return nil
+}
+
+func (x *WatchResponse) GetManga() *ExtensionMangaWatch {
+	if x != nil {
+		if x, ok := x.Data.(*WatchResponse_Manga); ok {
+			return x.Manga
+		}
+	}
+	return nil
+}
+
+func (x *WatchResponse) GetFikushon() *ExtensionFikushonWatch {
+	if x != nil {
+		if x, ok := x.Data.(*WatchResponse_Fikushon); ok {
+			return x.Fikushon
+		}
+	}
+	return nil
+}
+
+func (x *WatchResponse) GetWatch() *ExtensionWatch {
+	if x != nil {
+		if x, ok := x.Data.(*WatchResponse_Watch); ok {
+			return x.Watch
+		}
+	}
+	return nil
+}
+
+func (x *WatchResponse) GetRaw() string {
+	if x != nil {
+		if x, ok := x.Data.(*WatchResponse_Raw); ok {
+			return x.Raw
+		}
+	}
+	return ""
+}
+
+type isWatchResponse_Data interface {
+	isWatchResponse_Data()
+}
+
+type WatchResponse_Bangumi struct {
+	Bangumi *ExtensionBangumiWatch `protobuf:"bytes,1,opt,name=bangumi,proto3,oneof"`
+}
+
+type WatchResponse_Manga struct {
+	Manga *ExtensionMangaWatch `protobuf:"bytes,2,opt,name=manga,proto3,oneof"`
+}
+
+type WatchResponse_Fikushon struct {
+	Fikushon *ExtensionFikushonWatch `protobuf:"bytes,3,opt,name=fikushon,proto3,oneof"`
+}
+
+type WatchResponse_Watch struct {
+	Watch *ExtensionWatch `protobuf:"bytes,4,opt,name=watch,proto3,oneof"`
+}
+
+type WatchResponse_Raw struct {
+	Raw string `protobuf:"bytes,5,opt,name=raw,proto3,oneof"` // Backup or unknown watch type
+}
+
+func (*WatchResponse_Bangumi) isWatchResponse_Data() {}
+
+func (*WatchResponse_Manga) isWatchResponse_Data() {}
+
+func (*WatchResponse_Fikushon) isWatchResponse_Data() {}
+
+func (*WatchResponse_Watch) isWatchResponse_Data() {}
+
+func (*WatchResponse_Raw) isWatchResponse_Data() {}
+
+// Extension Management
+type DownloadExtensionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoUrl       string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Pkg           string                 `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadExtensionRequest) Reset() {
+	*x = DownloadExtensionRequest{}
+	mi := &file_proto_extension_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExtensionRequest) ProtoMessage() {}
+
+func (x *DownloadExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExtensionRequest.ProtoReflect.Descriptor instead.
+func (*DownloadExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DownloadExtensionRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *DownloadExtensionRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+type DownloadExtensionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadExtensionResponse) Reset() {
+	*x = DownloadExtensionResponse{}
+	mi := &file_proto_extension_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExtensionResponse) ProtoMessage() {}
+
+func (x *DownloadExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExtensionResponse.ProtoReflect.Descriptor instead.
+func (*DownloadExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DownloadExtensionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveExtensionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveExtensionRequest) Reset() {
+	*x = RemoveExtensionRequest{}
+	mi := &file_proto_extension_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveExtensionRequest) ProtoMessage() {}
+
+func (x *RemoveExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveExtensionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveExtensionRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+type RemoveExtensionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveExtensionResponse) Reset() {
+	*x = RemoveExtensionResponse{}
+	mi := &file_proto_extension_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveExtensionResponse) ProtoMessage() {}
+
+func (x *RemoveExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveExtensionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveExtensionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetExtensionSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExtensionSettingsRequest) Reset() {
+	*x = GetExtensionSettingsRequest{}
+	mi := &file_proto_extension_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExtensionSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExtensionSettingsRequest) ProtoMessage() {}
+
+func (x *GetExtensionSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExtensionSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetExtensionSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetExtensionSettingsRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+type GetExtensionSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      []*ExtensionSetting    `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExtensionSettingsResponse) Reset() {
+	*x = GetExtensionSettingsResponse{}
+	mi := &file_proto_extension_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExtensionSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExtensionSettingsResponse) ProtoMessage() {}
+
+func (x *GetExtensionSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExtensionSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetExtensionSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetExtensionSettingsResponse) GetSettings() []*ExtensionSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SaveExtensionSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Settings      []*ExtensionSetting    `protobuf:"bytes,2,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveExtensionSettingsRequest) Reset() {
+	*x = SaveExtensionSettingsRequest{}
+	mi := &file_proto_extension_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveExtensionSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveExtensionSettingsRequest) ProtoMessage() {}
+
+func (x *SaveExtensionSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveExtensionSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SaveExtensionSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SaveExtensionSettingsRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *SaveExtensionSettingsRequest) GetSettings() []*ExtensionSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SaveExtensionSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveExtensionSettingsResponse) Reset() {
+	*x = SaveExtensionSettingsResponse{}
+	mi := &file_proto_extension_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveExtensionSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveExtensionSettingsResponse) ProtoMessage() {}
+
+func (x *SaveExtensionSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveExtensionSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SaveExtensionSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SaveExtensionSettingsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Domains an extension may access on top of its website and declared domains
+type GrantExtensionDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Domains       []string               `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantExtensionDomainsRequest) Reset() {
+	*x = GrantExtensionDomainsRequest{}
+	mi := &file_proto_extension_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantExtensionDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantExtensionDomainsRequest) ProtoMessage() {}
+
+func (x *GrantExtensionDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantExtensionDomainsRequest.ProtoReflect.Descriptor instead.
+func (*GrantExtensionDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GrantExtensionDomainsRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *GrantExtensionDomainsRequest) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type GrantExtensionDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []string               `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantExtensionDomainsResponse) Reset() {
+	*x = GrantExtensionDomainsResponse{}
+	mi := &file_proto_extension_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantExtensionDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantExtensionDomainsResponse) ProtoMessage() {}
+
+func (x *GrantExtensionDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantExtensionDomainsResponse.ProtoReflect.Descriptor instead.
+func (*GrantExtensionDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GrantExtensionDomainsResponse) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type RevokeExtensionDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Domains       []string               `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeExtensionDomainsRequest) Reset() {
+	*x = RevokeExtensionDomainsRequest{}
+	mi := &file_proto_extension_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeExtensionDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeExtensionDomainsRequest) ProtoMessage() {}
+
+func (x *RevokeExtensionDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeExtensionDomainsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeExtensionDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RevokeExtensionDomainsRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *RevokeExtensionDomainsRequest) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type RevokeExtensionDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []string               `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeExtensionDomainsResponse) Reset() {
+	*x = RevokeExtensionDomainsResponse{}
+	mi := &file_proto_extension_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeExtensionDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeExtensionDomainsResponse) ProtoMessage() {}
+
+func (x *RevokeExtensionDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeExtensionDomainsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeExtensionDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevokeExtensionDomainsResponse) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// Console output and exceptions captured from an extension
+type ExtensionLogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Time          string                 `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`   // ISO8601
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"` // log, warn, error or exception
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtensionLogEntry) Reset() {
+	*x = ExtensionLogEntry{}
+	mi := &file_proto_extension_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionLogEntry) ProtoMessage() {}
+
+func (x *ExtensionLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionLogEntry.ProtoReflect.Descriptor instead.
+func (*ExtensionLogEntry) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExtensionLogEntry) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *ExtensionLogEntry) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *ExtensionLogEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *ExtensionLogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TailExtensionLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailExtensionLogsRequest) Reset() {
+	*x = TailExtensionLogsRequest{}
+	mi := &file_proto_extension_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailExtensionLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailExtensionLogsRequest) ProtoMessage() {}
+
+func (x *TailExtensionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailExtensionLogsRequest.ProtoReflect.Descriptor instead.
+func (*TailExtensionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TailExtensionLogsRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+// An empty pkg returns the logs of every extension
+type GetExtensionLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExtensionLogsRequest) Reset() {
+	*x = GetExtensionLogsRequest{}
+	mi := &file_proto_extension_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExtensionLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExtensionLogsRequest) ProtoMessage() {}
+
+func (x *GetExtensionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExtensionLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetExtensionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetExtensionLogsRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+type GetExtensionLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ExtensionLogEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExtensionLogsResponse) Reset() {
+	*x = GetExtensionLogsResponse{}
+	mi := &file_proto_extension_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExtensionLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExtensionLogsResponse) ProtoMessage() {}
+
+func (x *GetExtensionLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExtensionLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetExtensionLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetExtensionLogsResponse) GetEntries() []*ExtensionLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A newer version of an installed extension found in a repository
+type ExtensionUpdateInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RepoUrl          string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Pkg              string                 `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	InstalledVersion string                 `protobuf:"bytes,4,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version,omitempty"`
+	AvailableVersion string                 `protobuf:"bytes,5,opt,name=available_version,json=availableVersion,proto3" json:"available_version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExtensionUpdateInfo) Reset() {
+	*x = ExtensionUpdateInfo{}
+	mi := &file_proto_extension_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionUpdateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionUpdateInfo) ProtoMessage() {}
+
+func (x *ExtensionUpdateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionUpdateInfo.ProtoReflect.Descriptor instead.
+func (*ExtensionUpdateInfo) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExtensionUpdateInfo) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *ExtensionUpdateInfo) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *ExtensionUpdateInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtensionUpdateInfo) GetInstalledVersion() string {
+	if x != nil {
+		return x.InstalledVersion
+	}
+	return ""
+}
+
+func (x *ExtensionUpdateInfo) GetAvailableVersion() string {
+	if x != nil {
+		return x.AvailableVersion
+	}
+	return ""
+}
+
+type CheckExtensionUpdatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckExtensionUpdatesRequest) Reset() {
+	*x = CheckExtensionUpdatesRequest{}
+	mi := &file_proto_extension_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckExtensionUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckExtensionUpdatesRequest) ProtoMessage() {}
+
+func (x *CheckExtensionUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckExtensionUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*CheckExtensionUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{35}
+}
+
+type CheckExtensionUpdatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updates       []*ExtensionUpdateInfo `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	RepoErrors    map[string]string      `protobuf:"bytes,2,rep,name=repo_errors,json=repoErrors,proto3" json:"repo_errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // repo url -> error
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckExtensionUpdatesResponse) Reset() {
+	*x = CheckExtensionUpdatesResponse{}
+	mi := &file_proto_extension_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckExtensionUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckExtensionUpdatesResponse) ProtoMessage() {}
+
+func (x *CheckExtensionUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckExtensionUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*CheckExtensionUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckExtensionUpdatesResponse) GetUpdates() []*ExtensionUpdateInfo {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *CheckExtensionUpdatesResponse) GetRepoErrors() map[string]string {
+	if x != nil {
+		return x.RepoErrors
+	}
+	return nil
+}
+
+// Force checks every favorite instead of the ones whose interval passed
+type CheckFavoriteUpdatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Force         bool                   `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFavoriteUpdatesRequest) Reset() {
+	*x = CheckFavoriteUpdatesRequest{}
+	mi := &file_proto_extension_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFavoriteUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFavoriteUpdatesRequest) ProtoMessage() {}
+
+func (x *CheckFavoriteUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFavoriteUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*CheckFavoriteUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckFavoriteUpdatesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type CheckFavoriteUpdatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*EpisodeUpdate       `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchResponse) Reset() {
-	*x = WatchResponse{}
-	mi := &file_proto_extension_proto_msgTypes[11]
+func (x *CheckFavoriteUpdatesResponse) Reset() {
+	*x = CheckFavoriteUpdatesResponse{}
+	mi := &file_proto_extension_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchResponse) String() string {
+func (x *CheckFavoriteUpdatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchResponse) ProtoMessage() {}
+func (*CheckFavoriteUpdatesResponse) ProtoMessage() {}
 
-func (x *WatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[11]
+func (x *CheckFavoriteUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,121 +2064,137 @@ func (x *WatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchResponse.ProtoReflect.Descriptor instead.
-func (*WatchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CheckFavoriteUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*CheckFavoriteUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *WatchResponse) GetData() isWatchResponse_Data {
+func (x *CheckFavoriteUpdatesResponse) GetEpisodes() []*EpisodeUpdate {
 	if x != nil {
-		return x.Data
+		return x.Episodes
 	}
 	return nil
 }
 
-func (x *WatchResponse) GetBangumi() *ExtensionBangumiWatch {
-	if x != nil {
-		if x, ok := x.Data.(*WatchResponse_Bangumi); ok {
-			return x.Bangumi
-		}
-	}
-	return nil
+type UpgradeExtensionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoUrl       string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Pkg           string                 `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchResponse) GetManga() *ExtensionMangaWatch {
-	if x != nil {
-		if x, ok := x.Data.(*WatchResponse_Manga); ok {
-			return x.Manga
-		}
-	}
-	return nil
+func (x *UpgradeExtensionRequest) Reset() {
+	*x = UpgradeExtensionRequest{}
+	mi := &file_proto_extension_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchResponse) GetFikushon() *ExtensionFikushonWatch {
+func (x *UpgradeExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeExtensionRequest) ProtoMessage() {}
+
+func (x *UpgradeExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[39]
 	if x != nil {
-		if x, ok := x.Data.(*WatchResponse_Fikushon); ok {
-			return x.Fikushon
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *WatchResponse) GetWatch() *ExtensionWatch {
+// Deprecated: Use UpgradeExtensionRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpgradeExtensionRequest) GetRepoUrl() string {
 	if x != nil {
-		if x, ok := x.Data.(*WatchResponse_Watch); ok {
-			return x.Watch
-		}
+		return x.RepoUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *WatchResponse) GetRaw() string {
+func (x *UpgradeExtensionRequest) GetPkg() string {
 	if x != nil {
-		if x, ok := x.Data.(*WatchResponse_Raw); ok {
-			return x.Raw
-		}
+		return x.Pkg
 	}
 	return ""
 }
 
-type isWatchResponse_Data interface {
-	isWatchResponse_Data()
+type UpgradeExtensionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upgraded      *ExtensionUpdateInfo   `protobuf:"bytes,1,opt,name=upgraded,proto3" json:"upgraded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type WatchResponse_Bangumi struct {
-	Bangumi *ExtensionBangumiWatch `protobuf:"bytes,1,opt,name=bangumi,proto3,oneof"`
+func (x *UpgradeExtensionResponse) Reset() {
+	*x = UpgradeExtensionResponse{}
+	mi := &file_proto_extension_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type WatchResponse_Manga struct {
-	Manga *ExtensionMangaWatch `protobuf:"bytes,2,opt,name=manga,proto3,oneof"`
+func (x *UpgradeExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type WatchResponse_Fikushon struct {
-	Fikushon *ExtensionFikushonWatch `protobuf:"bytes,3,opt,name=fikushon,proto3,oneof"`
-}
+func (*UpgradeExtensionResponse) ProtoMessage() {}
 
-type WatchResponse_Watch struct {
-	Watch *ExtensionWatch `protobuf:"bytes,4,opt,name=watch,proto3,oneof"`
+func (x *UpgradeExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type WatchResponse_Raw struct {
-	Raw string `protobuf:"bytes,5,opt,name=raw,proto3,oneof"` // Backup or unknown watch type
+// Deprecated: Use UpgradeExtensionResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{40}
 }
 
-func (*WatchResponse_Bangumi) isWatchResponse_Data() {}
-
-func (*WatchResponse_Manga) isWatchResponse_Data() {}
-
-func (*WatchResponse_Fikushon) isWatchResponse_Data() {}
-
-func (*WatchResponse_Watch) isWatchResponse_Data() {}
-
-func (*WatchResponse_Raw) isWatchResponse_Data() {}
+func (x *UpgradeExtensionResponse) GetUpgraded() *ExtensionUpdateInfo {
+	if x != nil {
+		return x.Upgraded
+	}
+	return nil
+}
 
-// Extension Management
-type DownloadExtensionRequest struct {
+// Atomic upgrades roll every extension back when one of them fails
+type UpgradeAllExtensionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepoUrl       string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
-	Pkg           string                 `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Atomic        bool                   `protobuf:"varint,1,opt,name=atomic,proto3" json:"atomic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadExtensionRequest) Reset() {
-	*x = DownloadExtensionRequest{}
-	mi := &file_proto_extension_proto_msgTypes[12]
+func (x *UpgradeAllExtensionsRequest) Reset() {
+	*x = UpgradeAllExtensionsRequest{}
+	mi := &file_proto_extension_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadExtensionRequest) String() string {
+func (x *UpgradeAllExtensionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadExtensionRequest) ProtoMessage() {}
+func (*UpgradeAllExtensionsRequest) ProtoMessage() {}
 
-func (x *DownloadExtensionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[12]
+func (x *UpgradeAllExtensionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,47 +2205,41 @@ func (x *DownloadExtensionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadExtensionRequest.ProtoReflect.Descriptor instead.
-func (*DownloadExtensionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *DownloadExtensionRequest) GetRepoUrl() string {
-	if x != nil {
-		return x.RepoUrl
-	}
-	return ""
+// Deprecated: Use UpgradeAllExtensionsRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeAllExtensionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DownloadExtensionRequest) GetPkg() string {
+func (x *UpgradeAllExtensionsRequest) GetAtomic() bool {
 	if x != nil {
-		return x.Pkg
+		return x.Atomic
 	}
-	return ""
+	return false
 }
 
-type DownloadExtensionResponse struct {
+type UpgradeAllExtensionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Upgraded      []*ExtensionUpdateInfo `protobuf:"bytes,1,rep,name=upgraded,proto3" json:"upgraded,omitempty"`
+	Failed        map[string]string      `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // pkg -> error
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadExtensionResponse) Reset() {
-	*x = DownloadExtensionResponse{}
-	mi := &file_proto_extension_proto_msgTypes[13]
+func (x *UpgradeAllExtensionsResponse) Reset() {
+	*x = UpgradeAllExtensionsResponse{}
+	mi := &file_proto_extension_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadExtensionResponse) String() string {
+func (x *UpgradeAllExtensionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadExtensionResponse) ProtoMessage() {}
+func (*UpgradeAllExtensionsResponse) ProtoMessage() {}
 
-func (x *DownloadExtensionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[13]
+func (x *UpgradeAllExtensionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,40 +2250,48 @@ func (x *DownloadExtensionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadExtensionResponse.ProtoReflect.Descriptor instead.
-func (*DownloadExtensionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UpgradeAllExtensionsResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeAllExtensionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DownloadExtensionResponse) GetMessage() string {
+func (x *UpgradeAllExtensionsResponse) GetUpgraded() []*ExtensionUpdateInfo {
 	if x != nil {
-		return x.Message
+		return x.Upgraded
 	}
-	return ""
+	return nil
 }
 
-type RemoveExtensionRequest struct {
+func (x *UpgradeAllExtensionsResponse) GetFailed() map[string]string {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+// Reinstalls the version replaced by the last install or upgrade of pkg
+type RollbackExtensionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pkg           string                 `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveExtensionRequest) Reset() {
-	*x = RemoveExtensionRequest{}
-	mi := &file_proto_extension_proto_msgTypes[14]
+func (x *RollbackExtensionRequest) Reset() {
+	*x = RollbackExtensionRequest{}
+	mi := &file_proto_extension_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveExtensionRequest) String() string {
+func (x *RollbackExtensionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveExtensionRequest) ProtoMessage() {}
+func (*RollbackExtensionRequest) ProtoMessage() {}
 
-func (x *RemoveExtensionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[14]
+func (x *RollbackExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,40 +2302,40 @@ func (x *RemoveExtensionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveExtensionRequest.ProtoReflect.Descriptor instead.
-func (*RemoveExtensionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RollbackExtensionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RemoveExtensionRequest) GetPkg() string {
+func (x *RollbackExtensionRequest) GetPkg() string {
 	if x != nil {
 		return x.Pkg
 	}
 	return ""
 }
 
-type RemoveExtensionResponse struct {
+type RollbackExtensionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveExtensionResponse) Reset() {
-	*x = RemoveExtensionResponse{}
-	mi := &file_proto_extension_proto_msgTypes[15]
+func (x *RollbackExtensionResponse) Reset() {
+	*x = RollbackExtensionResponse{}
+	mi := &file_proto_extension_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveExtensionResponse) String() string {
+func (x *RollbackExtensionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveExtensionResponse) ProtoMessage() {}
+func (*RollbackExtensionResponse) ProtoMessage() {}
 
-func (x *RemoveExtensionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[15]
+func (x *RollbackExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,40 +2346,43 @@ func (x *RemoveExtensionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveExtensionResponse.ProtoReflect.Descriptor instead.
-func (*RemoveExtensionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RollbackExtensionResponse.ProtoReflect.Descriptor instead.
+func (*RollbackExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RemoveExtensionResponse) GetMessage() string {
+func (x *RollbackExtensionResponse) GetVersion() string {
 	if x != nil {
-		return x.Message
+		return x.Version
 	}
 	return ""
 }
 
-type GetExtensionSettingsRequest struct {
+// Disabled extensions stay installed and listed but never run. Enabling an
+// extension disabled for going over its budgets reloads it
+type SetExtensionEnabledRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExtensionSettingsRequest) Reset() {
-	*x = GetExtensionSettingsRequest{}
-	mi := &file_proto_extension_proto_msgTypes[16]
+func (x *SetExtensionEnabledRequest) Reset() {
+	*x = SetExtensionEnabledRequest{}
+	mi := &file_proto_extension_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExtensionSettingsRequest) String() string {
+func (x *SetExtensionEnabledRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExtensionSettingsRequest) ProtoMessage() {}
+func (*SetExtensionEnabledRequest) ProtoMessage() {}
 
-func (x *GetExtensionSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[16]
+func (x *SetExtensionEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,40 +2393,47 @@ func (x *GetExtensionSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExtensionSettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetExtensionSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SetExtensionEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetExtensionEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetExtensionSettingsRequest) GetPkg() string {
+func (x *SetExtensionEnabledRequest) GetPkg() string {
 	if x != nil {
 		return x.Pkg
 	}
 	return ""
 }
 
-type GetExtensionSettingsResponse struct {
+func (x *SetExtensionEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetExtensionEnabledResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      []*ExtensionSetting    `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExtensionSettingsResponse) Reset() {
-	*x = GetExtensionSettingsResponse{}
-	mi := &file_proto_extension_proto_msgTypes[17]
+func (x *SetExtensionEnabledResponse) Reset() {
+	*x = SetExtensionEnabledResponse{}
+	mi := &file_proto_extension_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExtensionSettingsResponse) String() string {
+func (x *SetExtensionEnabledResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExtensionSettingsResponse) ProtoMessage() {}
+func (*SetExtensionEnabledResponse) ProtoMessage() {}
 
-func (x *GetExtensionSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[17]
+func (x *SetExtensionEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,41 +2444,42 @@ func (x *GetExtensionSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExtensionSettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetExtensionSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SetExtensionEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetExtensionEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetExtensionSettingsResponse) GetSettings() []*ExtensionSetting {
+func (x *SetExtensionEnabledResponse) GetMessage() string {
 	if x != nil {
-		return x.Settings
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type SaveExtensionSettingsRequest struct {
+// An empty version unpins the extension
+type PinExtensionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pkg           string                 `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
-	Settings      []*ExtensionSetting    `protobuf:"bytes,2,rep,name=settings,proto3" json:"settings,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveExtensionSettingsRequest) Reset() {
-	*x = SaveExtensionSettingsRequest{}
-	mi := &file_proto_extension_proto_msgTypes[18]
+func (x *PinExtensionRequest) Reset() {
+	*x = PinExtensionRequest{}
+	mi := &file_proto_extension_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveExtensionSettingsRequest) String() string {
+func (x *PinExtensionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveExtensionSettingsRequest) ProtoMessage() {}
+func (*PinExtensionRequest) ProtoMessage() {}
 
-func (x *SaveExtensionSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[18]
+func (x *PinExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,47 +2490,47 @@ func (x *SaveExtensionSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveExtensionSettingsRequest.ProtoReflect.Descriptor instead.
-func (*SaveExtensionSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PinExtensionRequest.ProtoReflect.Descriptor instead.
+func (*PinExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SaveExtensionSettingsRequest) GetPkg() string {
+func (x *PinExtensionRequest) GetPkg() string {
 	if x != nil {
 		return x.Pkg
 	}
 	return ""
 }
 
-func (x *SaveExtensionSettingsRequest) GetSettings() []*ExtensionSetting {
+func (x *PinExtensionRequest) GetVersion() string {
 	if x != nil {
-		return x.Settings
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-type SaveExtensionSettingsResponse struct {
+type PinExtensionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveExtensionSettingsResponse) Reset() {
-	*x = SaveExtensionSettingsResponse{}
-	mi := &file_proto_extension_proto_msgTypes[19]
+func (x *PinExtensionResponse) Reset() {
+	*x = PinExtensionResponse{}
+	mi := &file_proto_extension_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveExtensionSettingsResponse) String() string {
+func (x *PinExtensionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveExtensionSettingsResponse) ProtoMessage() {}
+func (*PinExtensionResponse) ProtoMessage() {}
 
-func (x *SaveExtensionSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_proto_msgTypes[19]
+func (x *PinExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,12 +2541,12 @@ func (x *SaveExtensionSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveExtensionSettingsResponse.ProtoReflect.Descriptor instead.
-func (*SaveExtensionSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_extension_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PinExtensionResponse.ProtoReflect.Descriptor instead.
+func (*PinExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SaveExtensionSettingsResponse) GetMessage() string {
+func (x *PinExtensionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -1142,7 +2557,7 @@ var File_proto_extension_proto protoreflect.FileDescriptor
 
 const file_proto_extension_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/extension.proto\x12\x04miru\x1a\x1bproto/extension_model.proto\"]\n" +
+	"\x15proto/extension.proto\x12\x04miru\x1a\x14proto/db_model.proto\x1a\x1bproto/extension_model.proto\"]\n" +
 	"\rSearchRequest\x12\x10\n" +
 	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x0e\n" +
 	"\x02kw\x18\x02 \x01(\tR\x02kw\x12\x12\n" +
@@ -1162,7 +2577,22 @@ const file_proto_extension_proto_rawDesc = "" +
 	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\"?\n" +
 	"\x0eLatestResponse\x12-\n" +
-	"\x05items\x18\x01 \x03(\v2\x17.miru.ExtensionListItemR\x05items\"3\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.miru.ExtensionListItemR\x05items\"6\n" +
+	"\x0ePopularRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\"@\n" +
+	"\x0fPopularResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.miru.ExtensionListItemR\x05items\"1\n" +
+	"\vTagsRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\"\n" +
+	"\fTagsResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"8\n" +
+	"\x12CheckUpdateRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"-\n" +
+	"\x13CheckUpdateResponse\x12\x16\n" +
+	"\x06update\x18\x01 \x01(\tR\x06update\"3\n" +
 	"\rDetailRequest\x12\x10\n" +
 	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\";\n" +
@@ -1204,18 +2634,98 @@ const file_proto_extension_proto_rawDesc = "" +
 	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x122\n" +
 	"\bsettings\x18\x02 \x03(\v2\x16.miru.ExtensionSettingR\bsettings\"9\n" +
 	"\x1dSaveExtensionSettingsResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xc6\x05\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"J\n" +
+	"\x1cGrantExtensionDomainsRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x18\n" +
+	"\adomains\x18\x02 \x03(\tR\adomains\"9\n" +
+	"\x1dGrantExtensionDomainsResponse\x12\x18\n" +
+	"\adomains\x18\x01 \x03(\tR\adomains\"K\n" +
+	"\x1dRevokeExtensionDomainsRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x18\n" +
+	"\adomains\x18\x02 \x03(\tR\adomains\":\n" +
+	"\x1eRevokeExtensionDomainsResponse\x12\x18\n" +
+	"\adomains\x18\x01 \x03(\tR\adomains\"i\n" +
+	"\x11ExtensionLogEntry\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\tR\x04time\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\",\n" +
+	"\x18TailExtensionLogsRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\"+\n" +
+	"\x17GetExtensionLogsRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\"M\n" +
+	"\x18GetExtensionLogsResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.miru.ExtensionLogEntryR\aentries\"\xb0\x01\n" +
+	"\x13ExtensionUpdateInfo\x12\x19\n" +
+	"\brepo_url\x18\x01 \x01(\tR\arepoUrl\x12\x10\n" +
+	"\x03pkg\x18\x02 \x01(\tR\x03pkg\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12+\n" +
+	"\x11installed_version\x18\x04 \x01(\tR\x10installedVersion\x12+\n" +
+	"\x11available_version\x18\x05 \x01(\tR\x10availableVersion\"\x1e\n" +
+	"\x1cCheckExtensionUpdatesRequest\"\xe9\x01\n" +
+	"\x1dCheckExtensionUpdatesResponse\x123\n" +
+	"\aupdates\x18\x01 \x03(\v2\x19.miru.ExtensionUpdateInfoR\aupdates\x12T\n" +
+	"\vrepo_errors\x18\x02 \x03(\v23.miru.CheckExtensionUpdatesResponse.RepoErrorsEntryR\n" +
+	"repoErrors\x1a=\n" +
+	"\x0fRepoErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"3\n" +
+	"\x1bCheckFavoriteUpdatesRequest\x12\x14\n" +
+	"\x05force\x18\x01 \x01(\bR\x05force\"O\n" +
+	"\x1cCheckFavoriteUpdatesResponse\x12/\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x13.miru.EpisodeUpdateR\bepisodes\"F\n" +
+	"\x17UpgradeExtensionRequest\x12\x19\n" +
+	"\brepo_url\x18\x01 \x01(\tR\arepoUrl\x12\x10\n" +
+	"\x03pkg\x18\x02 \x01(\tR\x03pkg\"Q\n" +
+	"\x18UpgradeExtensionResponse\x125\n" +
+	"\bupgraded\x18\x01 \x01(\v2\x19.miru.ExtensionUpdateInfoR\bupgraded\"5\n" +
+	"\x1bUpgradeAllExtensionsRequest\x12\x16\n" +
+	"\x06atomic\x18\x01 \x01(\bR\x06atomic\"\xd8\x01\n" +
+	"\x1cUpgradeAllExtensionsResponse\x125\n" +
+	"\bupgraded\x18\x01 \x03(\v2\x19.miru.ExtensionUpdateInfoR\bupgraded\x12F\n" +
+	"\x06failed\x18\x02 \x03(\v2..miru.UpgradeAllExtensionsResponse.FailedEntryR\x06failed\x1a9\n" +
+	"\vFailedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\",\n" +
+	"\x18RollbackExtensionRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\"5\n" +
+	"\x19RollbackExtensionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"H\n" +
+	"\x1aSetExtensionEnabledRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"7\n" +
+	"\x1bSetExtensionEnabledResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"A\n" +
+	"\x13PinExtensionRequest\x12\x10\n" +
+	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"0\n" +
+	"\x14PinExtensionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xc7\x0e\n" +
 	"\x10ExtensionService\x123\n" +
 	"\x06Search\x12\x13.miru.SearchRequest\x1a\x14.miru.SearchResponse\x12E\n" +
 	"\fCreateFilter\x12\x19.miru.CreateFilterRequest\x1a\x1a.miru.CreateFilterResponse\x123\n" +
-	"\x06Latest\x12\x13.miru.LatestRequest\x1a\x14.miru.LatestResponse\x123\n" +
+	"\x06Latest\x12\x13.miru.LatestRequest\x1a\x14.miru.LatestResponse\x126\n" +
+	"\aPopular\x12\x14.miru.PopularRequest\x1a\x15.miru.PopularResponse\x12-\n" +
+	"\x04Tags\x12\x11.miru.TagsRequest\x1a\x12.miru.TagsResponse\x12B\n" +
+	"\vCheckUpdate\x12\x18.miru.CheckUpdateRequest\x1a\x19.miru.CheckUpdateResponse\x123\n" +
 	"\x06Detail\x12\x13.miru.DetailRequest\x1a\x14.miru.DetailResponse\x120\n" +
 	"\x05Watch\x12\x12.miru.WatchRequest\x1a\x13.miru.WatchResponse\x123\n" +
 	"\x06Mirror\x12\x13.miru.MirrorRequest\x1a\x14.miru.MirrorResponse\x12T\n" +
 	"\x11DownloadExtension\x12\x1e.miru.DownloadExtensionRequest\x1a\x1f.miru.DownloadExtensionResponse\x12N\n" +
 	"\x0fRemoveExtension\x12\x1c.miru.RemoveExtensionRequest\x1a\x1d.miru.RemoveExtensionResponse\x12]\n" +
 	"\x14GetExtensionSettings\x12!.miru.GetExtensionSettingsRequest\x1a\".miru.GetExtensionSettingsResponse\x12`\n" +
-	"\x15SaveExtensionSettings\x12\".miru.SaveExtensionSettingsRequest\x1a#.miru.SaveExtensionSettingsResponseB)Z'github.com/miru-project/miru-core/protob\x06proto3"
+	"\x15SaveExtensionSettings\x12\".miru.SaveExtensionSettingsRequest\x1a#.miru.SaveExtensionSettingsResponse\x12`\n" +
+	"\x15GrantExtensionDomains\x12\".miru.GrantExtensionDomainsRequest\x1a#.miru.GrantExtensionDomainsResponse\x12c\n" +
+	"\x16RevokeExtensionDomains\x12#.miru.RevokeExtensionDomainsRequest\x1a$.miru.RevokeExtensionDomainsResponse\x12N\n" +
+	"\x11TailExtensionLogs\x12\x1e.miru.TailExtensionLogsRequest\x1a\x17.miru.ExtensionLogEntry0\x01\x12Q\n" +
+	"\x10GetExtensionLogs\x12\x1d.miru.GetExtensionLogsRequest\x1a\x1e.miru.GetExtensionLogsResponse\x12`\n" +
+	"\x15CheckExtensionUpdates\x12\".miru.CheckExtensionUpdatesRequest\x1a#.miru.CheckExtensionUpdatesResponse\x12Q\n" +
+	"\x10UpgradeExtension\x12\x1d.miru.UpgradeExtensionRequest\x1a\x1e.miru.UpgradeExtensionResponse\x12]\n" +
+	"\x14CheckFavoriteUpdates\x12!.miru.CheckFavoriteUpdatesRequest\x1a\".miru.CheckFavoriteUpdatesResponse\x12]\n" +
+	"\x14UpgradeAllExtensions\x12!.miru.UpgradeAllExtensionsRequest\x1a\".miru.UpgradeAllExtensionsResponse\x12T\n" +
+	"\x11RollbackExtension\x12\x1e.miru.RollbackExtensionRequest\x1a\x1f.miru.RollbackExtensionResponse\x12Z\n" +
+	"\x13SetExtensionEnabled\x12 .miru.SetExtensionEnabledRequest\x1a!.miru.SetExtensionEnabledResponse\x12E\n" +
+	"\fPinExtension\x12\x19.miru.PinExtensionRequest\x1a\x1a.miru.PinExtensionResponseB)Z'github.com/miru-project/miru-core/protob\x06proto3"
 
 var (
 	file_proto_extension_proto_rawDescOnce sync.Once
@@ -1229,78 +2739,146 @@ func file_proto_extension_proto_rawDescGZIP() []byte {
 	return file_proto_extension_proto_rawDescData
 }
 
-var file_proto_extension_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_extension_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_proto_extension_proto_goTypes = []any{
-	(*SearchRequest)(nil),                 // 0: miru.SearchRequest
-	(*CreateFilterRequest)(nil),           // 1: miru.CreateFilterRequest
-	(*CreateFilterResponse)(nil),          // 2: miru.CreateFilterResponse
-	(*SearchResponse)(nil),                // 3: miru.SearchResponse
-	(*LatestRequest)(nil),                 // 4: miru.LatestRequest
-	(*LatestResponse)(nil),                // 5: miru.LatestResponse
-	(*DetailRequest)(nil),                 // 6: miru.DetailRequest
-	(*DetailResponse)(nil),                // 7: miru.DetailResponse
-	(*WatchRequest)(nil),                  // 8: miru.WatchRequest
-	(*MirrorRequest)(nil),                 // 9: miru.MirrorRequest
-	(*MirrorResponse)(nil),                // 10: miru.MirrorResponse
-	(*WatchResponse)(nil),                 // 11: miru.WatchResponse
-	(*DownloadExtensionRequest)(nil),      // 12: miru.DownloadExtensionRequest
-	(*DownloadExtensionResponse)(nil),     // 13: miru.DownloadExtensionResponse
-	(*RemoveExtensionRequest)(nil),        // 14: miru.RemoveExtensionRequest
-	(*RemoveExtensionResponse)(nil),       // 15: miru.RemoveExtensionResponse
-	(*GetExtensionSettingsRequest)(nil),   // 16: miru.GetExtensionSettingsRequest
-	(*GetExtensionSettingsResponse)(nil),  // 17: miru.GetExtensionSettingsResponse
-	(*SaveExtensionSettingsRequest)(nil),  // 18: miru.SaveExtensionSettingsRequest
-	(*SaveExtensionSettingsResponse)(nil), // 19: miru.SaveExtensionSettingsResponse
-	nil,                                   // 20: miru.CreateFilterResponse.FiltersEntry
-	(*ExtensionListItem)(nil),             // 21: miru.ExtensionListItem
-	(*ExtensionDetail)(nil),               // 22: miru.ExtensionDetail
-	(*ExtensionBangumiWatch)(nil),         // 23: miru.ExtensionBangumiWatch
-	(*ExtensionMangaWatch)(nil),           // 24: miru.ExtensionMangaWatch
-	(*ExtensionFikushonWatch)(nil),        // 25: miru.ExtensionFikushonWatch
-	(*ExtensionWatch)(nil),                // 26: miru.ExtensionWatch
-	(*ExtensionSetting)(nil),              // 27: miru.ExtensionSetting
-	(*ExtensionFilter)(nil),               // 28: miru.ExtensionFilter
+	(*SearchRequest)(nil),                  // 0: miru.SearchRequest
+	(*CreateFilterRequest)(nil),            // 1: miru.CreateFilterRequest
+	(*CreateFilterResponse)(nil),           // 2: miru.CreateFilterResponse
+	(*SearchResponse)(nil),                 // 3: miru.SearchResponse
+	(*LatestRequest)(nil),                  // 4: miru.LatestRequest
+	(*LatestResponse)(nil),                 // 5: miru.LatestResponse
+	(*PopularRequest)(nil),                 // 6: miru.PopularRequest
+	(*PopularResponse)(nil),                // 7: miru.PopularResponse
+	(*TagsRequest)(nil),                    // 8: miru.TagsRequest
+	(*TagsResponse)(nil),                   // 9: miru.TagsResponse
+	(*CheckUpdateRequest)(nil),             // 10: miru.CheckUpdateRequest
+	(*CheckUpdateResponse)(nil),            // 11: miru.CheckUpdateResponse
+	(*DetailRequest)(nil),                  // 12: miru.DetailRequest
+	(*DetailResponse)(nil),                 // 13: miru.DetailResponse
+	(*WatchRequest)(nil),                   // 14: miru.WatchRequest
+	(*MirrorRequest)(nil),                  // 15: miru.MirrorRequest
+	(*MirrorResponse)(nil),                 // 16: miru.MirrorResponse
+	(*WatchResponse)(nil),                  // 17: miru.WatchResponse
+	(*DownloadExtensionRequest)(nil),       // 18: miru.DownloadExtensionRequest
+	(*DownloadExtensionResponse)(nil),      // 19: miru.DownloadExtensionResponse
+	(*RemoveExtensionRequest)(nil),         // 20: miru.RemoveExtensionRequest
+	(*RemoveExtensionResponse)(nil),        // 21: miru.RemoveExtensionResponse
+	(*GetExtensionSettingsRequest)(nil),    // 22: miru.GetExtensionSettingsRequest
+	(*GetExtensionSettingsResponse)(nil),   // 23: miru.GetExtensionSettingsResponse
+	(*SaveExtensionSettingsRequest)(nil),   // 24: miru.SaveExtensionSettingsRequest
+	(*SaveExtensionSettingsResponse)(nil),  // 25: miru.SaveExtensionSettingsResponse
+	(*GrantExtensionDomainsRequest)(nil),   // 26: miru.GrantExtensionDomainsRequest
+	(*GrantExtensionDomainsResponse)(nil),  // 27: miru.GrantExtensionDomainsResponse
+	(*RevokeExtensionDomainsRequest)(nil),  // 28: miru.RevokeExtensionDomainsRequest
+	(*RevokeExtensionDomainsResponse)(nil), // 29: miru.RevokeExtensionDomainsResponse
+	(*ExtensionLogEntry)(nil),              // 30: miru.ExtensionLogEntry
+	(*TailExtensionLogsRequest)(nil),       // 31: miru.TailExtensionLogsRequest
+	(*GetExtensionLogsRequest)(nil),        // 32: miru.GetExtensionLogsRequest
+	(*GetExtensionLogsResponse)(nil),       // 33: miru.GetExtensionLogsResponse
+	(*ExtensionUpdateInfo)(nil),            // 34: miru.ExtensionUpdateInfo
+	(*CheckExtensionUpdatesRequest)(nil),   // 35: miru.CheckExtensionUpdatesRequest
+	(*CheckExtensionUpdatesResponse)(nil),  // 36: miru.CheckExtensionUpdatesResponse
+	(*CheckFavoriteUpdatesRequest)(nil),    // 37: miru.CheckFavoriteUpdatesRequest
+	(*CheckFavoriteUpdatesResponse)(nil),   // 38: miru.CheckFavoriteUpdatesResponse
+	(*UpgradeExtensionRequest)(nil),        // 39: miru.UpgradeExtensionRequest
+	(*UpgradeExtensionResponse)(nil),       // 40: miru.UpgradeExtensionResponse
+	(*UpgradeAllExtensionsRequest)(nil),    // 41: miru.UpgradeAllExtensionsRequest
+	(*UpgradeAllExtensionsResponse)(nil),   // 42: miru.UpgradeAllExtensionsResponse
+	(*RollbackExtensionRequest)(nil),       // 43: miru.RollbackExtensionRequest
+	(*RollbackExtensionResponse)(nil),      // 44: miru.RollbackExtensionResponse
+	(*SetExtensionEnabledRequest)(nil),     // 45: miru.SetExtensionEnabledRequest
+	(*SetExtensionEnabledResponse)(nil),    // 46: miru.SetExtensionEnabledResponse
+	(*PinExtensionRequest)(nil),            // 47: miru.PinExtensionRequest
+	(*PinExtensionResponse)(nil),           // 48: miru.PinExtensionResponse
+	nil,                                    // 49: miru.CreateFilterResponse.FiltersEntry
+	nil,                                    // 50: miru.CheckExtensionUpdatesResponse.RepoErrorsEntry
+	nil,                                    // 51: miru.UpgradeAllExtensionsResponse.FailedEntry
+	(*ExtensionListItem)(nil),              // 52: miru.ExtensionListItem
+	(*ExtensionDetail)(nil),                // 53: miru.ExtensionDetail
+	(*ExtensionBangumiWatch)(nil),          // 54: miru.ExtensionBangumiWatch
+	(*ExtensionMangaWatch)(nil),            // 55: miru.ExtensionMangaWatch
+	(*ExtensionFikushonWatch)(nil),         // 56: miru.ExtensionFikushonWatch
+	(*ExtensionWatch)(nil),                 // 57: miru.ExtensionWatch
+	(*ExtensionSetting)(nil),               // 58: miru.ExtensionSetting
+	(*EpisodeUpdate)(nil),                  // 59: miru.EpisodeUpdate
+	(*ExtensionFilter)(nil),                // 60: miru.ExtensionFilter
 }
 var file_proto_extension_proto_depIdxs = []int32{
-	20, // 0: miru.CreateFilterResponse.filters:type_name -> miru.CreateFilterResponse.FiltersEntry
-	21, // 1: miru.SearchResponse.items:type_name -> miru.ExtensionListItem
-	21, // 2: miru.LatestResponse.items:type_name -> miru.ExtensionListItem
-	22, // 3: miru.DetailResponse.data:type_name -> miru.ExtensionDetail
-	23, // 4: miru.MirrorResponse.bangumi:type_name -> miru.ExtensionBangumiWatch
-	24, // 5: miru.MirrorResponse.manga:type_name -> miru.ExtensionMangaWatch
-	25, // 6: miru.MirrorResponse.fikushon:type_name -> miru.ExtensionFikushonWatch
-	23, // 7: miru.WatchResponse.bangumi:type_name -> miru.ExtensionBangumiWatch
-	24, // 8: miru.WatchResponse.manga:type_name -> miru.ExtensionMangaWatch
-	25, // 9: miru.WatchResponse.fikushon:type_name -> miru.ExtensionFikushonWatch
-	26, // 10: miru.WatchResponse.watch:type_name -> miru.ExtensionWatch
-	27, // 11: miru.GetExtensionSettingsResponse.settings:type_name -> miru.ExtensionSetting
-	27, // 12: miru.SaveExtensionSettingsRequest.settings:type_name -> miru.ExtensionSetting
-	28, // 13: miru.CreateFilterResponse.FiltersEntry.value:type_name -> miru.ExtensionFilter
-	0,  // 14: miru.ExtensionService.Search:input_type -> miru.SearchRequest
-	1,  // 15: miru.ExtensionService.CreateFilter:input_type -> miru.CreateFilterRequest
-	4,  // 16: miru.ExtensionService.Latest:input_type -> miru.LatestRequest
-	6,  // 17: miru.ExtensionService.Detail:input_type -> miru.DetailRequest
-	8,  // 18: miru.ExtensionService.Watch:input_type -> miru.WatchRequest
-	9,  // 19: miru.ExtensionService.Mirror:input_type -> miru.MirrorRequest
-	12, // 20: miru.ExtensionService.DownloadExtension:input_type -> miru.DownloadExtensionRequest
-	14, // 21: miru.ExtensionService.RemoveExtension:input_type -> miru.RemoveExtensionRequest
-	16, // 22: miru.ExtensionService.GetExtensionSettings:input_type -> miru.GetExtensionSettingsRequest
-	18, // 23: miru.ExtensionService.SaveExtensionSettings:input_type -> miru.SaveExtensionSettingsRequest
-	3,  // 24: miru.ExtensionService.Search:output_type -> miru.SearchResponse
-	2,  // 25: miru.ExtensionService.CreateFilter:output_type -> miru.CreateFilterResponse
-	5,  // 26: miru.ExtensionService.Latest:output_type -> miru.LatestResponse
-	7,  // 27: miru.ExtensionService.Detail:output_type -> miru.DetailResponse
-	11, // 28: miru.ExtensionService.Watch:output_type -> miru.WatchResponse
-	10, // 29: miru.ExtensionService.Mirror:output_type -> miru.MirrorResponse
-	13, // 30: miru.ExtensionService.DownloadExtension:output_type -> miru.DownloadExtensionResponse
-	15, // 31: miru.ExtensionService.RemoveExtension:output_type -> miru.RemoveExtensionResponse
-	17, // 32: miru.ExtensionService.GetExtensionSettings:output_type -> miru.GetExtensionSettingsResponse
-	19, // 33: miru.ExtensionService.SaveExtensionSettings:output_type -> miru.SaveExtensionSettingsResponse
-	24, // [24:34] is the sub-list for method output_type
-	14, // [14:24] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	49, // 0: miru.CreateFilterResponse.filters:type_name -> miru.CreateFilterResponse.FiltersEntry
+	52, // 1: miru.SearchResponse.items:type_name -> miru.ExtensionListItem
+	52, // 2: miru.LatestResponse.items:type_name -> miru.ExtensionListItem
+	52, // 3: miru.PopularResponse.items:type_name -> miru.ExtensionListItem
+	53, // 4: miru.DetailResponse.data:type_name -> miru.ExtensionDetail
+	54, // 5: miru.MirrorResponse.bangumi:type_name -> miru.ExtensionBangumiWatch
+	55, // 6: miru.MirrorResponse.manga:type_name -> miru.ExtensionMangaWatch
+	56, // 7: miru.MirrorResponse.fikushon:type_name -> miru.ExtensionFikushonWatch
+	54, // 8: miru.WatchResponse.bangumi:type_name -> miru.ExtensionBangumiWatch
+	55, // 9: miru.WatchResponse.manga:type_name -> miru.ExtensionMangaWatch
+	56, // 10: miru.WatchResponse.fikushon:type_name -> miru.ExtensionFikushonWatch
+	57, // 11: miru.WatchResponse.watch:type_name -> miru.ExtensionWatch
+	58, // 12: miru.GetExtensionSettingsResponse.settings:type_name -> miru.ExtensionSetting
+	58, // 13: miru.SaveExtensionSettingsRequest.settings:type_name -> miru.ExtensionSetting
+	30, // 14: miru.GetExtensionLogsResponse.entries:type_name -> miru.ExtensionLogEntry
+	34, // 15: miru.CheckExtensionUpdatesResponse.updates:type_name -> miru.ExtensionUpdateInfo
+	50, // 16: miru.CheckExtensionUpdatesResponse.repo_errors:type_name -> miru.CheckExtensionUpdatesResponse.RepoErrorsEntry
+	59, // 17: miru.CheckFavoriteUpdatesResponse.episodes:type_name -> miru.EpisodeUpdate
+	34, // 18: miru.UpgradeExtensionResponse.upgraded:type_name -> miru.ExtensionUpdateInfo
+	34, // 19: miru.UpgradeAllExtensionsResponse.upgraded:type_name -> miru.ExtensionUpdateInfo
+	51, // 20: miru.UpgradeAllExtensionsResponse.failed:type_name -> miru.UpgradeAllExtensionsResponse.FailedEntry
+	60, // 21: miru.CreateFilterResponse.FiltersEntry.value:type_name -> miru.ExtensionFilter
+	0,  // 22: miru.ExtensionService.Search:input_type -> miru.SearchRequest
+	1,  // 23: miru.ExtensionService.CreateFilter:input_type -> miru.CreateFilterRequest
+	4,  // 24: miru.ExtensionService.Latest:input_type -> miru.LatestRequest
+	6,  // 25: miru.ExtensionService.Popular:input_type -> miru.PopularRequest
+	8,  // 26: miru.ExtensionService.Tags:input_type -> miru.TagsRequest
+	10, // 27: miru.ExtensionService.CheckUpdate:input_type -> miru.CheckUpdateRequest
+	12, // 28: miru.ExtensionService.Detail:input_type -> miru.DetailRequest
+	14, // 29: miru.ExtensionService.Watch:input_type -> miru.WatchRequest
+	15, // 30: miru.ExtensionService.Mirror:input_type -> miru.MirrorRequest
+	18, // 31: miru.ExtensionService.DownloadExtension:input_type -> miru.DownloadExtensionRequest
+	20, // 32: miru.ExtensionService.RemoveExtension:input_type -> miru.RemoveExtensionRequest
+	22, // 33: miru.ExtensionService.GetExtensionSettings:input_type -> miru.GetExtensionSettingsRequest
+	24, // 34: miru.ExtensionService.SaveExtensionSettings:input_type -> miru.SaveExtensionSettingsRequest
+	26, // 35: miru.ExtensionService.GrantExtensionDomains:input_type -> miru.GrantExtensionDomainsRequest
+	28, // 36: miru.ExtensionService.RevokeExtensionDomains:input_type -> miru.RevokeExtensionDomainsRequest
+	31, // 37: miru.ExtensionService.TailExtensionLogs:input_type -> miru.TailExtensionLogsRequest
+	32, // 38: miru.ExtensionService.GetExtensionLogs:input_type -> miru.GetExtensionLogsRequest
+	35, // 39: miru.ExtensionService.CheckExtensionUpdates:input_type -> miru.CheckExtensionUpdatesRequest
+	39, // 40: miru.ExtensionService.UpgradeExtension:input_type -> miru.UpgradeExtensionRequest
+	37, // 41: miru.ExtensionService.CheckFavoriteUpdates:input_type -> miru.CheckFavoriteUpdatesRequest
+	41, // 42: miru.ExtensionService.UpgradeAllExtensions:input_type -> miru.UpgradeAllExtensionsRequest
+	43, // 43: miru.ExtensionService.RollbackExtension:input_type -> miru.RollbackExtensionRequest
+	45, // 44: miru.ExtensionService.SetExtensionEnabled:input_type -> miru.SetExtensionEnabledRequest
+	47, // 45: miru.ExtensionService.PinExtension:input_type -> miru.PinExtensionRequest
+	3,  // 46: miru.ExtensionService.Search:output_type -> miru.SearchResponse
+	2,  // 47: miru.ExtensionService.CreateFilter:output_type -> miru.CreateFilterResponse
+	5,  // 48: miru.ExtensionService.Latest:output_type -> miru.LatestResponse
+	7,  // 49: miru.ExtensionService.Popular:output_type -> miru.PopularResponse
+	9,  // 50: miru.ExtensionService.Tags:output_type -> miru.TagsResponse
+	11, // 51: miru.ExtensionService.CheckUpdate:output_type -> miru.CheckUpdateResponse
+	13, // 52: miru.ExtensionService.Detail:output_type -> miru.DetailResponse
+	17, // 53: miru.ExtensionService.Watch:output_type -> miru.WatchResponse
+	16, // 54: miru.ExtensionService.Mirror:output_type -> miru.MirrorResponse
+	19, // 55: miru.ExtensionService.DownloadExtension:output_type -> miru.DownloadExtensionResponse
+	21, // 56: miru.ExtensionService.RemoveExtension:output_type -> miru.RemoveExtensionResponse
+	23, // 57: miru.ExtensionService.GetExtensionSettings:output_type -> miru.GetExtensionSettingsResponse
+	25, // 58: miru.ExtensionService.SaveExtensionSettings:output_type -> miru.SaveExtensionSettingsResponse
+	27, // 59: miru.ExtensionService.GrantExtensionDomains:output_type -> miru.GrantExtensionDomainsResponse
+	29, // 60: miru.ExtensionService.RevokeExtensionDomains:output_type -> miru.RevokeExtensionDomainsResponse
+	30, // 61: miru.ExtensionService.TailExtensionLogs:output_type -> miru.ExtensionLogEntry
+	33, // 62: miru.ExtensionService.GetExtensionLogs:output_type -> miru.GetExtensionLogsResponse
+	36, // 63: miru.ExtensionService.CheckExtensionUpdates:output_type -> miru.CheckExtensionUpdatesResponse
+	40, // 64: miru.ExtensionService.UpgradeExtension:output_type -> miru.UpgradeExtensionResponse
+	38, // 65: miru.ExtensionService.CheckFavoriteUpdates:output_type -> miru.CheckFavoriteUpdatesResponse
+	42, // 66: miru.ExtensionService.UpgradeAllExtensions:output_type -> miru.UpgradeAllExtensionsResponse
+	44, // 67: miru.ExtensionService.RollbackExtension:output_type -> miru.RollbackExtensionResponse
+	46, // 68: miru.ExtensionService.SetExtensionEnabled:output_type -> miru.SetExtensionEnabledResponse
+	48, // 69: miru.ExtensionService.PinExtension:output_type -> miru.PinExtensionResponse
+	46, // [46:70] is the sub-list for method output_type
+	22, // [22:46] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_proto_extension_proto_init() }
@@ -1308,14 +2886,15 @@ func file_proto_extension_proto_init() {
 	if File_proto_extension_proto != nil {
 		return
 	}
+	file_proto_db_model_proto_init()
 	file_proto_extension_model_proto_init()
-	file_proto_extension_proto_msgTypes[10].OneofWrappers = []any{
+	file_proto_extension_proto_msgTypes[16].OneofWrappers = []any{
 		(*MirrorResponse_Bangumi)(nil),
 		(*MirrorResponse_Manga)(nil),
 		(*MirrorResponse_Fikushon)(nil),
 		(*MirrorResponse_Raw)(nil),
 	}
-	file_proto_extension_proto_msgTypes[11].OneofWrappers = []any{
+	file_proto_extension_proto_msgTypes[17].OneofWrappers = []any{
 		(*WatchResponse_Bangumi)(nil),
 		(*WatchResponse_Manga)(nil),
 		(*WatchResponse_Fikushon)(nil),
@@ -1328,7 +2907,7 @@ func file_proto_extension_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_extension_proto_rawDesc), len(file_proto_extension_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExtensionService_Search_FullMethodName                = "/miru.ExtensionService/Search"
-	ExtensionService_CreateFilter_FullMethodName          = "/miru.ExtensionService/CreateFilter"
-	ExtensionService_Latest_FullMethodName                = "/miru.ExtensionService/Latest"
-	ExtensionService_Detail_FullMethodName                = "/miru.ExtensionService/Detail"
-	ExtensionService_Watch_FullMethodName                 = "/miru.ExtensionService/Watch"
-	ExtensionService_Mirror_FullMethodName                = "/miru.ExtensionService/Mirror"
-	ExtensionService_DownloadExtension_FullMethodName     = "/miru.ExtensionService/DownloadExtension"
-	ExtensionService_RemoveExtension_FullMethodName       = "/miru.ExtensionService/RemoveExtension"
-	ExtensionService_GetExtensionSettings_FullMethodName  = "/miru.ExtensionService/GetExtensionSettings"
-	ExtensionService_SaveExtensionSettings_FullMethodName = "/miru.ExtensionService/SaveExtensionSettings"
+	ExtensionService_Search_FullMethodName                 = "/miru.ExtensionService/Search"
+	ExtensionService_CreateFilter_FullMethodName           = "/miru.ExtensionService/CreateFilter"
+	ExtensionService_Latest_FullMethodName                 = "/miru.ExtensionService/Latest"
+	ExtensionService_Popular_FullMethodName                = "/miru.ExtensionService/Popular"
+	ExtensionService_Tags_FullMethodName                   = "/miru.ExtensionService/Tags"
+	ExtensionService_CheckUpdate_FullMethodName            = "/miru.ExtensionService/CheckUpdate"
+	ExtensionService_Detail_FullMethodName                 = "/miru.ExtensionService/Detail"
+	ExtensionService_Watch_FullMethodName                  = "/miru.ExtensionService/Watch"
+	ExtensionService_Mirror_FullMethodName                 = "/miru.ExtensionService/Mirror"
+	ExtensionService_DownloadExtension_FullMethodName      = "/miru.ExtensionService/DownloadExtension"
+	ExtensionService_RemoveExtension_FullMethodName        = "/miru.ExtensionService/RemoveExtension"
+	ExtensionService_GetExtensionSettings_FullMethodName   = "/miru.ExtensionService/GetExtensionSettings"
+	ExtensionService_SaveExtensionSettings_FullMethodName  = "/miru.ExtensionService/SaveExtensionSettings"
+	ExtensionService_GrantExtensionDomains_FullMethodName  = "/miru.ExtensionService/GrantExtensionDomains"
+	ExtensionService_RevokeExtensionDomains_FullMethodName = "/miru.ExtensionService/RevokeExtensionDomains"
+	ExtensionService_TailExtensionLogs_FullMethodName      = "/miru.ExtensionService/TailExtensionLogs"
+	ExtensionService_GetExtensionLogs_FullMethodName       = "/miru.ExtensionService/GetExtensionLogs"
+	ExtensionService_CheckExtensionUpdates_FullMethodName  = "/miru.ExtensionService/CheckExtensionUpdates"
+	ExtensionService_UpgradeExtension_FullMethodName       = "/miru.ExtensionService/UpgradeExtension"
+	ExtensionService_CheckFavoriteUpdates_FullMethodName   = "/miru.ExtensionService/CheckFavoriteUpdates"
+	ExtensionService_UpgradeAllExtensions_FullMethodName   = "/miru.ExtensionService/UpgradeAllExtensions"
+	ExtensionService_RollbackExtension_FullMethodName      = "/miru.ExtensionService/RollbackExtension"
+	ExtensionService_SetExtensionEnabled_FullMethodName    = "/miru.ExtensionService/SetExtensionEnabled"
+	ExtensionService_PinExtension_FullMethodName           = "/miru.ExtensionService/PinExtension"
 )
 
 // ExtensionServiceClient is the client API for ExtensionService service.
@@ -38,6 +52,9 @@ type ExtensionServiceClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 	CreateFilter(ctx context.Context, in *CreateFilterRequest, opts ...grpc.CallOption) (*CreateFilterResponse, error)
 	Latest(ctx context.Context, in *LatestRequest, opts ...grpc.CallOption) (*LatestResponse, error)
+	Popular(ctx context.Context, in *PopularRequest, opts ...grpc.CallOption) (*PopularResponse, error)
+	Tags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	CheckUpdate(ctx context.Context, in *CheckUpdateRequest, opts ...grpc.CallOption) (*CheckUpdateResponse, error)
 	Detail(ctx context.Context, in *DetailRequest, opts ...grpc.CallOption) (*DetailResponse, error)
 	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*WatchResponse, error)
 	Mirror(ctx context.Context, in *MirrorRequest, opts ...grpc.CallOption) (*MirrorResponse, error)
@@ -45,6 +62,17 @@ type ExtensionServiceClient interface {
 	RemoveExtension(ctx context.Context, in *RemoveExtensionRequest, opts ...grpc.CallOption) (*RemoveExtensionResponse, error)
 	GetExtensionSettings(ctx context.Context, in *GetExtensionSettingsRequest, opts ...grpc.CallOption) (*GetExtensionSettingsResponse, error)
 	SaveExtensionSettings(ctx context.Context, in *SaveExtensionSettingsRequest, opts ...grpc.CallOption) (*SaveExtensionSettingsResponse, error)
+	GrantExtensionDomains(ctx context.Context, in *GrantExtensionDomainsRequest, opts ...grpc.CallOption) (*GrantExtensionDomainsResponse, error)
+	RevokeExtensionDomains(ctx context.Context, in *RevokeExtensionDomainsRequest, opts ...grpc.CallOption) (*RevokeExtensionDomainsResponse, error)
+	TailExtensionLogs(ctx context.Context, in *TailExtensionLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExtensionLogEntry], error)
+	GetExtensionLogs(ctx context.Context, in *GetExtensionLogsRequest, opts ...grpc.CallOption) (*GetExtensionLogsResponse, error)
+	CheckExtensionUpdates(ctx context.Context, in *CheckExtensionUpdatesRequest, opts ...grpc.CallOption) (*CheckExtensionUpdatesResponse, error)
+	UpgradeExtension(ctx context.Context, in *UpgradeExtensionRequest, opts ...grpc.CallOption) (*UpgradeExtensionResponse, error)
+	CheckFavoriteUpdates(ctx context.Context, in *CheckFavoriteUpdatesRequest, opts ...grpc.CallOption) (*CheckFavoriteUpdatesResponse, error)
+	UpgradeAllExtensions(ctx context.Context, in *UpgradeAllExtensionsRequest, opts ...grpc.CallOption) (*UpgradeAllExtensionsResponse, error)
+	RollbackExtension(ctx context.Context, in *RollbackExtensionRequest, opts ...grpc.CallOption) (*RollbackExtensionResponse, error)
+	SetExtensionEnabled(ctx context.Context, in *SetExtensionEnabledRequest, opts ...grpc.CallOption) (*SetExtensionEnabledResponse, error)
+	PinExtension(ctx context.Context, in *PinExtensionRequest, opts ...grpc.CallOption) (*PinExtensionResponse, error)
 }
 
 type extensionServiceClient struct {
@@ -85,6 +113,36 @@ func (c *extensionServiceClient) Latest(ctx context.Context, in *LatestRequest,
 	return out, nil
 }
 
+func (c *extensionServiceClient) Popular(ctx context.Context, in *PopularRequest, opts ...grpc.CallOption) (*PopularResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PopularResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_Popular_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) Tags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_Tags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) CheckUpdate(ctx context.Context, in *CheckUpdateRequest, opts ...grpc.CallOption) (*CheckUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckUpdateResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_CheckUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *extensionServiceClient) Detail(ctx context.Context, in *DetailRequest, opts ...grpc.CallOption) (*DetailResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DetailResponse)
@@ -155,6 +213,125 @@ func (c *extensionServiceClient) SaveExtensionSettings(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *extensionServiceClient) GrantExtensionDomains(ctx context.Context, in *GrantExtensionDomainsRequest, opts ...grpc.CallOption) (*GrantExtensionDomainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantExtensionDomainsResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_GrantExtensionDomains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) RevokeExtensionDomains(ctx context.Context, in *RevokeExtensionDomainsRequest, opts ...grpc.CallOption) (*RevokeExtensionDomainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeExtensionDomainsResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_RevokeExtensionDomains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) TailExtensionLogs(ctx context.Context, in *TailExtensionLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExtensionLogEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExtensionService_ServiceDesc.Streams[0], ExtensionService_TailExtensionLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailExtensionLogsRequest, ExtensionLogEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExtensionService_TailExtensionLogsClient = grpc.ServerStreamingClient[ExtensionLogEntry]
+
+func (c *extensionServiceClient) GetExtensionLogs(ctx context.Context, in *GetExtensionLogsRequest, opts ...grpc.CallOption) (*GetExtensionLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExtensionLogsResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_GetExtensionLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) CheckExtensionUpdates(ctx context.Context, in *CheckExtensionUpdatesRequest, opts ...grpc.CallOption) (*CheckExtensionUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckExtensionUpdatesResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_CheckExtensionUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) UpgradeExtension(ctx context.Context, in *UpgradeExtensionRequest, opts ...grpc.CallOption) (*UpgradeExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpgradeExtensionResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_UpgradeExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) CheckFavoriteUpdates(ctx context.Context, in *CheckFavoriteUpdatesRequest, opts ...grpc.CallOption) (*CheckFavoriteUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckFavoriteUpdatesResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_CheckFavoriteUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) UpgradeAllExtensions(ctx context.Context, in *UpgradeAllExtensionsRequest, opts ...grpc.CallOption) (*UpgradeAllExtensionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpgradeAllExtensionsResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_UpgradeAllExtensions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) RollbackExtension(ctx context.Context, in *RollbackExtensionRequest, opts ...grpc.CallOption) (*RollbackExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackExtensionResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_RollbackExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) SetExtensionEnabled(ctx context.Context, in *SetExtensionEnabledRequest, opts ...grpc.CallOption) (*SetExtensionEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExtensionEnabledResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_SetExtensionEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) PinExtension(ctx context.Context, in *PinExtensionRequest, opts ...grpc.CallOption) (*PinExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinExtensionResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_PinExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExtensionServiceServer is the server API for ExtensionService service.
 // All implementations must embed UnimplementedExtensionServiceServer
 // for forward compatibility.
@@ -162,6 +339,9 @@ type ExtensionServiceServer interface {
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 	CreateFilter(context.Context, *CreateFilterRequest) (*CreateFilterResponse, error)
 	Latest(context.Context, *LatestRequest) (*LatestResponse, error)
+	Popular(context.Context, *PopularRequest) (*PopularResponse, error)
+	Tags(context.Context, *TagsRequest) (*TagsResponse, error)
+	CheckUpdate(context.Context, *CheckUpdateRequest) (*CheckUpdateResponse, error)
 	Detail(context.Context, *DetailRequest) (*DetailResponse, error)
 	Watch(context.Context, *WatchRequest) (*WatchResponse, error)
 	Mirror(context.Context, *MirrorRequest) (*MirrorResponse, error)
@@ -169,6 +349,17 @@ type ExtensionServiceServer interface {
 	RemoveExtension(context.Context, *RemoveExtensionRequest) (*RemoveExtensionResponse, error)
 	GetExtensionSettings(context.Context, *GetExtensionSettingsRequest) (*GetExtensionSettingsResponse, error)
 	SaveExtensionSettings(context.Context, *SaveExtensionSettingsRequest) (*SaveExtensionSettingsResponse, error)
+	GrantExtensionDomains(context.Context, *GrantExtensionDomainsRequest) (*GrantExtensionDomainsResponse, error)
+	RevokeExtensionDomains(context.Context, *RevokeExtensionDomainsRequest) (*RevokeExtensionDomainsResponse, error)
+	TailExtensionLogs(*TailExtensionLogsRequest, grpc.ServerStreamingServer[ExtensionLogEntry]) error
+	GetExtensionLogs(context.Context, *GetExtensionLogsRequest) (*GetExtensionLogsResponse, error)
+	CheckExtensionUpdates(context.Context, *CheckExtensionUpdatesRequest) (*CheckExtensionUpdatesResponse, error)
+	UpgradeExtension(context.Context, *UpgradeExtensionRequest) (*UpgradeExtensionResponse, error)
+	CheckFavoriteUpdates(context.Context, *CheckFavoriteUpdatesRequest) (*CheckFavoriteUpdatesResponse, error)
+	UpgradeAllExtensions(context.Context, *UpgradeAllExtensionsRequest) (*UpgradeAllExtensionsResponse, error)
+	RollbackExtension(context.Context, *RollbackExtensionRequest) (*RollbackExtensionResponse, error)
+	SetExtensionEnabled(context.Context, *SetExtensionEnabledRequest) (*SetExtensionEnabledResponse, error)
+	PinExtension(context.Context, *PinExtensionRequest) (*PinExtensionResponse, error)
 	mustEmbedUnimplementedExtensionServiceServer()
 }
 
@@ -188,6 +379,15 @@ func (UnimplementedExtensionServiceServer) CreateFilter(context.Context, *Create
 func (UnimplementedExtensionServiceServer) Latest(context.Context, *LatestRequest) (*LatestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Latest not implemented")
 }
+func (UnimplementedExtensionServiceServer) Popular(context.Context, *PopularRequest) (*PopularResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Popular not implemented")
+}
+func (UnimplementedExtensionServiceServer) Tags(context.Context, *TagsRequest) (*TagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Tags not implemented")
+}
+func (UnimplementedExtensionServiceServer) CheckUpdate(context.Context, *CheckUpdateRequest) (*CheckUpdateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckUpdate not implemented")
+}
 func (UnimplementedExtensionServiceServer) Detail(context.Context, *DetailRequest) (*DetailResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Detail not implemented")
 }
@@ -209,6 +409,39 @@ func (UnimplementedExtensionServiceServer) GetExtensionSettings(context.Context,
 func (UnimplementedExtensionServiceServer) SaveExtensionSettings(context.Context, *SaveExtensionSettingsRequest) (*SaveExtensionSettingsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SaveExtensionSettings not implemented")
 }
+func (UnimplementedExtensionServiceServer) GrantExtensionDomains(context.Context, *GrantExtensionDomainsRequest) (*GrantExtensionDomainsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantExtensionDomains not implemented")
+}
+func (UnimplementedExtensionServiceServer) RevokeExtensionDomains(context.Context, *RevokeExtensionDomainsRequest) (*RevokeExtensionDomainsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeExtensionDomains not implemented")
+}
+func (UnimplementedExtensionServiceServer) TailExtensionLogs(*TailExtensionLogsRequest, grpc.ServerStreamingServer[ExtensionLogEntry]) error {
+	return status.Error(codes.Unimplemented, "method TailExtensionLogs not implemented")
+}
+func (UnimplementedExtensionServiceServer) GetExtensionLogs(context.Context, *GetExtensionLogsRequest) (*GetExtensionLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExtensionLogs not implemented")
+}
+func (UnimplementedExtensionServiceServer) CheckExtensionUpdates(context.Context, *CheckExtensionUpdatesRequest) (*CheckExtensionUpdatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckExtensionUpdates not implemented")
+}
+func (UnimplementedExtensionServiceServer) UpgradeExtension(context.Context, *UpgradeExtensionRequest) (*UpgradeExtensionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpgradeExtension not implemented")
+}
+func (UnimplementedExtensionServiceServer) CheckFavoriteUpdates(context.Context, *CheckFavoriteUpdatesRequest) (*CheckFavoriteUpdatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckFavoriteUpdates not implemented")
+}
+func (UnimplementedExtensionServiceServer) UpgradeAllExtensions(context.Context, *UpgradeAllExtensionsRequest) (*UpgradeAllExtensionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpgradeAllExtensions not implemented")
+}
+func (UnimplementedExtensionServiceServer) RollbackExtension(context.Context, *RollbackExtensionRequest) (*RollbackExtensionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackExtension not implemented")
+}
+func (UnimplementedExtensionServiceServer) SetExtensionEnabled(context.Context, *SetExtensionEnabledRequest) (*SetExtensionEnabledResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExtensionEnabled not implemented")
+}
+func (UnimplementedExtensionServiceServer) PinExtension(context.Context, *PinExtensionRequest) (*PinExtensionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PinExtension not implemented")
+}
 func (UnimplementedExtensionServiceServer) mustEmbedUnimplementedExtensionServiceServer() {}
 func (UnimplementedExtensionServiceServer) testEmbeddedByValue()                          {}
 
@@ -284,6 +517,60 @@ func _ExtensionService_Latest_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExtensionService_Popular_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopularRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).Popular(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_Popular_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).Popular(ctx, req.(*PopularRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_Tags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).Tags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_Tags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).Tags(ctx, req.(*TagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_CheckUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).CheckUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_CheckUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).CheckUpdate(ctx, req.(*CheckUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExtensionService_Detail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DetailRequest)
 	if err := dec(in); err != nil {
@@ -410,6 +697,197 @@ func _ExtensionService_SaveExtensionSettings_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExtensionService_GrantExtensionDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantExtensionDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).GrantExtensionDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_GrantExtensionDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).GrantExtensionDomains(ctx, req.(*GrantExtensionDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_RevokeExtensionDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeExtensionDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).RevokeExtensionDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_RevokeExtensionDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).RevokeExtensionDomains(ctx, req.(*RevokeExtensionDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_TailExtensionLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailExtensionLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExtensionServiceServer).TailExtensionLogs(m, &grpc.GenericServerStream[TailExtensionLogsRequest, ExtensionLogEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExtensionService_TailExtensionLogsServer = grpc.ServerStreamingServer[ExtensionLogEntry]
+
+func _ExtensionService_GetExtensionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExtensionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).GetExtensionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_GetExtensionLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).GetExtensionLogs(ctx, req.(*GetExtensionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_CheckExtensionUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckExtensionUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).CheckExtensionUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_CheckExtensionUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).CheckExtensionUpdates(ctx, req.(*CheckExtensionUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_UpgradeExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).UpgradeExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_UpgradeExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).UpgradeExtension(ctx, req.(*UpgradeExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_CheckFavoriteUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFavoriteUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).CheckFavoriteUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_CheckFavoriteUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).CheckFavoriteUpdates(ctx, req.(*CheckFavoriteUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_UpgradeAllExtensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeAllExtensionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).UpgradeAllExtensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_UpgradeAllExtensions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).UpgradeAllExtensions(ctx, req.(*UpgradeAllExtensionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_RollbackExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).RollbackExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_RollbackExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).RollbackExtension(ctx, req.(*RollbackExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_SetExtensionEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExtensionEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).SetExtensionEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_SetExtensionEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).SetExtensionEnabled(ctx, req.(*SetExtensionEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_PinExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).PinExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_PinExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).PinExtension(ctx, req.(*PinExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExtensionService_ServiceDesc is the grpc.ServiceDesc for ExtensionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -429,6 +907,18 @@ var ExtensionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Latest",
 			Handler:    _ExtensionService_Latest_Handler,
 		},
+		{
+			MethodName: "Popular",
+			Handler:    _ExtensionService_Popular_Handler,
+		},
+		{
+			MethodName: "Tags",
+			Handler:    _ExtensionService_Tags_Handler,
+		},
+		{
+			MethodName: "CheckUpdate",
+			Handler:    _ExtensionService_CheckUpdate_Handler,
+		},
 		{
 			MethodName: "Detail",
 			Handler:    _ExtensionService_Detail_Handler,
@@ -457,7 +947,53 @@ var ExtensionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SaveExtensionSettings",
 			Handler:    _ExtensionService_SaveExtensionSettings_Handler,
 		},
+		{
+			MethodName: "GrantExtensionDomains",
+			Handler:    _ExtensionService_GrantExtensionDomains_Handler,
+		},
+		{
+			MethodName: "RevokeExtensionDomains",
+			Handler:    _ExtensionService_RevokeExtensionDomains_Handler,
+		},
+		{
+			MethodName: "GetExtensionLogs",
+			Handler:    _ExtensionService_GetExtensionLogs_Handler,
+		},
+		{
+			MethodName: "CheckExtensionUpdates",
+			Handler:    _ExtensionService_CheckExtensionUpdates_Handler,
+		},
+		{
+			MethodName: "UpgradeExtension",
+			Handler:    _ExtensionService_UpgradeExtension_Handler,
+		},
+		{
+			MethodName: "CheckFavoriteUpdates",
+			Handler:    _ExtensionService_CheckFavoriteUpdates_Handler,
+		},
+		{
+			MethodName: "UpgradeAllExtensions",
+			Handler:    _ExtensionService_UpgradeAllExtensions_Handler,
+		},
+		{
+			MethodName: "RollbackExtension",
+			Handler:    _ExtensionService_RollbackExtension_Handler,
+		},
+		{
+			MethodName: "SetExtensionEnabled",
+			Handler:    _ExtensionService_SetExtensionEnabled_Handler,
+		},
+		{
+			MethodName: "PinExtension",
+			Handler:    _ExtensionService_PinExtension_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailExtensionLogs",
+			Handler:       _ExtensionService_TailExtensionLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/extension.proto",
 }
@@ -24,9 +24,12 @@ const (
 type ExtensionSettingType int32
 
 const (
-	ExtensionSettingType_input  ExtensionSettingType = 0
-	ExtensionSettingType_radio  ExtensionSettingType = 1
-	ExtensionSettingType_toggle ExtensionSettingType = 2
+	ExtensionSettingType_input        ExtensionSettingType = 0
+	ExtensionSettingType_radio        ExtensionSettingType = 1
+	ExtensionSettingType_toggle       ExtensionSettingType = 2
+	ExtensionSettingType_number       ExtensionSettingType = 3
+	ExtensionSettingType_multi_select ExtensionSettingType = 4
+	ExtensionSettingType_secret       ExtensionSettingType = 5
 )
 
 // Enum value maps for ExtensionSettingType.
@@ -35,11 +38,17 @@ var (
 		0: "input",
 		1: "radio",
 		2: "toggle",
+		3: "number",
+		4: "multi_select",
+		5: "secret",
 	}
 	ExtensionSettingType_value = map[string]int32{
-		"input":  0,
-		"radio":  1,
-		"toggle": 2,
+		"input":        0,
+		"radio":        1,
+		"toggle":       2,
+		"number":       3,
+		"multi_select": 4,
+		"secret":       5,
 	}
 )
 
@@ -1356,16 +1365,18 @@ func (x *RepoConfig) GetId() int32 {
 }
 
 type ExtensionSetting struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Package       string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	Value         *string                `protobuf:"bytes,5,opt,name=value,proto3,oneof" json:"value,omitempty"`
-	DefaultValue  string                 `protobuf:"bytes,6,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
-	Type          ExtensionSettingType   `protobuf:"varint,7,opt,name=type,proto3,enum=miru.ExtensionSettingType" json:"type,omitempty"`
-	Description   *string                `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Options       *string                `protobuf:"bytes,9,opt,name=options,proto3,oneof" json:"options,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Package      string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	Title        string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Key          string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Value        *string                `protobuf:"bytes,5,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	DefaultValue string                 `protobuf:"bytes,6,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	Type         ExtensionSettingType   `protobuf:"varint,7,opt,name=type,proto3,enum=miru.ExtensionSettingType" json:"type,omitempty"`
+	Description  *string                `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Options      *string                `protobuf:"bytes,9,opt,name=options,proto3,oneof" json:"options,omitempty"`
+	// Set when saving to keep the stored value, e.g. of a masked secret
+	Unchanged     bool `protobuf:"varint,10,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1463,6 +1474,13 @@ func (x *ExtensionSetting) GetOptions() string {
 	return ""
 }
 
+func (x *ExtensionSetting) GetUnchanged() bool {
+	if x != nil {
+		return x.Unchanged
+	}
+	return false
+}
+
 var File_proto_extension_model_proto protoreflect.FileDescriptor
 
 const file_proto_extension_model_proto_rawDesc = "" +
@@ -1605,7 +1623,7 @@ const file_proto_extension_model_proto_rawDesc = "" +
 	"RepoConfig\x12\x12\n" +
 	"\x04link\x18\x01 \x01(\tR\x04link\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x0e\n" +
-	"\x02id\x18\x03 \x01(\x05R\x02id\"\xbf\x02\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"\xdd\x02\n" +
 	"\x10ExtensionSetting\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\apackage\x18\x02 \x01(\tR\apackage\x12\x14\n" +
@@ -1615,16 +1633,23 @@ const file_proto_extension_model_proto_rawDesc = "" +
 	"\fdefaultValue\x18\x06 \x01(\tR\fdefaultValue\x12.\n" +
 	"\x04type\x18\a \x01(\x0e2\x1a.miru.ExtensionSettingTypeR\x04type\x12%\n" +
 	"\vdescription\x18\b \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1d\n" +
-	"\aoptions\x18\t \x01(\tH\x02R\aoptions\x88\x01\x01B\b\n" +
+	"\aoptions\x18\t \x01(\tH\x02R\aoptions\x88\x01\x01\x12\x1c\n" +
+	"\tunchanged\x18\n" +
+	" \x01(\bR\tunchangedB\b\n" +
 	"\x06_valueB\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
-	"\b_options*8\n" +
+	"\b_options*b\n" +
 	"\x14ExtensionSettingType\x12\t\n" +
 	"\x05input\x10\x00\x12\t\n" +
 	"\x05radio\x10\x01\x12\n" +
 	"\n" +
-	"\x06toggle\x10\x02B)Z'github.com/miru-project/miru-core/protob\x06proto3"
+	"\x06toggle\x10\x02\x12\n" +
+	"\n" +
+	"\x06number\x10\x03\x12\x10\n" +
+	"\fmulti_select\x10\x04\x12\n" +
+	"\n" +
+	"\x06secret\x10\x05B)Z'github.com/miru-project/miru-core/protob\x06proto3"
 
 var (
 	file_proto_extension_model_proto_rawDescOnce sync.Once
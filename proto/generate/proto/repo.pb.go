@@ -286,6 +286,103 @@ func (x *DeleteRepoResponse) GetMessage() string {
 	return ""
 }
 
+// Extensions of a repository with trusted keys must be signed by one of them
+type SetRepoTrustedKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoUrl       string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	TrustedKeys   []string               `protobuf:"bytes,2,rep,name=trusted_keys,json=trustedKeys,proto3" json:"trusted_keys,omitempty"` // base64 ed25519 public keys
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoTrustedKeysRequest) Reset() {
+	*x = SetRepoTrustedKeysRequest{}
+	mi := &file_proto_repo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoTrustedKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoTrustedKeysRequest) ProtoMessage() {}
+
+func (x *SetRepoTrustedKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_repo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoTrustedKeysRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoTrustedKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_repo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetRepoTrustedKeysRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *SetRepoTrustedKeysRequest) GetTrustedKeys() []string {
+	if x != nil {
+		return x.TrustedKeys
+	}
+	return nil
+}
+
+type SetRepoTrustedKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoTrustedKeysResponse) Reset() {
+	*x = SetRepoTrustedKeysResponse{}
+	mi := &file_proto_repo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoTrustedKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoTrustedKeysResponse) ProtoMessage() {}
+
+func (x *SetRepoTrustedKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_repo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoTrustedKeysResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoTrustedKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_repo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetRepoTrustedKeysResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type FetchRepoListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -294,7 +391,7 @@ type FetchRepoListRequest struct {
 
 func (x *FetchRepoListRequest) Reset() {
 	*x = FetchRepoListRequest{}
-	mi := &file_proto_repo_proto_msgTypes[6]
+	mi := &file_proto_repo_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -306,7 +403,7 @@ func (x *FetchRepoListRequest) String() string {
 func (*FetchRepoListRequest) ProtoMessage() {}
 
 func (x *FetchRepoListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_repo_proto_msgTypes[6]
+	mi := &file_proto_repo_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,7 +416,7 @@ func (x *FetchRepoListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchRepoListRequest.ProtoReflect.Descriptor instead.
 func (*FetchRepoListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_repo_proto_rawDescGZIP(), []int{6}
+	return file_proto_repo_proto_rawDescGZIP(), []int{8}
 }
 
 type FetchRepoListResponse struct {
@@ -331,7 +428,7 @@ type FetchRepoListResponse struct {
 
 func (x *FetchRepoListResponse) Reset() {
 	*x = FetchRepoListResponse{}
-	mi := &file_proto_repo_proto_msgTypes[7]
+	mi := &file_proto_repo_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +440,7 @@ func (x *FetchRepoListResponse) String() string {
 func (*FetchRepoListResponse) ProtoMessage() {}
 
 func (x *FetchRepoListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_repo_proto_msgTypes[7]
+	mi := &file_proto_repo_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +453,7 @@ func (x *FetchRepoListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchRepoListResponse.ProtoReflect.Descriptor instead.
 func (*FetchRepoListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_repo_proto_rawDescGZIP(), []int{7}
+	return file_proto_repo_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FetchRepoListResponse) GetData() string {
@@ -382,16 +479,22 @@ const file_proto_repo_proto_rawDesc = "" +
 	"\x11DeleteRepoRequest\x12\x19\n" +
 	"\brepo_url\x18\x01 \x01(\tR\arepoUrl\".\n" +
 	"\x12DeleteRepoResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"Y\n" +
+	"\x19SetRepoTrustedKeysRequest\x12\x19\n" +
+	"\brepo_url\x18\x01 \x01(\tR\arepoUrl\x12!\n" +
+	"\ftrusted_keys\x18\x02 \x03(\tR\vtrustedKeys\"6\n" +
+	"\x1aSetRepoTrustedKeysResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"\x16\n" +
 	"\x14FetchRepoListRequest\"+\n" +
 	"\x15FetchRepoListResponse\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\tR\x04data2\x8b\x02\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data2\xe4\x02\n" +
 	"\vRepoService\x129\n" +
 	"\bGetRepos\x12\x15.miru.GetReposRequest\x1a\x16.miru.GetReposResponse\x126\n" +
 	"\aSetRepo\x12\x14.miru.SetRepoRequest\x1a\x15.miru.SetRepoResponse\x12?\n" +
 	"\n" +
 	"DeleteRepo\x12\x17.miru.DeleteRepoRequest\x1a\x18.miru.DeleteRepoResponse\x12H\n" +
-	"\rFetchRepoList\x12\x1a.miru.FetchRepoListRequest\x1a\x1b.miru.FetchRepoListResponseB)Z'github.com/miru-project/miru-core/protob\x06proto3"
+	"\rFetchRepoList\x12\x1a.miru.FetchRepoListRequest\x1a\x1b.miru.FetchRepoListResponse\x12W\n" +
+	"\x12SetRepoTrustedKeys\x12\x1f.miru.SetRepoTrustedKeysRequest\x1a .miru.SetRepoTrustedKeysResponseB)Z'github.com/miru-project/miru-core/protob\x06proto3"
 
 var (
 	file_proto_repo_proto_rawDescOnce sync.Once
@@ -405,28 +508,32 @@ func file_proto_repo_proto_rawDescGZIP() []byte {
 	return file_proto_repo_proto_rawDescData
 }
 
-var file_proto_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_proto_repo_proto_goTypes = []any{
-	(*GetReposRequest)(nil),       // 0: miru.GetReposRequest
-	(*GetReposResponse)(nil),      // 1: miru.GetReposResponse
-	(*SetRepoRequest)(nil),        // 2: miru.SetRepoRequest
-	(*SetRepoResponse)(nil),       // 3: miru.SetRepoResponse
-	(*DeleteRepoRequest)(nil),     // 4: miru.DeleteRepoRequest
-	(*DeleteRepoResponse)(nil),    // 5: miru.DeleteRepoResponse
-	(*FetchRepoListRequest)(nil),  // 6: miru.FetchRepoListRequest
-	(*FetchRepoListResponse)(nil), // 7: miru.FetchRepoListResponse
+	(*GetReposRequest)(nil),            // 0: miru.GetReposRequest
+	(*GetReposResponse)(nil),           // 1: miru.GetReposResponse
+	(*SetRepoRequest)(nil),             // 2: miru.SetRepoRequest
+	(*SetRepoResponse)(nil),            // 3: miru.SetRepoResponse
+	(*DeleteRepoRequest)(nil),          // 4: miru.DeleteRepoRequest
+	(*DeleteRepoResponse)(nil),         // 5: miru.DeleteRepoResponse
+	(*SetRepoTrustedKeysRequest)(nil),  // 6: miru.SetRepoTrustedKeysRequest
+	(*SetRepoTrustedKeysResponse)(nil), // 7: miru.SetRepoTrustedKeysResponse
+	(*FetchRepoListRequest)(nil),       // 8: miru.FetchRepoListRequest
+	(*FetchRepoListResponse)(nil),      // 9: miru.FetchRepoListResponse
 }
 var file_proto_repo_proto_depIdxs = []int32{
 	0, // 0: miru.RepoService.GetRepos:input_type -> miru.GetReposRequest
 	2, // 1: miru.RepoService.SetRepo:input_type -> miru.SetRepoRequest
 	4, // 2: miru.RepoService.DeleteRepo:input_type -> miru.DeleteRepoRequest
-	6, // 3: miru.RepoService.FetchRepoList:input_type -> miru.FetchRepoListRequest
-	1, // 4: miru.RepoService.GetRepos:output_type -> miru.GetReposResponse
-	3, // 5: miru.RepoService.SetRepo:output_type -> miru.SetRepoResponse
-	5, // 6: miru.RepoService.DeleteRepo:output_type -> miru.DeleteRepoResponse
-	7, // 7: miru.RepoService.FetchRepoList:output_type -> miru.FetchRepoListResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	8, // 3: miru.RepoService.FetchRepoList:input_type -> miru.FetchRepoListRequest
+	6, // 4: miru.RepoService.SetRepoTrustedKeys:input_type -> miru.SetRepoTrustedKeysRequest
+	1, // 5: miru.RepoService.GetRepos:output_type -> miru.GetReposResponse
+	3, // 6: miru.RepoService.SetRepo:output_type -> miru.SetRepoResponse
+	5, // 7: miru.RepoService.DeleteRepo:output_type -> miru.DeleteRepoResponse
+	9, // 8: miru.RepoService.FetchRepoList:output_type -> miru.FetchRepoListResponse
+	7, // 9: miru.RepoService.SetRepoTrustedKeys:output_type -> miru.SetRepoTrustedKeysResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -443,7 +550,7 @@ func file_proto_repo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_repo_proto_rawDesc), len(file_proto_repo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RepoService_GetRepos_FullMethodName      = "/miru.RepoService/GetRepos"
-	RepoService_SetRepo_FullMethodName       = "/miru.RepoService/SetRepo"
-	RepoService_DeleteRepo_FullMethodName    = "/miru.RepoService/DeleteRepo"
-	RepoService_FetchRepoList_FullMethodName = "/miru.RepoService/FetchRepoList"
+	RepoService_GetRepos_FullMethodName           = "/miru.RepoService/GetRepos"
+	RepoService_SetRepo_FullMethodName            = "/miru.RepoService/SetRepo"
+	RepoService_DeleteRepo_FullMethodName         = "/miru.RepoService/DeleteRepo"
+	RepoService_FetchRepoList_FullMethodName      = "/miru.RepoService/FetchRepoList"
+	RepoService_SetRepoTrustedKeys_FullMethodName = "/miru.RepoService/SetRepoTrustedKeys"
 )
 
 // RepoServiceClient is the client API for RepoService service.
@@ -33,6 +34,7 @@ type RepoServiceClient interface {
 	SetRepo(ctx context.Context, in *SetRepoRequest, opts ...grpc.CallOption) (*SetRepoResponse, error)
 	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*DeleteRepoResponse, error)
 	FetchRepoList(ctx context.Context, in *FetchRepoListRequest, opts ...grpc.CallOption) (*FetchRepoListResponse, error)
+	SetRepoTrustedKeys(ctx context.Context, in *SetRepoTrustedKeysRequest, opts ...grpc.CallOption) (*SetRepoTrustedKeysResponse, error)
 }
 
 type repoServiceClient struct {
@@ -83,6 +85,16 @@ func (c *repoServiceClient) FetchRepoList(ctx context.Context, in *FetchRepoList
 	return out, nil
 }
 
+func (c *repoServiceClient) SetRepoTrustedKeys(ctx context.Context, in *SetRepoTrustedKeysRequest, opts ...grpc.CallOption) (*SetRepoTrustedKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRepoTrustedKeysResponse)
+	err := c.cc.Invoke(ctx, RepoService_SetRepoTrustedKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepoServiceServer is the server API for RepoService service.
 // All implementations must embed UnimplementedRepoServiceServer
 // for forward compatibility.
@@ -91,6 +103,7 @@ type RepoServiceServer interface {
 	SetRepo(context.Context, *SetRepoRequest) (*SetRepoResponse, error)
 	DeleteRepo(context.Context, *DeleteRepoRequest) (*DeleteRepoResponse, error)
 	FetchRepoList(context.Context, *FetchRepoListRequest) (*FetchRepoListResponse, error)
+	SetRepoTrustedKeys(context.Context, *SetRepoTrustedKeysRequest) (*SetRepoTrustedKeysResponse, error)
 	mustEmbedUnimplementedRepoServiceServer()
 }
 
@@ -113,6 +126,9 @@ func (UnimplementedRepoServiceServer) DeleteRepo(context.Context, *DeleteRepoReq
 func (UnimplementedRepoServiceServer) FetchRepoList(context.Context, *FetchRepoListRequest) (*FetchRepoListResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method FetchRepoList not implemented")
 }
+func (UnimplementedRepoServiceServer) SetRepoTrustedKeys(context.Context, *SetRepoTrustedKeysRequest) (*SetRepoTrustedKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoTrustedKeys not implemented")
+}
 func (UnimplementedRepoServiceServer) mustEmbedUnimplementedRepoServiceServer() {}
 func (UnimplementedRepoServiceServer) testEmbeddedByValue()                     {}
 
@@ -206,6 +222,24 @@ func _RepoService_FetchRepoList_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoService_SetRepoTrustedKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoTrustedKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).SetRepoTrustedKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_SetRepoTrustedKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).SetRepoTrustedKeys(ctx, req.(*SetRepoTrustedKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RepoService_ServiceDesc is the grpc.ServiceDesc for RepoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var RepoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchRepoList",
 			Handler:    _RepoService_FetchRepoList_Handler,
 		},
+		{
+			MethodName: "SetRepoTrustedKeys",
+			Handler:    _RepoService_SetRepoTrustedKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/repo.proto",
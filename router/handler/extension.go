@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"strconv"
 
 	"github.com/miru-project/miru-core/ent"
@@ -10,44 +12,67 @@ import (
 )
 
 // handle Latest when receiving a request
-func Latest(page string, pkg string) *result.Result[[]*proto.ExtensionListItem] {
+func Latest(ctx context.Context, page string, pkg string) *result.Result[[]*proto.ExtensionListItem] {
 
 	intPage, err := strconv.Atoi(page)
 	if err != nil {
 		return result.NewErrorResult[[]*proto.ExtensionListItem]("Invalid page number", 400, nil)
 	}
-	res, e := jsExtension.Latest[proto.ExtensionListItem](pkg, intPage)
+	res, e := jsExtension.Latest[proto.ExtensionListItem](ctx, pkg, intPage)
+	return handleResult(res, e)
+}
+
+// handle Popular when receiving a request
+func Popular(ctx context.Context, page string, pkg string) *result.Result[[]*proto.ExtensionListItem] {
+
+	intPage, err := strconv.Atoi(page)
+	if err != nil {
+		return result.NewErrorResult[[]*proto.ExtensionListItem]("Invalid page number", 400, nil)
+	}
+	res, e := jsExtension.Popular[proto.ExtensionListItem](ctx, pkg, intPage)
+	return handleResult(res, e)
+}
+
+// handle Tags when receiving a request
+func Tags(ctx context.Context, pkg string, url string) *result.Result[[]*string] {
+	res, e := jsExtension.Tags[string](ctx, pkg, url)
+	return handleResult(res, e)
+}
+
+// handle CheckUpdate when receiving a request
+func CheckUpdate(ctx context.Context, pkg string, url string) *result.Result[*string] {
+	res, e := jsExtension.CheckUpdate[string](ctx, pkg, url)
 	return handleResult(res, e)
 }
 
 // handle Search when receiving a request
-func Search(page string, pkg string, kw string, filter string) *result.Result[[]*proto.ExtensionListItem] {
+func Search(ctx context.Context, page string, pkg string, kw string, filter string) *result.Result[[]*proto.ExtensionListItem] {
 
 	intPage, err := strconv.Atoi(page)
 	if err != nil {
 		return result.NewErrorResult[[]*proto.ExtensionListItem]("Invalid page number", 400, nil)
 	}
 
-	res, e := jsExtension.Search[proto.ExtensionListItem](pkg, intPage, kw, filter)
+	res, e := jsExtension.Search[proto.ExtensionListItem](ctx, pkg, intPage, kw, filter)
 	return handleResult(res, e)
 }
 
 // handle CreateFilter when receiving a request
-func CreateFilter(pkg string, filter string) *result.Result[map[string]*proto.ExtensionFilter] {
-	res, e := jsExtension.CreateFilter(pkg, filter)
+func CreateFilter(ctx context.Context, pkg string, filter string) *result.Result[map[string]*proto.ExtensionFilter] {
+	res, e := jsExtension.CreateFilter(ctx, pkg, filter)
 	return handleResult(res, e)
 }
 
 // handle Watch when receiving a request
-func Watch(pkg string, url string) (*result.Result[any], *jsExtension.ExtApi) {
+func Watch(ctx context.Context, pkg string, url string) (*result.Result[any], *jsExtension.ExtApi) {
 
-	res, e, api := jsExtension.Watch(pkg, url)
+	res, e, api := jsExtension.Watch(ctx, pkg, url)
 	return handleResult(res, e), api
 }
 
 // handle Mirror when receiving a request
-func Mirror(pkg string, url string) *result.Result[string] {
-	res, e := jsExtension.Mirror(pkg, url)
+func Mirror(ctx context.Context, pkg string, url string) *result.Result[string] {
+	res, e := jsExtension.Mirror(ctx, pkg, url)
 	if e != nil {
 		return result.NewErrorResult(e.Error(), 500, "")
 	}
@@ -60,15 +85,19 @@ func Mirror(pkg string, url string) *result.Result[string] {
 }
 
 // handle Detail when receiving a request
-func Detail(pkg string, url string) *result.Result[*proto.ExtensionDetail] {
+func Detail(ctx context.Context, pkg string, url string) *result.Result[*proto.ExtensionDetail] {
 
-	res, e := jsExtension.Detail[proto.ExtensionDetail](pkg, url)
+	res, e := jsExtension.Detail[proto.ExtensionDetail](ctx, pkg, url)
 	return handleResult(res, e)
 }
 
 func handleResult[T any](res T, e error) *result.Result[T] {
 	if e != nil {
 		var zero T
+		var timeout *jsExtension.ExtTimeoutError
+		if errors.As(e, &timeout) {
+			return result.NewErrorResult(e.Error(), 408, zero)
+		}
 		return result.NewErrorResult(e.Error(), 404, zero)
 	}
 